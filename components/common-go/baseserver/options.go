@@ -7,6 +7,7 @@ package baseserver
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
@@ -35,6 +36,9 @@ type options struct {
 	healthHandler healthcheck.Handler
 
 	grpcHealthCheck grpc_health_v1.HealthServer
+
+	// debugHandlers are served by the debug server in addition to pprof
+	debugHandlers map[string]http.Handler
 }
 
 func defaultOptions() *options {
@@ -135,6 +139,21 @@ func WithHealthHandler(handler healthcheck.Handler) Option {
 	}
 }
 
+// WithDebugHandler serves handler on the debug server under pattern
+func WithDebugHandler(pattern string, handler http.Handler) Option {
+	return func(opts *options) error {
+		if handler == nil {
+			return fmt.Errorf("nil debug handler provided for %s", pattern)
+		}
+
+		if opts.debugHandlers == nil {
+			opts.debugHandlers = make(map[string]http.Handler)
+		}
+		opts.debugHandlers[pattern] = handler
+		return nil
+	}
+}
+
 func WithGRPCHealthService(svc grpc_health_v1.HealthServer) Option {
 	return func(opts *options) error {
 		if svc == nil {
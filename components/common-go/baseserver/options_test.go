@@ -5,6 +5,7 @@
 package baseserver
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ func TestOptions(t *testing.T) {
 	grpcHealthService := &grpc_health_v1.UnimplementedHealthServer{}
 	httpCfg := ServerConfiguration{Address: "localhost:8080"}
 	grpcCfg := ServerConfiguration{Address: "localhost:8081"}
+	debugHandler := http.NewServeMux()
 
 	var opts = []Option{
 		WithHTTP(&httpCfg),
@@ -33,6 +35,7 @@ func TestOptions(t *testing.T) {
 		WithHealthHandler(health),
 		WithGRPCHealthService(grpcHealthService),
 		WithVersion("foo-bar"),
+		WithDebugHandler("/debug/foo", debugHandler),
 	}
 	actual, err := evaluateOptions(defaultOptions(), opts...)
 	require.NoError(t, err)
@@ -50,6 +53,7 @@ func TestOptions(t *testing.T) {
 		healthHandler:   health,
 		grpcHealthCheck: grpcHealthService,
 		version:         "foo-bar",
+		debugHandlers:   map[string]http.Handler{"/debug/foo": debugHandler},
 	}
 
 	require.Equal(t, expected, actual)
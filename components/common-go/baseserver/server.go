@@ -399,11 +399,16 @@ func newBuiltinServices(server *Server) *builtinServices {
 		healthAddr = ":0"
 	}
 
+	debug := pprof.Handler()
+	for pattern, handler := range server.options.debugHandlers {
+		debug.Handle(pattern, handler)
+	}
+
 	return &builtinServices{
 		underTest: server.options.underTest,
 		Debug: &http.Server{
 			Addr:    fmt.Sprintf(":%d", BuiltinDebugPort),
-			Handler: pprof.Handler(),
+			Handler: debug,
 		},
 		Health: &http.Server{
 			Addr:    healthAddr,
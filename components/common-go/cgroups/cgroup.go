@@ -190,3 +190,57 @@ func ReadIOMax(path string) ([]DeviceIOMax, error) {
 
 	return devices, nil
 }
+
+// DeviceIOStat is the IO a cgroup did on a device since the cgroup was created
+type DeviceIOStat struct {
+	Major      uint64
+	Minor      uint64
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadIOs    uint64
+	WriteIOs   uint64
+}
+
+// ReadIOStat reads the per-device IO statistics of an io.stat file
+func ReadIOStat(path string) ([]DeviceIOStat, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []DeviceIOStat
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var dev DeviceIOStat
+		if _, err := fmt.Sscanf(fields[0], "%d:%d", &dev.Major, &dev.Minor); err != nil {
+			return nil, fmt.Errorf("invalid device in %s: %s", path, fields[0])
+		}
+		for _, kv := range fields[1:] {
+			key, value, ok := strings.Cut(kv, "=")
+			if !ok {
+				continue
+			}
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse %s of device %s: %w", key, fields[0], err)
+			}
+			switch key {
+			case "rbytes":
+				dev.ReadBytes = v
+			case "wbytes":
+				dev.WriteBytes = v
+			case "rios":
+				dev.ReadIOs = v
+			case "wios":
+				dev.WriteIOs = v
+			}
+		}
+		devices = append(devices, dev)
+	}
+
+	return devices, nil
+}
@@ -188,3 +188,46 @@ func TestReadPSI(t *testing.T) {
 		})
 	}
 }
+
+func TestReadIOStat(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		content  string
+		expected []DeviceIOStat
+	}{
+		{
+			name:     "empty",
+			content:  "",
+			expected: nil,
+		},
+		{
+			name:    "multiple devices",
+			content: "259:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0\n8:0 rbytes=90430464 wbytes=299008000 rios=8950 wios=1252 dbytes=50331648 dios=3021\n",
+			expected: []DeviceIOStat{
+				{Major: 259, Minor: 0, ReadBytes: 1459200, WriteBytes: 314773504, ReadIOs: 192, WriteIOs: 353},
+				{Major: 8, Minor: 0, ReadBytes: 90430464, WriteBytes: 299008000, ReadIOs: 8950, WriteIOs: 1252},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			f, err := os.CreateTemp("", "cgroup_test*")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+
+			if _, err := f.Write([]byte(s.content)); err != nil {
+				t.Fatal(err)
+			}
+
+			v, err := ReadIOStat(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, s.expected, v)
+		})
+	}
+}
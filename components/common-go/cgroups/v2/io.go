@@ -36,3 +36,8 @@ func (io *IO) Max() ([]cgroups.DeviceIOMax, error) {
 	path := filepath.Join(io.path, "io.max")
 	return cgroups.ReadIOMax(path)
 }
+
+func (io *IO) Stat() ([]cgroups.DeviceIOStat, error) {
+	path := filepath.Join(io.path, "io.stat")
+	return cgroups.ReadIOStat(path)
+}
@@ -36,6 +36,9 @@ const (
 	// TeamLabel is the label for the workspace's team
 	TeamLabel = "team"
 
+	// TenantLabel is the label for the workspace's tenant
+	TenantLabel = "tenant"
+
 	// TypeLabel marks the workspace type
 	TypeLabel = "workspaceType"
 
@@ -73,6 +76,12 @@ const (
 	// workspaceNetConnLimit denotes the maximum number of connections a workspace can make per minute
 	WorkspaceNetConnLimitAnnotation = "gitpod.io/netConnLimitPerMinute"
 
+	// WorkspaceClassAnnotation contains the class of a workspace
+	WorkspaceClassAnnotation = "gitpod.io/workspaceClass"
+
+	// WorkspaceEgressPolicyAnnotation selects the egress network policy of a workspace by name
+	WorkspaceEgressPolicyAnnotation = "gitpod.io/egressPolicy"
+
 	// workspacePressureStallInfo indicates if pressure stall information should be retrieved for the workspace
 	WorkspacePressureStallInfoAnnotation = "gitpod.io/psi"
 )
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package api
+
+import (
+	"time"
+)
+
+const (
+	// ContentTypeBackupManifest is the content type for a JSON serialized WorkspaceBackupManifest
+	ContentTypeBackupManifest = "application/vnd.gitpod.ws.backup-manifest.v1+json"
+)
+
+// WorkspaceBackupManifest lists the backup generations of a workspace.
+// Restoring a workspace without a specific backup ID restores the latest generation.
+type WorkspaceBackupManifest struct {
+	// Backups are ordered from oldest to latest.
+	Backups []WorkspaceBackupGeneration `json:"backups"`
+}
+
+// WorkspaceBackupGeneration describes a single backup generation
+type WorkspaceBackupGeneration struct {
+	// ID identifies the backup within its workspace
+	ID string `json:"id"`
+	// Name is the backup name as passed to the remote storage, e.g. when downloading it
+	Name string `json:"name"`
+	// Created is the time the backup was made
+	Created time.Time `json:"created"`
+	// Chunked is true if the backup was uploaded as chunks
+	Chunked bool `json:"chunked,omitempty"`
+
+	// Workspace instance ID this backup came from
+	InstanceID string `json:"instanceID"`
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package api
+
+import (
+	digest "github.com/opencontainers/go-digest"
+)
+
+const (
+	// ContentTypeChunkIndex is the content type for a JSON serialized WorkspaceChunkIndex
+	ContentTypeChunkIndex = "application/vnd.gitpod.ws.chunk-index.v1+json"
+)
+
+// WorkspaceChunkIndex describes a backup whose tar archive is stored as content-defined chunks.
+// Restoring the backup means concatenating all chunks in order.
+type WorkspaceChunkIndex struct {
+	// Bucket where to find the chunks.
+	Bucket string `json:"bucket"`
+	// ChunkPrefix is the object name prefix of all chunks. A chunk's object name is the
+	// prefix followed by the encoded chunk digest.
+	ChunkPrefix string `json:"chunkPrefix"`
+	// DiffID is the digest of the complete, uncompressed tar archive.
+	DiffID digest.Digest `json:"diffID"`
+	// Size is the size of the complete tar archive in bytes.
+	Size int64 `json:"size"`
+	// Chunks make up the tar archive in order.
+	Chunks []WorkspaceChunk `json:"chunks"`
+
+	// Workspace instance ID this backup came from
+	InstanceID string `json:"instanceID"`
+}
+
+// WorkspaceChunk describes a single chunk of a chunked backup
+type WorkspaceChunk struct {
+	Digest digest.Digest `json:"digest"`
+	Size   int64         `json:"size"`
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	"github.com/gitpod-io/gitpod/common-go/util"
 )
 
 // StorageConfig configures the remote storage we use
@@ -27,6 +28,12 @@ type StorageConfig struct {
 	// S3Config configures the S3 remote storage
 	S3Config *S3Config `json:"s3,omitempty"`
 
+	// LocalConfig configures the local filesystem remote storage
+	LocalConfig *LocalConfig `json:"local,omitempty"`
+
+	// Encryption enables client-side envelope encryption of workspace content
+	Encryption *EncryptionConfig `json:"encryption,omitempty"`
+
 	BlobQuota int64 `json:"blobQuota"`
 }
 
@@ -58,6 +65,10 @@ const (
 	// exist in the environment. See https://pkg.go.dev/github.com/aws/aws-sdk-go-v2/config#LoadDefaultConfig for more details.
 	S3Storage RemoteStorageType = "s3"
 
+	// LocalStorage stores workspaces in directories on a local or network filesystem. Presigned URLs
+	// are served by the HMAC-signed HTTP handler of the storage package.
+	LocalStorage RemoteStorageType = "local"
+
 	// NullStorage does not synchronize workspaces at all
 	NullStorage RemoteStorageType = ""
 )
@@ -116,6 +127,60 @@ type S3Config struct {
 	CredentialsFile string `json:"credentialsFile"`
 }
 
+// LocalConfig configures the local filesystem remote storage backend
+type LocalConfig struct {
+	// Path is the directory in which each bucket is stored as a sub-directory
+	Path string `json:"path"`
+
+	// BaseURL is the externally reachable URL under which the signed URL handler is served
+	BaseURL string `json:"baseURL"`
+
+	// SigningKey is the HMAC key used to sign URLs
+	SigningKey     string `json:"signingKey"`
+	SigningKeyFile string `json:"signingKeyFile"`
+
+	// URLExpiry is how long signed URLs remain valid. Defaults to 30 minutes.
+	URLExpiry util.Duration `json:"urlExpiry,omitempty"`
+}
+
+// EncryptionConfig configures the client-side envelope encryption of workspace backups and snapshots
+type EncryptionConfig struct {
+	// Provider is the name of the key encryption key provider. Defaults to "file".
+	Provider string `json:"provider,omitempty"`
+
+	// KeyringFile points to the keyring of the file provider
+	KeyringFile string `json:"keyringFile,omitempty"`
+}
+
+// ExportConfig configures the signed URLs under which workspace content exports are served
+type ExportConfig struct {
+	// BaseURL is the externally reachable URL under which the export handler is served
+	BaseURL string `json:"baseURL"`
+
+	// SigningKey is the HMAC key used to sign export URLs
+	SigningKey     string `json:"signingKey"`
+	SigningKeyFile string `json:"signingKeyFile"`
+
+	// URLExpiry is how long export URLs remain valid. Defaults to 30 minutes.
+	URLExpiry util.Duration `json:"urlExpiry,omitempty"`
+}
+
+// ScrubberConfig configures the background job which verifies the integrity of stored workspace content
+type ScrubberConfig struct {
+	// Interval is the time between two scrubber runs. Defaults to 24 hours.
+	Interval util.Duration `json:"interval,omitempty"`
+
+	// Owners restricts the scrubber to the content of these owners. Defaults to all owners.
+	Owners []string `json:"owners,omitempty"`
+
+	// Quarantine moves corrupt objects, and objects which were found orphaned in two consecutive runs,
+	// out of the way instead of only reporting them
+	Quarantine bool `json:"quarantine,omitempty"`
+
+	// ReportPath is the file to which the report of the latest run is written
+	ReportPath string `json:"reportPath,omitempty"`
+}
+
 type PProf struct {
 	Addr string `json:"address"`
 }
@@ -128,6 +193,12 @@ type UsageReportConfig struct {
 type ServiceConfig struct {
 	Service baseserver.ServerConfiguration `json:"service"`
 	Storage StorageConfig                  `json:"storage"`
+	// HTTP configures the server that serves signed URLs of the local storage backend and workspace content exports
+	HTTP *baseserver.ServerConfiguration `json:"http,omitempty"`
+	// Export enables workspace content exports. Requires the HTTP server.
+	Export *ExportConfig `json:"export,omitempty"`
+	// Scrubber enables the background verification of stored workspace content
+	Scrubber *ScrubberConfig `json:"scrubber,omitempty"`
 	// Deprecated
 	_ UsageReportConfig `json:"usageReport"`
 }
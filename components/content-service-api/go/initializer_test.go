@@ -185,6 +185,38 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 				"initializer.prebuild.1.git": "some value",
 			},
 		},
+		{
+			Name: "oci initializer",
+			Input: &api.WorkspaceInitializer{
+				Spec: &api.WorkspaceInitializer_Composite{
+					Composite: &api.CompositeInitializer{
+						Initializer: []*api.WorkspaceInitializer{
+							{
+								Spec: &api.WorkspaceInitializer_Git{
+									Git: &api.GitInitializer{
+										Config: &api.GitConfig{
+											AuthPassword: "foobar",
+										},
+									},
+								},
+							},
+							{
+								Spec: &api.WorkspaceInitializer_Oci{
+									Oci: &api.OCIArtifactInitializer{
+										Ref:          "registry.example.com/starter:v1",
+										AuthPassword: "registry token",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expectation: map[string]string{
+				"initializer.composite.0.git": "foobar",
+				"initializer.composite.1.oci": "registry token",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -201,6 +233,10 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 				api.GitInitializer{},
 				api.GitConfig{},
 				api.PrebuildInitializer{},
+				api.CompositeInitializer{},
+				api.WorkspaceInitializer_Composite{},
+				api.WorkspaceInitializer_Oci{},
+				api.OCIArtifactInitializer{},
 			}
 			if diff := cmp.Diff(original, test.Input, cmpopts.IgnoreUnexported(ignoreUnexported...)); diff != "" {
 				t.Errorf("unexpected alteration from GatherSecretsFromInitializer (-want +got):\n%s", diff)
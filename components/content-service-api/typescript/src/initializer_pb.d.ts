@@ -49,6 +49,11 @@ export class WorkspaceInitializer extends jspb.Message {
     getBackup(): FromBackupInitializer | undefined;
     setBackup(value?: FromBackupInitializer): WorkspaceInitializer;
 
+    hasOci(): boolean;
+    clearOci(): void;
+    getOci(): OCIArtifactInitializer | undefined;
+    setOci(value?: OCIArtifactInitializer): WorkspaceInitializer;
+
     getSpecCase(): WorkspaceInitializer.SpecCase;
 
     serializeBinary(): Uint8Array;
@@ -70,6 +75,7 @@ export namespace WorkspaceInitializer {
         composite?: CompositeInitializer.AsObject,
         download?: FileDownloadInitializer.AsObject,
         backup?: FromBackupInitializer.AsObject,
+        oci?: OCIArtifactInitializer.AsObject,
     }
 
     export enum SpecCase {
@@ -81,6 +87,7 @@ export namespace WorkspaceInitializer {
         COMPOSITE = 5,
         DOWNLOAD = 6,
         BACKUP = 7,
+        OCI = 8,
     }
 
 }
@@ -160,6 +167,41 @@ export namespace FileDownloadInitializer {
 
 }
 
+export class OCIArtifactInitializer extends jspb.Message {
+    getRef(): string;
+    setRef(value: string): OCIArtifactInitializer;
+    getDigest(): string;
+    setDigest(value: string): OCIArtifactInitializer;
+    getTargetLocation(): string;
+    setTargetLocation(value: string): OCIArtifactInitializer;
+    getAuthUser(): string;
+    setAuthUser(value: string): OCIArtifactInitializer;
+    getAuthPassword(): string;
+    setAuthPassword(value: string): OCIArtifactInitializer;
+    getAuthOts(): string;
+    setAuthOts(value: string): OCIArtifactInitializer;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): OCIArtifactInitializer.AsObject;
+    static toObject(includeInstance: boolean, msg: OCIArtifactInitializer): OCIArtifactInitializer.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: OCIArtifactInitializer, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): OCIArtifactInitializer;
+    static deserializeBinaryFromReader(message: OCIArtifactInitializer, reader: jspb.BinaryReader): OCIArtifactInitializer;
+}
+
+export namespace OCIArtifactInitializer {
+    export type AsObject = {
+        ref: string,
+        digest: string,
+        targetLocation: string,
+        authUser: string,
+        authPassword: string,
+        authOts: string,
+    }
+}
+
 export class EmptyInitializer extends jspb.Message {
 
     serializeBinary(): Uint8Array;
@@ -193,6 +235,17 @@ export class GitInitializer extends jspb.Message {
     clearConfig(): void;
     getConfig(): GitConfig | undefined;
     setConfig(value?: GitConfig): GitInitializer;
+    getCloneFilter(): PartialCloneFilter;
+    setCloneFilter(value: PartialCloneFilter): GitInitializer;
+    clearSparseCheckoutList(): void;
+    getSparseCheckoutList(): Array<string>;
+    setSparseCheckoutList(value: Array<string>): GitInitializer;
+    addSparseCheckout(value: string, index?: number): string;
+
+    hasLfs(): boolean;
+    clearLfs(): void;
+    getLfs(): GitLFSConfig | undefined;
+    setLfs(value?: GitLFSConfig): GitInitializer;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GitInitializer.AsObject;
@@ -212,6 +265,36 @@ export namespace GitInitializer {
         cloneTaget: string,
         checkoutLocation: string,
         config?: GitConfig.AsObject,
+        cloneFilter: PartialCloneFilter,
+        sparseCheckoutList: Array<string>,
+        lfs?: GitLFSConfig.AsObject,
+    }
+}
+
+export class GitLFSConfig extends jspb.Message {
+    clearIncludeList(): void;
+    getIncludeList(): Array<string>;
+    setIncludeList(value: Array<string>): GitLFSConfig;
+    addInclude(value: string, index?: number): string;
+    clearExcludeList(): void;
+    getExcludeList(): Array<string>;
+    setExcludeList(value: Array<string>): GitLFSConfig;
+    addExclude(value: string, index?: number): string;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GitLFSConfig.AsObject;
+    static toObject(includeInstance: boolean, msg: GitLFSConfig): GitLFSConfig.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GitLFSConfig, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GitLFSConfig;
+    static deserializeBinaryFromReader(message: GitLFSConfig, reader: jspb.BinaryReader): GitLFSConfig;
+}
+
+export namespace GitLFSConfig {
+    export type AsObject = {
+        includeList: Array<string>,
+        excludeList: Array<string>,
     }
 }
 
@@ -370,6 +453,12 @@ export namespace GitStatus {
     }
 }
 
+export enum PartialCloneFilter {
+    NO_FILTER = 0,
+    BLOBLESS = 1,
+    TREELESS = 2,
+}
+
 export enum CloneTargetMode {
     REMOTE_HEAD = 0,
     REMOTE_COMMIT = 1,
@@ -30,7 +30,10 @@ goog.exportSymbol('proto.contentservice.FromBackupInitializer', null, global);
 goog.exportSymbol('proto.contentservice.GitAuthMethod', null, global);
 goog.exportSymbol('proto.contentservice.GitConfig', null, global);
 goog.exportSymbol('proto.contentservice.GitInitializer', null, global);
+goog.exportSymbol('proto.contentservice.GitLFSConfig', null, global);
 goog.exportSymbol('proto.contentservice.GitStatus', null, global);
+goog.exportSymbol('proto.contentservice.OCIArtifactInitializer', null, global);
+goog.exportSymbol('proto.contentservice.PartialCloneFilter', null, global);
 goog.exportSymbol('proto.contentservice.PrebuildInitializer', null, global);
 goog.exportSymbol('proto.contentservice.SnapshotInitializer', null, global);
 goog.exportSymbol('proto.contentservice.WorkspaceInitializer', null, global);
@@ -119,6 +122,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.contentservice.FileDownloadInitializer.FileInfo.displayName = 'proto.contentservice.FileDownloadInitializer.FileInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.OCIArtifactInitializer = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.contentservice.OCIArtifactInitializer, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.OCIArtifactInitializer.displayName = 'proto.contentservice.OCIArtifactInitializer';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -151,7 +175,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.contentservice.GitInitializer = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.GitInitializer.repeatedFields_, null);
 };
 goog.inherits(proto.contentservice.GitInitializer, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -161,6 +185,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.contentservice.GitInitializer.displayName = 'proto.contentservice.GitInitializer';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.GitLFSConfig = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.GitLFSConfig.repeatedFields_, null);
+};
+goog.inherits(proto.contentservice.GitLFSConfig, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.GitLFSConfig.displayName = 'proto.contentservice.GitLFSConfig';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -275,7 +320,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<!Array<number>>}
  * @const
  */
-proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7]];
+proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7,8]];
 
 /**
  * @enum {number}
@@ -288,7 +333,8 @@ proto.contentservice.WorkspaceInitializer.SpecCase = {
   PREBUILD: 4,
   COMPOSITE: 5,
   DOWNLOAD: 6,
-  BACKUP: 7
+  BACKUP: 7,
+  OCI: 8
 };
 
 /**
@@ -335,7 +381,8 @@ proto.contentservice.WorkspaceInitializer.toObject = function(includeInstance, m
     prebuild: (f = msg.getPrebuild()) && proto.contentservice.PrebuildInitializer.toObject(includeInstance, f),
     composite: (f = msg.getComposite()) && proto.contentservice.CompositeInitializer.toObject(includeInstance, f),
     download: (f = msg.getDownload()) && proto.contentservice.FileDownloadInitializer.toObject(includeInstance, f),
-    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f)
+    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f),
+    oci: (f = msg.getOci()) && proto.contentservice.OCIArtifactInitializer.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -407,6 +454,11 @@ proto.contentservice.WorkspaceInitializer.deserializeBinaryFromReader = function
       reader.readMessage(value,proto.contentservice.FromBackupInitializer.deserializeBinaryFromReader);
       msg.setBackup(value);
       break;
+    case 8:
+      var value = new proto.contentservice.OCIArtifactInitializer;
+      reader.readMessage(value,proto.contentservice.OCIArtifactInitializer.deserializeBinaryFromReader);
+      msg.setOci(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -492,6 +544,14 @@ proto.contentservice.WorkspaceInitializer.serializeBinaryToWriter = function(mes
       proto.contentservice.FromBackupInitializer.serializeBinaryToWriter
     );
   }
+  f = message.getOci();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.contentservice.OCIArtifactInitializer.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -754,6 +814,43 @@ proto.contentservice.WorkspaceInitializer.prototype.hasBackup = function() {
 };
 
 
+/**
+ * optional OCIArtifactInitializer oci = 8;
+ * @return {?proto.contentservice.OCIArtifactInitializer}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.getOci = function() {
+  return /** @type{?proto.contentservice.OCIArtifactInitializer} */ (
+    jspb.Message.getWrapperField(this, proto.contentservice.OCIArtifactInitializer, 8));
+};
+
+
+/**
+ * @param {?proto.contentservice.OCIArtifactInitializer|undefined} value
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+*/
+proto.contentservice.WorkspaceInitializer.prototype.setOci = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.contentservice.WorkspaceInitializer.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+ */
+proto.contentservice.WorkspaceInitializer.prototype.clearOci = function() {
+  return this.setOci(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.hasOci = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -1297,6 +1394,286 @@ proto.contentservice.FileDownloadInitializer.prototype.setTargetLocation = funct
 
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.OCIArtifactInitializer.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.OCIArtifactInitializer} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.OCIArtifactInitializer.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    digest: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    targetLocation: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    authUser: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    authPassword: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    authOts: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.OCIArtifactInitializer}
+ */
+proto.contentservice.OCIArtifactInitializer.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.OCIArtifactInitializer;
+  return proto.contentservice.OCIArtifactInitializer.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.OCIArtifactInitializer} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.OCIArtifactInitializer}
+ */
+proto.contentservice.OCIArtifactInitializer.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRef(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDigest(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetLocation(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthUser(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthPassword(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthOts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.OCIArtifactInitializer.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.OCIArtifactInitializer} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.OCIArtifactInitializer.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRef();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDigest();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTargetLocation();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAuthUser();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getAuthPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAuthOts();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ref = 1;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string digest = 2;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getDigest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setDigest = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string target_location = 3;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getTargetLocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setTargetLocation = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string auth_user = 4;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getAuthUser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setAuthUser = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string auth_password = 5;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getAuthPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setAuthPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string auth_ots = 6;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getAuthOts = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setAuthOts = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1398,6 +1775,15 @@ proto.contentservice.EmptyInitializer.serializeBinaryToWriter = function(message
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.GitInitializer.repeatedFields_ = [8];
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1432,7 +1818,10 @@ proto.contentservice.GitInitializer.toObject = function(includeInstance, msg) {
     targetMode: jspb.Message.getFieldWithDefault(msg, 3, 0),
     cloneTaget: jspb.Message.getFieldWithDefault(msg, 4, ""),
     checkoutLocation: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f)
+    config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f),
+    cloneFilter: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    sparseCheckoutList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+    lfs: (f = msg.getLfs()) && proto.contentservice.GitLFSConfig.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1494,6 +1883,19 @@ proto.contentservice.GitInitializer.deserializeBinaryFromReader = function(msg,
       reader.readMessage(value,proto.contentservice.GitConfig.deserializeBinaryFromReader);
       msg.setConfig(value);
       break;
+    case 7:
+      var value = /** @type {!proto.contentservice.PartialCloneFilter} */ (reader.readEnum());
+      msg.setCloneFilter(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addSparseCheckout(value);
+      break;
+    case 9:
+      var value = new proto.contentservice.GitLFSConfig;
+      reader.readMessage(value,proto.contentservice.GitLFSConfig.deserializeBinaryFromReader);
+      msg.setLfs(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1566,6 +1968,28 @@ proto.contentservice.GitInitializer.serializeBinaryToWriter = function(message,
       proto.contentservice.GitConfig.serializeBinaryToWriter
     );
   }
+  f = message.getCloneFilter();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+  f = message.getSparseCheckoutList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = message.getLfs();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.contentservice.GitLFSConfig.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -1696,6 +2120,303 @@ proto.contentservice.GitInitializer.prototype.hasConfig = function() {
 };
 
 
+/**
+ * optional PartialCloneFilter clone_filter = 7;
+ * @return {!proto.contentservice.PartialCloneFilter}
+ */
+proto.contentservice.GitInitializer.prototype.getCloneFilter = function() {
+  return /** @type {!proto.contentservice.PartialCloneFilter} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.PartialCloneFilter} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setCloneFilter = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+/**
+ * repeated string sparse_checkout = 8;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getSparseCheckoutList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setSparseCheckoutList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addSparseCheckout = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearSparseCheckoutList = function() {
+  return this.setSparseCheckoutList([]);
+};
+
+
+/**
+ * optional GitLFSConfig lfs = 9;
+ * @return {?proto.contentservice.GitLFSConfig}
+ */
+proto.contentservice.GitInitializer.prototype.getLfs = function() {
+  return /** @type{?proto.contentservice.GitLFSConfig} */ (
+    jspb.Message.getWrapperField(this, proto.contentservice.GitLFSConfig, 9));
+};
+
+
+/**
+ * @param {?proto.contentservice.GitLFSConfig|undefined} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+*/
+proto.contentservice.GitInitializer.prototype.setLfs = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearLfs = function() {
+  return this.setLfs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.contentservice.GitInitializer.prototype.hasLfs = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.GitLFSConfig.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.GitLFSConfig.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.GitLFSConfig.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.GitLFSConfig} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.GitLFSConfig.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    includeList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    excludeList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.GitLFSConfig}
+ */
+proto.contentservice.GitLFSConfig.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.GitLFSConfig;
+  return proto.contentservice.GitLFSConfig.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.GitLFSConfig} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.GitLFSConfig}
+ */
+proto.contentservice.GitLFSConfig.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addInclude(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addExclude(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.GitLFSConfig.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.GitLFSConfig.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.GitLFSConfig} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.GitLFSConfig.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIncludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getExcludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string include = 1;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitLFSConfig.prototype.getIncludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitLFSConfig} returns this
+ */
+proto.contentservice.GitLFSConfig.prototype.setIncludeList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitLFSConfig} returns this
+ */
+proto.contentservice.GitLFSConfig.prototype.addInclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitLFSConfig} returns this
+ */
+proto.contentservice.GitLFSConfig.prototype.clearIncludeList = function() {
+  return this.setIncludeList([]);
+};
+
+
+/**
+ * repeated string exclude = 2;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitLFSConfig.prototype.getExcludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitLFSConfig} returns this
+ */
+proto.contentservice.GitLFSConfig.prototype.setExcludeList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitLFSConfig} returns this
+ */
+proto.contentservice.GitLFSConfig.prototype.addExclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitLFSConfig} returns this
+ */
+proto.contentservice.GitLFSConfig.prototype.clearExcludeList = function() {
+  return this.setExcludeList([]);
+};
+
+
 
 
 
@@ -2884,6 +3605,15 @@ proto.contentservice.GitStatus.prototype.setTotalUnpushedCommits = function(valu
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.contentservice.PartialCloneFilter = {
+  NO_FILTER: 0,
+  BLOBLESS: 1,
+  TREELESS: 2
+};
+
 /**
  * @enum {number}
  */
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+// rewrapCmd re-wraps the data keys of encrypted workspace content after a key rotation
+var rewrapCmd = &cobra.Command{
+	Use:   "rewrap <owner>/<workspace>...",
+	Short: "Re-wraps the data keys of encrypted workspace content using the primary key encryption key",
+	Long: `Re-wraps the data keys of encrypted workspace content using the primary key encryption key.
+Run this after adding a new primary key to the keyring. Once all workspaces have been re-wrapped,
+the old key can be removed from the keyring.`,
+	Args:    cobra.MinimumNArgs(1),
+	Example: "rewrap test-owner/test-workspace",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg := getConfig()
+		if cfg.Storage.Encryption == nil {
+			return xerrors.Errorf("storage encryption is not configured")
+		}
+
+		ctx := context.Background()
+		for _, arg := range args {
+			owner, workspace, ok := strings.Cut(arg, "/")
+			if !ok {
+				return xerrors.Errorf("invalid workspace %s: must be <owner>/<workspace>", arg)
+			}
+
+			rs, err := storage.NewDirectAccess(&cfg.Storage)
+			if err != nil {
+				return err
+			}
+			err = rs.Init(ctx, owner, workspace, "")
+			if err != nil {
+				return err
+			}
+			ers, ok := rs.(*storage.EncryptedDirectAccess)
+			if !ok {
+				return xerrors.Errorf("storage does not support encryption")
+			}
+
+			n, err := ers.RewrapAll(ctx)
+			if err != nil {
+				return xerrors.Errorf("cannot rewrap %s: %w", arg, err)
+			}
+			log.WithField("owner", owner).WithField("workspace", workspace).WithField("rewrapped", n).Info("rewrapped workspace content")
+			fmt.Printf("%s: rewrapped %d objects\n", arg, n)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(rewrapCmd)
+}
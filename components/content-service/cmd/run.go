@@ -5,13 +5,27 @@
 package cmd
 
 import (
+	"context"
+	"net/http"
+	"strings"
+
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/scrubber"
 	"github.com/gitpod-io/gitpod/content-service/pkg/service"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/spf13/cobra"
 )
 
+// localStoragePrefix is the HTTP path under which we serve the signed URLs of the local storage backend.
+// The local storage BaseURL must point to this path.
+const localStoragePrefix = "/storage/"
+
+// exportPrefix is the HTTP path under which we serve workspace content exports. The export BaseURL must point to this path.
+const exportPrefix = "/export/"
+
 // runCmd starts the content service
 var runCmd = &cobra.Command{
 	Use:   "run",
@@ -20,14 +34,40 @@ var runCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg := getConfig()
 
-		srv, err := baseserver.New("content-service",
+		opts := []baseserver.Option{
 			baseserver.WithGRPC(&cfg.Service),
 			baseserver.WithVersion(Version),
-		)
+		}
+		if cfg.HTTP != nil {
+			opts = append(opts, baseserver.WithHTTP(cfg.HTTP))
+		}
+		srv, err := baseserver.New("content-service", opts...)
 		if err != nil {
 			log.WithError(err).Fatal("Failed to create server.")
 		}
 
+		if cfg.Storage.Kind == config.LocalStorage {
+			if cfg.HTTP == nil || cfg.Storage.LocalConfig == nil {
+				log.Fatal("local storage requires the http server and local storage to be configured")
+			}
+			handler, err := storage.NewLocalStorageHandler(*cfg.Storage.LocalConfig)
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create local storage handler")
+			}
+			srv.HTTPMux().Handle(localStoragePrefix, http.StripPrefix(strings.TrimSuffix(localStoragePrefix, "/"), handler))
+		}
+
+		if cfg.Export != nil {
+			if cfg.HTTP == nil {
+				log.Fatal("workspace content export requires the http server to be configured")
+			}
+			handler, err := service.NewExportHandler(cfg.Storage, *cfg.Export)
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create export handler")
+			}
+			srv.HTTPMux().Handle(exportPrefix, http.StripPrefix(strings.TrimSuffix(exportPrefix, "/"), handler))
+		}
+
 		contentService, err := service.NewContentService(cfg.Storage)
 		if err != nil {
 			log.WithError(err).Fatalf("Cannot create content service")
@@ -40,7 +80,7 @@ var runCmd = &cobra.Command{
 		}
 		api.RegisterBlobServiceServer(srv.GRPC(), blobService)
 
-		workspaceService, err := service.NewWorkspaceService(cfg.Storage)
+		workspaceService, err := service.NewWorkspaceService(cfg.Storage, cfg.Export)
 		if err != nil {
 			log.WithError(err).Fatalf("Cannot create workspace service")
 		}
@@ -58,6 +98,14 @@ var runCmd = &cobra.Command{
 		}
 		api.RegisterIDEPluginServiceServer(srv.GRPC(), idePluginService)
 
+		if cfg.Scrubber != nil {
+			backupScrubber, err := scrubber.New(cfg.Storage, *cfg.Scrubber, srv.MetricsRegistry())
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create backup integrity scrubber")
+			}
+			go backupScrubber.Start(context.Background())
+		}
+
 		err = srv.ListenAndServe()
 		if err != nil {
 			log.WithError(err).Fatal("Cannot start server")
@@ -0,0 +1,259 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"strings"
+
+	gitignore "github.com/monochromegane/go-gitignore"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+)
+
+// ExportFormat is the archive format produced by ExportTarbal
+type ExportFormat string
+
+const (
+	// ExportTar produces an uncompressed tar archive
+	ExportTar ExportFormat = "tar"
+
+	// ExportZip produces a zip archive
+	ExportZip ExportFormat = "zip"
+)
+
+// maxGitignoreSize is the size beyond which we ignore .gitignore files
+const maxGitignoreSize = 1024 * 1024
+
+// ExportOptions configure ExportTarbal
+type ExportOptions struct {
+	Format ExportFormat
+
+	// ExcludeGit excludes all .git directories and files
+	ExcludeGit bool
+
+	// Ignore excludes all paths it ignores. If nil, no paths are ignored.
+	Ignore *Gitignore
+}
+
+// Gitignore matches paths against the .gitignore files of a tar archive
+type Gitignore struct {
+	// matchers are keyed by the directory the .gitignore file is in, relative to the archive root
+	matchers map[string]gitignore.IgnoreMatcher
+}
+
+// ReadGitignore reads all .gitignore files of the tar archive src
+func ReadGitignore(ctx context.Context, src io.Reader) (res *Gitignore, err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "readGitignore")
+	defer tracing.FinishSpan(span, &err)
+
+	src, closeSrc, err := decompressStream(src)
+	if err != nil {
+		return nil, err
+	}
+	defer closeSrc()
+
+	res = &Gitignore{matchers: make(map[string]gitignore.IgnoreMatcher)}
+	tr := tar.NewReader(src)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read tar: %w", err)
+		}
+
+		name := exportName(hdr.Name)
+		if path.Base(name) != ".gitignore" || hdr.Typeflag != tar.TypeReg || hdr.Size > maxGitignoreSize {
+			continue
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read %s: %w", name, err)
+		}
+		dir := path.Dir(name)
+		res.matchers[dir] = gitignore.NewGitIgnoreFromReader(dir, bytes.NewReader(content))
+	}
+	span.LogKV("gitignoreFiles", len(res.matchers))
+
+	return res, nil
+}
+
+// Ignored returns true if the path or one of its parent directories is ignored
+func (g *Gitignore) Ignored(name string, isDir bool) bool {
+	segs := strings.Split(exportName(name), "/")
+	for i := range segs {
+		p := strings.Join(segs[:i+1], "/")
+		if g.match(p, isDir || i < len(segs)-1) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Gitignore) match(p string, isDir bool) bool {
+	// .gitignore files apply to all paths in their directory and below
+	for dir := path.Dir(p); ; dir = path.Dir(dir) {
+		if m, ok := g.matchers[dir]; ok && m.Match(p, isDir) {
+			return true
+		}
+		if dir == "." {
+			return false
+		}
+	}
+}
+
+// ExportTarbal converts the tar archive src to the format of the export, leaving out all excluded paths
+func ExportTarbal(ctx context.Context, src io.Reader, dst io.Writer, opts ExportOptions) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "exportTarbal")
+	span.LogKV("format", opts.Format, "excludeGit", opts.ExcludeGit, "gitignore", opts.Ignore != nil)
+	defer tracing.FinishSpan(span, &err)
+
+	var w exportWriter
+	switch opts.Format {
+	case ExportTar:
+		w = &tarExportWriter{tar.NewWriter(dst)}
+	case ExportZip:
+		w = &zipExportWriter{zip.NewWriter(dst)}
+	default:
+		return xerrors.Errorf("unsupported export format: %s", opts.Format)
+	}
+
+	src, closeSrc, err := decompressStream(src)
+	if err != nil {
+		return err
+	}
+	defer closeSrc()
+
+	var exported, excluded int
+	tr := tar.NewReader(src)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return xerrors.Errorf("cannot read tar: %w", err)
+		}
+
+		name := exportName(hdr.Name)
+		if name == "." {
+			continue
+		}
+		isDir := hdr.Typeflag == tar.TypeDir
+		if (opts.ExcludeGit && isGitPath(name)) || (opts.Ignore != nil && opts.Ignore.Ignored(name, isDir)) {
+			excluded++
+			continue
+		}
+
+		err = w.Write(name, hdr, tr)
+		if err != nil {
+			return xerrors.Errorf("cannot export %s: %w", name, err)
+		}
+		exported++
+	}
+	span.LogKV("exported", exported, "excluded", excluded)
+
+	return w.Close()
+}
+
+// exportName normalises the name of a tar entry to a relative path without trailing slash
+func exportName(name string) string {
+	return path.Clean(strings.TrimPrefix(name, "/"))
+}
+
+// isGitPath returns true if the path is within a .git directory or a .git file itself
+func isGitPath(name string) bool {
+	for _, seg := range strings.Split(name, "/") {
+		if seg == ".git" {
+			return true
+		}
+	}
+	return false
+}
+
+type exportWriter interface {
+	Write(name string, hdr *tar.Header, content io.Reader) error
+	Close() error
+}
+
+type tarExportWriter struct {
+	w *tar.Writer
+}
+
+func (t *tarExportWriter) Write(name string, hdr *tar.Header, content io.Reader) error {
+	h := *hdr
+	h.Name = name
+	if h.Typeflag == tar.TypeDir {
+		h.Name += "/"
+	}
+	err := t.w.WriteHeader(&h)
+	if err != nil {
+		return err
+	}
+	if h.Typeflag == tar.TypeReg {
+		_, err = io.Copy(t.w, content)
+	}
+	return err
+}
+
+func (t *tarExportWriter) Close() error {
+	return t.w.Close()
+}
+
+type zipExportWriter struct {
+	w *zip.Writer
+}
+
+func (z *zipExportWriter) Write(name string, hdr *tar.Header, content io.Reader) error {
+	switch hdr.Typeflag {
+	case tar.TypeReg, tar.TypeDir, tar.TypeSymlink:
+	default:
+		// zip has no notion of hard links or special files
+		log.WithField("name", name).WithField("type", hdr.Typeflag).Debug("skipping tar entry which cannot be represented in a zip archive")
+		return nil
+	}
+
+	zh, err := zip.FileInfoHeader(hdr.FileInfo())
+	if err != nil {
+		return err
+	}
+	zh.Name = name
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		zh.Name += "/"
+		zh.Method = zip.Store
+	case tar.TypeSymlink:
+		zh.Method = zip.Store
+		content = strings.NewReader(hdr.Linkname)
+	default:
+		zh.Method = zip.Deflate
+	}
+
+	f, err := z.w.CreateHeader(zh)
+	if err != nil {
+		return err
+	}
+	if hdr.Typeflag == tar.TypeDir {
+		return nil
+	}
+	_, err = io.Copy(f, content)
+	return err
+}
+
+func (z *zipExportWriter) Close() error {
+	return z.w.Close()
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExportTarbal(t *testing.T) {
+	type entry struct {
+		Name    string
+		Content string
+		Dir     bool
+	}
+	src := []entry{
+		{Name: "./", Dir: true},
+		{Name: "./repo/", Dir: true},
+		{Name: "./repo/main.go", Content: "package main"},
+		{Name: "./repo/build/", Dir: true},
+		{Name: "./repo/build/out.bin", Content: "binary"},
+		{Name: "./repo/sub/", Dir: true},
+		{Name: "./repo/sub/debug.log", Content: "log"},
+		{Name: "./repo/sub/keep.log", Content: "log"},
+		{Name: "./repo/sub/.gitignore", Content: "*.log\n!keep.log\n"},
+		{Name: "./repo/.git/", Dir: true},
+		{Name: "./repo/.git/HEAD", Content: "ref: refs/heads/main"},
+		// the .gitignore comes after the files it ignores, as it may in a backup
+		{Name: "./repo/.gitignore", Content: "/build\n"},
+	}
+
+	tests := []struct {
+		Name        string
+		Format      ExportFormat
+		ExcludeGit  bool
+		Gitignore   bool
+		Expectation []string
+	}{
+		{
+			Name:   "tar",
+			Format: ExportTar,
+			Expectation: []string{
+				"repo/", "repo/.git/", "repo/.git/HEAD", "repo/.gitignore", "repo/build/", "repo/build/out.bin",
+				"repo/main.go", "repo/sub/", "repo/sub/.gitignore", "repo/sub/debug.log", "repo/sub/keep.log",
+			},
+		},
+		{
+			Name:       "zip without git",
+			Format:     ExportZip,
+			ExcludeGit: true,
+			Expectation: []string{
+				"repo/", "repo/.gitignore", "repo/build/", "repo/build/out.bin",
+				"repo/main.go", "repo/sub/", "repo/sub/.gitignore", "repo/sub/debug.log", "repo/sub/keep.log",
+			},
+		},
+		{
+			Name:        "gitignore",
+			Format:      ExportTar,
+			ExcludeGit:  true,
+			Gitignore:   true,
+			Expectation: []string{"repo/", "repo/.gitignore", "repo/main.go", "repo/sub/", "repo/sub/.gitignore", "repo/sub/keep.log"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tw := tar.NewWriter(&buf)
+			for _, e := range src {
+				hdr := &tar.Header{Name: e.Name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(e.Content))}
+				if e.Dir {
+					hdr = &tar.Header{Name: e.Name, Typeflag: tar.TypeDir, Mode: 0755}
+				}
+				if err := tw.WriteHeader(hdr); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := tw.Write([]byte(e.Content)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			tw.Close()
+
+			opts := ExportOptions{Format: test.Format, ExcludeGit: test.ExcludeGit}
+			if test.Gitignore {
+				ig, err := ReadGitignore(context.Background(), bytes.NewReader(buf.Bytes()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				opts.Ignore = ig
+			}
+
+			var out bytes.Buffer
+			err := ExportTarbal(context.Background(), bytes.NewReader(buf.Bytes()), &out, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			switch test.Format {
+			case ExportTar:
+				tr := tar.NewReader(&out)
+				for {
+					hdr, err := tr.Next()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+					names = append(names, hdr.Name)
+				}
+			case ExportZip:
+				zr, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, f := range zr.File {
+					names = append(names, f.Name)
+					if f.Name != "repo/main.go" {
+						continue
+					}
+					rc, err := f.Open()
+					if err != nil {
+						t.Fatal(err)
+					}
+					content, _ := io.ReadAll(rc)
+					rc.Close()
+					if string(content) != "package main" {
+						t.Errorf("unexpected content of %s: %s", f.Name, content)
+					}
+				}
+			}
+			sort.Strings(names)
+
+			if diff := cmp.Diff(test.Expectation, names); diff != "" {
+				t.Errorf("unexpected exported entries (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
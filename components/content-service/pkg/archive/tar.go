@@ -6,6 +6,8 @@ package archive
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
 	"context"
 	"io"
 	"os"
@@ -16,6 +18,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/opentracing/opentracing-go"
 	"golang.org/x/sys/unix"
 	"golang.org/x/xerrors"
@@ -73,6 +76,12 @@ func ExtractTarbal(ctx context.Context, src io.Reader, dst string, opts ...TarOp
 		opt(&cfg)
 	}
 
+	src, closeSrc, err := decompressStream(src)
+	if err != nil {
+		return err
+	}
+	defer closeSrc()
+
 	pipeReader, pipeWriter := io.Pipe()
 	teeReader := io.TeeReader(src, pipeWriter)
 
@@ -156,6 +165,67 @@ func ExtractTarbal(ctx context.Context, src io.Reader, dst string, opts ...TarOp
 	return nil
 }
 
+// zstdMagic is the magic number every zstd frame starts with
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// decompressStream transparently decompresses zstd compressed tar streams, as produced by compressed stream uploads.
+// Uncompressed streams are passed through as is.
+func decompressStream(src io.Reader) (io.Reader, func(), error) {
+	br := bufio.NewReader(src)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, nil, xerrors.Errorf("cannot read tar stream: %w", err)
+	}
+	if !bytes.Equal(magic, zstdMagic) {
+		return br, func() {}, nil
+	}
+
+	dec, err := zstd.NewReader(br)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot decompress tar stream: %w", err)
+	}
+	return dec, dec.Close, nil
+}
+
+// VerifyTarbal reads the (possibly compressed) tar archive src without extracting it and fails if it is malformed
+// or truncated. src is read to its end so that readers which verify a digest on EOF can report a mismatch.
+// Returns the number of entries in the archive.
+func VerifyTarbal(ctx context.Context, src io.Reader) (entries int, err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "verifyTarbal")
+	defer tracing.FinishSpan(span, &err)
+
+	tarsrc, closeSrc, err := decompressStream(src)
+	if err != nil {
+		return 0, err
+	}
+	defer closeSrc()
+
+	tr := tar.NewReader(tarsrc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return entries, xerrors.Errorf("cannot read tar: %w", err)
+		}
+		_, err = io.Copy(io.Discard, tr)
+		if err != nil {
+			return entries, xerrors.Errorf("cannot read %s: %w", hdr.Name, err)
+		}
+		entries++
+	}
+
+	_, err = io.Copy(io.Discard, src)
+	if err != nil {
+		return entries, xerrors.Errorf("cannot read tar stream: %w", err)
+	}
+	span.LogKV("entries", entries)
+
+	return entries, nil
+}
+
 func toHostID(containerID int, idMap []IDMapping) int {
 	for _, m := range idMap {
 		if (containerID >= m.ContainerID) && (containerID <= (m.ContainerID + m.Size - 1)) {
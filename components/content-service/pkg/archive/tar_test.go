@@ -8,11 +8,14 @@ import (
 	"archive/tar"
 	"bytes"
 	"context"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"syscall"
 	"testing"
+
+	"github.com/klauspost/compress/zstd"
 )
 
 func TestExtractTarbal(t *testing.T) {
@@ -23,8 +26,9 @@ func TestExtractTarbal(t *testing.T) {
 		Mode        int
 	}
 	tests := []struct {
-		Name  string
-		Files []file
+		Name       string
+		Files      []file
+		Compressed bool
 	}{
 		{
 			Name: "simple-test",
@@ -37,6 +41,13 @@ func TestExtractTarbal(t *testing.T) {
 			Name:  "empty-tar",
 			Files: []file{},
 		},
+		{
+			Name: "zstd-compressed",
+			Files: []file{
+				{"file.txt", 1024, 33333, 0644},
+			},
+			Compressed: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -66,6 +77,18 @@ func TestExtractTarbal(t *testing.T) {
 			tw.Flush()
 			tw.Close()
 
+			var src io.Reader = buf
+			if test.Compressed {
+				var compressed bytes.Buffer
+				enc, err := zstd.NewWriter(&compressed)
+				if err != nil {
+					t.Fatalf("cannot prepare archive: %q", err)
+				}
+				_, _ = io.Copy(enc, buf)
+				enc.Close()
+				src = &compressed
+			}
+
 			wd, err := os.MkdirTemp("", "")
 			defer os.RemoveAll(wd)
 			if err != nil {
@@ -77,7 +100,7 @@ func TestExtractTarbal(t *testing.T) {
 				t.Fatalf("cannot extract tar content: %v", err)
 			}
 
-			err = ExtractTarbal(context.Background(), buf, targetFolder)
+			err = ExtractTarbal(context.Background(), src, targetFolder)
 			if err != nil {
 				t.Fatalf("cannot extract tar content: %v", err)
 			}
@@ -110,3 +133,51 @@ func TestExtractTarbal(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyTarbal(t *testing.T) {
+	var valid bytes.Buffer
+	tw := tar.NewWriter(&valid)
+	for _, name := range []string{"a.txt", "b.txt"} {
+		err := tw.WriteHeader(&tar.Header{Name: name, Size: 512, Mode: 0644, Typeflag: tar.TypeReg})
+		if err != nil {
+			t.Fatalf("cannot prepare archive: %q", err)
+		}
+		_, err = tw.Write(make([]byte, 512))
+		if err != nil {
+			t.Fatalf("cannot prepare archive: %q", err)
+		}
+	}
+	tw.Close()
+
+	var compressed bytes.Buffer
+	enc, err := zstd.NewWriter(&compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = enc.Write(valid.Bytes())
+	enc.Close()
+
+	tests := []struct {
+		Name    string
+		Content []byte
+		Entries int
+		Error   bool
+	}{
+		{Name: "valid", Content: valid.Bytes(), Entries: 2},
+		{Name: "zstd-compressed", Content: compressed.Bytes(), Entries: 2},
+		{Name: "empty", Content: nil},
+		{Name: "truncated", Content: valid.Bytes()[:700], Error: true},
+		{Name: "garbage", Content: bytes.Repeat([]byte("x"), 1024), Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			entries, err := VerifyTarbal(context.Background(), bytes.NewReader(test.Content))
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.Error && entries != test.Entries {
+				t.Errorf("expected %d entries, got %d", test.Entries, entries)
+			}
+		})
+	}
+}
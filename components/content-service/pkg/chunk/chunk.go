@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package chunk
+
+import (
+	"io"
+
+	"golang.org/x/xerrors"
+)
+
+// Config configures the chunk sizes produced by a Chunker
+type Config struct {
+	// MinSize is the minimum size of a chunk. Only the last chunk of a stream can be smaller.
+	MinSize int
+	// AvgSize is the size chunks are normalised towards. Must be a power of two.
+	AvgSize int
+	// MaxSize is the maximum size of a chunk.
+	MaxSize int
+}
+
+// DefaultConfig produces chunks between 1 and 16 MiB, averaging 4 MiB
+var DefaultConfig = Config{
+	MinSize: 1 << 20,
+	AvgSize: 4 << 20,
+	MaxSize: 16 << 20,
+}
+
+// Validate checks if the config is valid
+func (c Config) Validate() error {
+	if c.MinSize <= 0 || c.MinSize >= c.AvgSize || c.AvgSize >= c.MaxSize {
+		return xerrors.Errorf("chunk sizes must satisfy 0 < min < avg < max")
+	}
+	if c.AvgSize&(c.AvgSize-1) != 0 {
+		return xerrors.Errorf("average chunk size must be a power of two")
+	}
+	return nil
+}
+
+// Chunker splits a stream into content-defined chunks using the gear-based rolling hash of FastCDC.
+// Because chunk boundaries depend on the content rather than the offset, an insertion or deletion
+// in the stream only affects the chunks around the change.
+type Chunker struct {
+	r   io.Reader
+	cfg Config
+
+	maskS, maskL uint64
+
+	buf        []byte
+	start, end int
+	eof        bool
+}
+
+// NewChunker produces a new chunker reading from r
+func NewChunker(r io.Reader, cfg Config) (*Chunker, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var bits int
+	for s := cfg.AvgSize; s > 1; s >>= 1 {
+		bits++
+	}
+
+	return &Chunker{
+		r:     r,
+		cfg:   cfg,
+		maskS: highBitMask(bits + 1),
+		maskL: highBitMask(bits - 1),
+		buf:   make([]byte, cfg.MaxSize),
+	}, nil
+}
+
+// Next returns the next chunk of the stream, or io.EOF once the stream is exhausted.
+// The returned slice is only valid until the next call to Next.
+func (c *Chunker) Next() ([]byte, error) {
+	// move the remainder of the previous read to the front of the buffer and fill it up
+	n := copy(c.buf, c.buf[c.start:c.end])
+	c.start, c.end = 0, n
+	for !c.eof && c.end < len(c.buf) {
+		n, err := c.r.Read(c.buf[c.end:])
+		c.end += n
+		if err == io.EOF {
+			c.eof = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.end == 0 {
+		return nil, io.EOF
+	}
+
+	cut := c.cutpoint(c.buf[:c.end])
+	c.start = cut
+	return c.buf[:cut], nil
+}
+
+// cutpoint finds the end of the next chunk in data using normalised chunking
+func (c *Chunker) cutpoint(data []byte) int {
+	n := len(data)
+	if n <= c.cfg.MinSize {
+		return n
+	}
+	normal := c.cfg.AvgSize
+	if n < normal {
+		normal = n
+	}
+
+	var (
+		h uint64
+		i = c.cfg.MinSize
+	)
+	for ; i < normal; i++ {
+		h = (h << 1) + gear[data[i]]
+		if h&c.maskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		h = (h << 1) + gear[data[i]]
+		if h&c.maskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
+
+// highBitMask produces a mask with the n most significant bits set. We use the high bits
+// because after shifting, they depend on a larger window of input bytes than the low bits.
+func highBitMask(n int) uint64 {
+	return ^uint64(0) << (64 - n)
+}
+
+// gear maps each byte value to a pseudo-random 64 bit value. The table must never change,
+// otherwise chunk boundaries (and thus deduplication) change with it.
+var gear [256]uint64
+
+func init() {
+	// splitmix64 with a fixed seed gives us a stable, well-distributed table
+	seed := uint64(0x6769_7470_6f64_6364)
+	for i := range gear {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
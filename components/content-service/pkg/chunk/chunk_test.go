@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package chunk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+var testConfig = Config{
+	MinSize: 256,
+	AvgSize: 1024,
+	MaxSize: 4096,
+}
+
+func chunks(t *testing.T, data []byte) [][]byte {
+	c, err := NewChunker(bytes.NewReader(data), testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var res [][]byte
+	for {
+		chk, err := c.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, append([]byte(nil), chk...))
+	}
+	return res
+}
+
+func TestChunkerReassembles(t *testing.T) {
+	data := make([]byte, 256*1024)
+	rand.New(rand.NewSource(42)).Read(data)
+
+	chks := chunks(t, data)
+	if len(chks) < 2 {
+		t.Fatalf("expected more than one chunk, got %d", len(chks))
+	}
+	for i, chk := range chks {
+		if len(chk) > testConfig.MaxSize {
+			t.Errorf("chunk %d exceeds max size: %d", i, len(chk))
+		}
+		if i < len(chks)-1 && len(chk) < testConfig.MinSize {
+			t.Errorf("chunk %d is below min size: %d", i, len(chk))
+		}
+	}
+	if act := bytes.Join(chks, nil); !bytes.Equal(act, data) {
+		t.Errorf("reassembled chunks do not match input")
+	}
+}
+
+func TestChunkerIsContentDefined(t *testing.T) {
+	data := make([]byte, 256*1024)
+	rand.New(rand.NewSource(42)).Read(data)
+
+	// insert a few bytes in the middle of the stream
+	modified := append(append(append([]byte(nil), data[:100000]...), []byte("hello world")...), data[100000:]...)
+
+	known := make(map[[32]byte]struct{})
+	for _, chk := range chunks(t, data) {
+		known[sha256.Sum256(chk)] = struct{}{}
+	}
+	var changed int
+	mchks := chunks(t, modified)
+	for _, chk := range mchks {
+		if _, ok := known[sha256.Sum256(chk)]; !ok {
+			changed++
+		}
+	}
+	if changed > 2 {
+		t.Errorf("expected the insertion to affect at most two chunks, but %d of %d changed", changed, len(mchks))
+	}
+}
+
+func TestChunkerEmptyStream(t *testing.T) {
+	if chks := chunks(t, nil); len(chks) != 0 {
+		t.Errorf("expected no chunks for empty stream, got %d", len(chks))
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config Config
+		Valid  bool
+	}{
+		{Name: "default", Config: DefaultConfig, Valid: true},
+		{Name: "min above avg", Config: Config{MinSize: 2048, AvgSize: 1024, MaxSize: 4096}},
+		{Name: "avg not a power of two", Config: Config{MinSize: 256, AvgSize: 1000, MaxSize: 4096}},
+		{Name: "zero", Config: Config{}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if test.Valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !test.Valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
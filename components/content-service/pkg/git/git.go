@@ -51,6 +51,29 @@ const (
 	BasicAuth AuthMethod = "basic-auth"
 )
 
+// CloneFilter is the filter of a partial clone
+type CloneFilter string
+
+const (
+	// NoFilter makes a shallow clone instead of a partial one
+	NoFilter CloneFilter = ""
+
+	// BloblessFilter clones the complete history without any file content
+	BloblessFilter CloneFilter = "blob:none"
+
+	// TreelessFilter clones the complete history without any trees and file content
+	TreelessFilter CloneFilter = "tree:0"
+)
+
+// LFSConfig determines which Git LFS objects are fetched into a working copy
+type LFSConfig struct {
+	// Include lists the path patterns of the LFS objects to fetch. If empty, all LFS objects are fetched.
+	Include []string
+
+	// Exclude lists the path patterns of the LFS objects which are not fetched
+	Exclude []string
+}
+
 // CachingAuthProvider caches the first non-erroneous response of the delegate auth provider
 func CachingAuthProvider(d AuthProvider) AuthProvider {
 	var (
@@ -93,6 +116,15 @@ type Client struct {
 	// UpstreamCloneURI is the fork upstream of a repository
 	UpstreamRemoteURI string
 
+	// Filter makes Clone produce a partial clone with the complete history instead of a shallow one
+	Filter CloneFilter
+
+	// SparseCheckout lists the directories which are checked out in cone mode. If empty, all files are checked out.
+	SparseCheckout []string
+
+	// LFS makes FetchLFS fetch the Git LFS objects of the working copy. If nil, LFS pointer files are left as they are.
+	LFS *LFSConfig
+
 	// if true will run git command as gitpod user (should be executed as root that has access to sudo in this case)
 	RunAsGitpodUser bool
 }
@@ -179,6 +211,10 @@ func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcomman
 	}
 
 	env = append(env, "HOME=/home/gitpod")
+	if c.LFS != nil {
+		// LFS objects are fetched by FetchLFS, which honours the include/exclude patterns
+		env = append(env, "GIT_LFS_SKIP_SMUDGE=1")
+	}
 
 	fullArgs = append(fullArgs, subcommand)
 	fullArgs = append(fullArgs, args...)
@@ -338,7 +374,17 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 		log.WithError(err).Error("cannot create clone location")
 	}
 
-	args := []string{"--depth=1", "--shallow-submodules", c.RemoteURI}
+	var args []string
+	if c.Filter != NoFilter {
+		args = append(args, "--filter="+string(c.Filter))
+	} else {
+		args = append(args, "--depth=1", "--shallow-submodules")
+	}
+	if len(c.SparseCheckout) > 0 {
+		// only check out the files in the root directory until we've configured the sparse checkout
+		args = append(args, "--sparse")
+	}
+	args = append(args, c.RemoteURI)
 
 	for key, value := range c.Config {
 		args = append(args, "--config")
@@ -353,7 +399,106 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 
 	args = append(args, ".")
 
-	return c.Git(ctx, "clone", args...)
+	err = c.Git(ctx, "clone", args...)
+	if err != nil {
+		return err
+	}
+
+	return c.ConfigureSparseCheckout(ctx)
+}
+
+// ConfigureSparseCheckout restricts the working copy to the directories listed in SparseCheckout.
+// Does nothing if SparseCheckout is empty.
+func (c *Client) ConfigureSparseCheckout(ctx context.Context) (err error) {
+	if len(c.SparseCheckout) == 0 {
+		return nil
+	}
+
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "configureSparseCheckout")
+	span.LogKV("directories", strings.Join(c.SparseCheckout, ","))
+	defer tracing.FinishSpan(span, &err)
+
+	return c.Git(ctx, "sparse-checkout", append([]string{"set", "--cone"}, c.SparseCheckout...)...)
+}
+
+// IsPartialClone returns true if Clone produces a partial clone. Partial clones have the complete history,
+// hence fetches must not limit their depth, which would turn them into shallow clones.
+func (c *Client) IsPartialClone() bool {
+	return c.Filter != NoFilter
+}
+
+// FetchLFS installs Git LFS in the working copy and fetches the LFS objects of the checked out files.
+// It returns the number of bytes fetched. Does nothing if LFS is nil.
+func (c *Client) FetchLFS(ctx context.Context) (size int64, err error) {
+	if c.LFS == nil {
+		return 0, nil
+	}
+
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "fetchLFS")
+	span.LogKV("include", strings.Join(c.LFS.Include, ","), "exclude", strings.Join(c.LFS.Exclude, ","))
+	defer tracing.FinishSpan(span, &err)
+
+	err = c.Git(ctx, "lfs", "install", "--local")
+	if err != nil {
+		return 0, err
+	}
+	// we store the patterns in the repo config so that subsequent fetches in the workspace honour them as well
+	if len(c.LFS.Include) > 0 {
+		err = c.Git(ctx, "config", "lfs.fetchinclude", strings.Join(c.LFS.Include, ","))
+		if err != nil {
+			return 0, err
+		}
+	}
+	if len(c.LFS.Exclude) > 0 {
+		err = c.Git(ctx, "config", "lfs.fetchexclude", strings.Join(c.LFS.Exclude, ","))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	objectDir := filepath.Join(c.Location, ".git", "lfs", "objects")
+	before, err := dirSize(objectDir)
+	if err != nil {
+		return 0, err
+	}
+	err = c.Git(ctx, "lfs", "pull")
+	if err != nil {
+		return 0, err
+	}
+	after, err := dirSize(objectDir)
+	if err != nil {
+		return 0, err
+	}
+	size = after - before
+	span.LogKV("size", size)
+	return size, nil
+}
+
+// dirSize sums up the size of all files in a directory. Returns 0 if the directory does not exist.
+func dirSize(dir string) (size int64, err error) {
+	err = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if os.IsNotExist(err) {
+			return filepath.SkipDir
+		}
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, xerrors.Errorf("cannot determine size of %s: %w", dir, err)
+	}
+	return size, nil
 }
 
 // UpdateRemote performs a git fetch on the upstream remote URI
@@ -479,6 +479,109 @@ func TestGitStatusFromFiles(t *testing.T) {
 	}
 }
 
+func TestClone(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Filter         CloneFilter
+		SparseCheckout []string
+		Shallow        bool
+		Files          []string
+		Missing        []string
+	}{
+		{
+			Name:    "shallow clone",
+			Shallow: true,
+			Files:   []string{"root-file", "a/file", "b/file"},
+		},
+		{
+			Name:   "blobless clone",
+			Filter: BloblessFilter,
+			Files:  []string{"root-file", "a/file", "b/file"},
+		},
+		{
+			Name:   "treeless clone",
+			Filter: TreelessFilter,
+			Files:  []string{"root-file", "a/file", "b/file"},
+		},
+		{
+			Name:           "sparse checkout",
+			Filter:         BloblessFilter,
+			SparseCheckout: []string{"a"},
+			Files:          []string{"root-file", "a/file"},
+			Missing:        []string{"b/file"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+
+			remote, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(remote.Location)
+			for _, args := range [][]string{
+				{"init"},
+				{"config", "--local", "user.email", "foo@bar.com"},
+				{"config", "--local", "user.name", "foo bar"},
+				{"config", "--local", "uploadpack.allowFilter", "true"},
+			} {
+				if err := remote.Git(ctx, args[0], args[1:]...); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, fn := range []string{"root-file", "a/file", "b/file"} {
+				if err := os.MkdirAll(filepath.Join(remote.Location, filepath.Dir(fn)), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(remote.Location, fn), []byte(fn), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := remote.Git(ctx, "add", "."); err != nil {
+				t.Fatal(err)
+			}
+			if err := remote.Git(ctx, "commit", "-m", "foo"); err != nil {
+				t.Fatal(err)
+			}
+
+			c, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(c.Location)
+			// partial clones need a transport which supports filters, local clones ignore them
+			c.RemoteURI = "file://" + remote.Location
+			c.Filter = test.Filter
+			c.SparseCheckout = test.SparseCheckout
+			if err := c.Clone(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, fn := range test.Files {
+				if _, err := os.Stat(filepath.Join(c.Location, fn)); err != nil {
+					t.Errorf("expected %s to be checked out: %v", fn, err)
+				}
+			}
+			for _, fn := range test.Missing {
+				if _, err := os.Stat(filepath.Join(c.Location, fn)); err == nil {
+					t.Errorf("expected %s not to be checked out", fn)
+				}
+			}
+
+			_, err = os.Stat(filepath.Join(c.Location, ".git", "shallow"))
+			if shallow := err == nil; shallow != test.Shallow {
+				t.Errorf("expected shallow clone: %v, got %v", test.Shallow, shallow)
+			}
+			out, _ := c.GitWithOutput(ctx, nil, "config", "remote.origin.partialclonefilter")
+			if filter := CloneFilter(strings.TrimSpace(string(out))); filter != test.Filter {
+				t.Errorf("expected clone filter %q, got %q", test.Filter, filter)
+			}
+		})
+	}
+}
+
 func newGitClient(ctx context.Context) (*Client, error) {
 	loc, err := os.MkdirTemp("", "gittest")
 	if err != nil {
@@ -187,6 +187,14 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 		log.WithError(err).Warn("error while updating submodules - continuing")
 	}
 
+	lfsStart := time.Now()
+	lfsSize, err := ws.FetchLFS(ctx)
+	if err != nil {
+		err = checkGitStatus(err)
+		return src, nil, xerrors.Errorf("git initializer fetchLFS: %w", err)
+	}
+	lfsDuration := time.Since(lfsStart)
+
 	log.WithField("stage", "init").WithField("location", ws.Location).Debug("Git operations complete")
 
 	if fsErr == nil {
@@ -201,6 +209,13 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 			Size:     currentSize - initialSize,
 		}}
 	}
+	if ws.LFS != nil {
+		stats = append(stats, csapi.InitializerMetric{
+			Type:     "git-lfs",
+			Duration: lfsDuration,
+			Size:     uint64(lfsSize),
+		})
+	}
 	return
 }
 
@@ -240,7 +255,7 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 		//
 		// We don't recurse submodules because callers realizeCloneTarget() are expected to update submodules explicitly,
 		// and deal with any error appropriately (i.e. emit a warning rather than fail).
-		if err := ws.Git(ctx, "fetch", "--depth=1", "origin", "--recurse-submodules=no", ws.CloneTarget); err != nil {
+		if err := ws.Git(ctx, "fetch", append(ws.fetchDepth(1), "origin", "--recurse-submodules=no", ws.CloneTarget)...); err != nil {
 			log.WithError(err).WithField("remoteURI", ws.RemoteURI).WithField("branch", ws.CloneTarget).Error("Cannot fetch remote branch")
 			return err
 		}
@@ -258,7 +273,7 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 		// We did a shallow clone before, hence need to fetch the commit we are about to check out.
 		// Because we don't want to make the "git fetch" mechanism in supervisor more complicated,
 		// we'll just fetch the 20 commits right away.
-		if err := ws.Git(ctx, "fetch", "origin", ws.CloneTarget, "--depth=20"); err != nil {
+		if err := ws.Git(ctx, "fetch", append([]string{"origin", ws.CloneTarget}, ws.fetchDepth(20)...)...); err != nil {
 			return err
 		}
 
@@ -281,12 +296,46 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 	return nil
 }
 
+// fetchDepth produces the arguments which limit the depth of a fetch. Partial clones have the complete history
+// and must not become shallow, hence we don't limit their fetches.
+func (ws *GitInitializer) fetchDepth(depth int) []string {
+	if ws.IsPartialClone() {
+		return nil
+	}
+	return []string{fmt.Sprintf("--depth=%d", depth)}
+}
+
 func checkGitStatus(err error) error {
 	if err != nil {
 		if strings.Contains(err.Error(), "The requested URL returned error: 524") {
 			return fmt.Errorf("Git clone returned HTTP status 524 (see https://gitlab.com/gitlab-com/gl-infra/reliability/-/issues/8475). Please try restarting your workspace")
 		}
+
+		var giterr git.OpFailedError
+		if errors.As(err, &giterr) && giterr.Subcommand == "lfs" && len(giterr.Args) > 0 && giterr.Args[0] == "pull" {
+			return fmt.Errorf("Cannot fetch Git LFS objects, the LFS server failed: %s. Please check the LFS configuration of the repository and try restarting your workspace", lfsFailure(giterr.Output))
+		}
 	}
 
 	return err
 }
+
+// lfsFailure extracts the reason of a failed "git lfs pull" from its output
+func lfsFailure(output string) string {
+	var reason string
+	for _, l := range strings.Split(output, "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		reason = l
+		// the batch API response carries the most specific reason, e.g. the HTTP status of the LFS server
+		if strings.Contains(l, "batch response:") {
+			break
+		}
+	}
+	if reason == "" {
+		return "unknown error"
+	}
+	return reason
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+)
+
+func TestCheckGitStatus(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Error       error
+		Expectation string
+	}{
+		{
+			Name:  "no error",
+			Error: nil,
+		},
+		{
+			Name:        "unrelated error",
+			Error:       errors.New("something went wrong"),
+			Expectation: "something went wrong",
+		},
+		{
+			Name: "lfs server failure",
+			Error: xerrors.Errorf("fetch: %w", git.OpFailedError{
+				Subcommand: "lfs",
+				Args:       []string{"pull"},
+				ExecErr:    errors.New("exit status 2"),
+				Output:     "batch response: Fatal error: Server error: https://example.com/repo.git/info/lfs/objects/batch\n\nerror: failed to fetch some objects from 'https://example.com/repo.git/info/lfs'\n",
+			}),
+			Expectation: "Cannot fetch Git LFS objects, the LFS server failed: batch response: Fatal error: Server error: https://example.com/repo.git/info/lfs/objects/batch. Please check the LFS configuration of the repository and try restarting your workspace",
+		},
+		{
+			Name: "lfs install failure",
+			Error: git.OpFailedError{
+				Subcommand: "lfs",
+				Args:       []string{"install", "--local"},
+				ExecErr:    errors.New("exit status 1"),
+				Output:     "git: 'lfs' is not a git command.",
+			},
+			Expectation: "git lfs install --local failed (exit status 1): git: 'lfs' is not a git command.",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := checkGitStatus(test.Error)
+			if test.Expectation == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != test.Expectation {
+				t.Errorf("unexpected error: expected %q, got %v", test.Expectation, err)
+			}
+		})
+	}
+}
@@ -162,6 +162,12 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 		initializer, err = newFileDownloadInitializer(loc, ir.Download)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Backup); ok {
 		initializer, err = newFromBackupInitializer(loc, rs, ir.Backup)
+	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Oci); ok {
+		if ir.Oci == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing OCI artifact initializer spec")
+		}
+
+		initializer, err = newOCIArtifactInitializer(ctx, loc, ir.Oci)
 	} else {
 		initializer = &EmptyInitializer{}
 	}
@@ -220,7 +226,7 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 		log.WithError(fsErr).Error("could not get disk usage")
 	}
 
-	hasBackup, err := bi.RemoteStorage.Download(ctx, bi.Location, storage.DefaultBackup, mappings)
+	hasBackup, err := downloadBackup(ctx, bi.RemoteStorage, bi.Location, storage.DefaultBackup, mappings)
 	if !hasBackup {
 		if err != nil {
 			return src, nil, xerrors.Errorf("no backup found, error: %w", err)
@@ -247,6 +253,16 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 	return csapi.WorkspaceInitFromBackup, stats, nil
 }
 
+// downloadBackup restores a backup, preferring its chunked form if there is one
+func downloadBackup(ctx context.Context, rs storage.DirectDownloader, location, name string, mappings []archive.IDMapping) (found bool, err error) {
+	found, err = storage.DownloadChunked(ctx, rs, location, name, mappings)
+	if found || err != nil {
+		return found, err
+	}
+
+	return rs.Download(ctx, location, name, mappings)
+}
+
 // newGitInitializer creates a Git initializer based on the request.
 // Returns gRPC errors.
 func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitializer, forceGitpodUser bool) (*GitInitializer, error) {
@@ -268,6 +284,31 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid target mode: %v", req.TargetMode))
 	}
 
+	var filter git.CloneFilter
+	switch req.CloneFilter {
+	case csapi.PartialCloneFilter_NO_FILTER:
+		filter = git.NoFilter
+	case csapi.PartialCloneFilter_BLOBLESS:
+		filter = git.BloblessFilter
+	case csapi.PartialCloneFilter_TREELESS:
+		filter = git.TreelessFilter
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid clone filter: %v", req.CloneFilter))
+	}
+	for _, dir := range req.SparseCheckout {
+		if dir == "" || filepath.IsAbs(dir) || strings.HasPrefix(filepath.Clean(dir), "..") {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid sparse checkout directory: %q", dir))
+		}
+	}
+
+	var lfs *git.LFSConfig
+	if req.Lfs != nil {
+		lfs = &git.LFSConfig{
+			Include: req.Lfs.Include,
+			Exclude: req.Lfs.Exclude,
+		}
+	}
+
 	var authMethod = git.BasicAuth
 	if req.Config.Authentication == csapi.GitAuthMethod_NO_AUTH {
 		authMethod = git.NoAuth
@@ -301,6 +342,9 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 			RemoteURI:         req.RemoteUri,
 			UpstreamRemoteURI: req.Upstream_RemoteUri,
 			Config:            req.Config.CustomConfig,
+			Filter:            filter,
+			SparseCheckout:    req.SparseCheckout,
+			LFS:               lfs,
 			AuthMethod:        authMethod,
 			AuthProvider:      authProvider,
 			RunAsGitpodUser:   forceGitpodUser,
@@ -468,7 +512,7 @@ func InitializeWorkspace(ctx context.Context, location string, remoteStorage sto
 	}
 
 	// Run the initializer
-	hasBackup, err := remoteStorage.Download(ctx, location, storage.DefaultBackup, cfg.mappings)
+	hasBackup, err := downloadBackup(ctx, remoteStorage, location, storage.DefaultBackup, cfg.mappings)
 	if err != nil {
 		return src, nil, xerrors.Errorf("cannot restore backup: %w", err)
 	}
@@ -0,0 +1,498 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+)
+
+const (
+	// dockerHubRegistry is the registry host of references without an explicit registry
+	dockerHubRegistry = "registry-1.docker.io"
+
+	// maxManifestSize is the largest manifest we're willing to download
+	maxManifestSize = 4 * 1024 * 1024
+
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerLayerGzip    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	mediaTypeImageLayerZstd     = "application/vnd.oci.image.layer.v1.tar+zstd"
+)
+
+// ociHTTPClient talks to the registries. It has no overall timeout because blobs can be arbitrarily large,
+// but bounds each step of establishing a connection and waiting for a response so that an unresponsive
+// registry cannot stall the workspace start.
+var ociHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	},
+}
+
+// ociReference references an artifact in a registry
+type ociReference struct {
+	Host       string
+	Repository string
+	Tag        string
+	Digest     digest.Digest
+}
+
+// parseOCIReference parses references such as registry.example.com/team/starter:v1 and registry.example.com/team/starter@sha256:...
+// References without a registry host refer to Docker Hub.
+func parseOCIReference(ref string) (*ociReference, error) {
+	var res ociReference
+
+	name := ref
+	if idx := strings.Index(name, "@"); idx >= 0 {
+		dgst, err := digest.Parse(name[idx+1:])
+		if err != nil {
+			return nil, xerrors.Errorf("invalid digest in %s: %w", ref, err)
+		}
+		res.Digest = dgst
+		name = name[:idx]
+	}
+	if idx := strings.LastIndex(name, ":"); idx > strings.LastIndex(name, "/") {
+		res.Tag = name[idx+1:]
+		name = name[:idx]
+	}
+	if res.Tag == "" && res.Digest == "" {
+		res.Tag = "latest"
+	}
+
+	segs := strings.SplitN(name, "/", 2)
+	if len(segs) == 2 && (strings.ContainsAny(segs[0], ".:") || segs[0] == "localhost") {
+		res.Host = segs[0]
+		res.Repository = segs[1]
+	} else {
+		res.Host = dockerHubRegistry
+		res.Repository = name
+		if len(segs) == 1 {
+			res.Repository = "library/" + name
+		}
+	}
+	if res.Repository == "" || strings.ToLower(res.Repository) != res.Repository {
+		return nil, xerrors.Errorf("invalid repository in %s", ref)
+	}
+
+	return &res, nil
+}
+
+// ociArtifactInitializer pulls an OCI artifact from a registry and unpacks its tar layers into the workspace
+type ociArtifactInitializer struct {
+	Ref ociReference
+
+	// Digest pins the manifest of the artifact. If set, the artifact is pulled by this digest.
+	Digest digest.Digest
+
+	TargetLocation string
+	AuthProvider   git.AuthProvider
+	HTTPClient     *http.Client
+}
+
+// Run initializes the workspace
+func (ws *ociArtifactInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (src csapi.WorkspaceInitSource, metrics csapi.InitializerMetrics, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OCIArtifactInitializer.Run")
+	span.LogKV("host", ws.Ref.Host, "repository", ws.Ref.Repository, "tag", ws.Ref.Tag, "digest", ws.Digest)
+	defer tracing.FinishSpan(span, &err)
+	start := time.Now()
+	initialSize, fsErr := getFsUsage()
+	if fsErr != nil {
+		log.WithError(fsErr).Error("could not get disk usage")
+	}
+
+	reg := &ociRegistryClient{
+		Ref:          ws.Ref,
+		AuthProvider: ws.AuthProvider,
+		HTTPClient:   ws.HTTPClient,
+	}
+	manifest, err := reg.Manifest(ctx, ws.Digest)
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot resolve OCI artifact: %w", err)
+	}
+
+	err = os.MkdirAll(ws.TargetLocation, 0755)
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot create target location %s: %w", ws.TargetLocation, err)
+	}
+
+	var unpacked int
+	for _, layer := range manifest.Layers {
+		switch layer.MediaType {
+		case ocispec.MediaTypeImageLayer, ocispec.MediaTypeImageLayerGzip, mediaTypeDockerLayerGzip, mediaTypeImageLayerZstd:
+		default:
+			log.WithField("digest", layer.Digest).WithField("mediaType", layer.MediaType).Debug("skipping OCI artifact layer which is not a tarball")
+			continue
+		}
+
+		err = ws.unpackLayer(ctx, reg, layer, mappings)
+		if err != nil {
+			return src, nil, xerrors.Errorf("cannot unpack OCI artifact layer %s: %w", layer.Digest, err)
+		}
+		unpacked++
+	}
+	if unpacked == 0 {
+		return src, nil, xerrors.Errorf("OCI artifact has no tarball layers")
+	}
+
+	if fsErr == nil {
+		currentSize, fsErr := getFsUsage()
+		if fsErr != nil {
+			log.WithError(fsErr).Error("could not get disk usage")
+		}
+
+		metrics = csapi.InitializerMetrics{csapi.InitializerMetric{
+			Type:     "oci",
+			Duration: time.Since(start),
+			Size:     currentSize - initialSize,
+		}}
+	}
+
+	src = csapi.WorkspaceInitFromOther
+	return
+}
+
+func (ws *ociArtifactInitializer) unpackLayer(ctx context.Context, reg *ociRegistryClient, layer ocispec.Descriptor, mappings []archive.IDMapping) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "unpackLayer")
+	span.LogKV("digest", layer.Digest, "mediaType", layer.MediaType, "size", layer.Size)
+	defer tracing.FinishSpan(span, &err)
+
+	if err := layer.Digest.Validate(); err != nil {
+		return err
+	}
+
+	blob, err := reg.Blob(ctx, layer.Digest)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	// we verify the digest of the compressed blob while we unpack it
+	verifier := layer.Digest.Verifier()
+	in := io.TeeReader(blob, verifier)
+
+	var tarbal io.Reader = in
+	switch layer.MediaType {
+	case ocispec.MediaTypeImageLayerGzip, mediaTypeDockerLayerGzip:
+		gz, err := gzip.NewReader(in)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		tarbal = gz
+	}
+
+	err = archive.ExtractTarbal(ctx, tarbal, ws.TargetLocation, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+	if err != nil {
+		return err
+	}
+
+	// tar stops reading at the end-of-archive marker, hence there may be padding left which is part of the digest
+	_, err = io.Copy(io.Discard, in)
+	if err != nil {
+		return err
+	}
+	if !verifier.Verified() {
+		return xerrors.Errorf("digest mismatch")
+	}
+
+	return nil
+}
+
+// ociRegistryClient talks to a registry using the OCI distribution API
+type ociRegistryClient struct {
+	Ref          ociReference
+	AuthProvider git.AuthProvider
+	HTTPClient   *http.Client
+
+	authorization string
+}
+
+// Manifest downloads the manifest of the artifact. If dgst is not empty, the manifest is pulled by this digest.
+func (c *ociRegistryClient) Manifest(ctx context.Context, dgst digest.Digest) (*ocispec.Manifest, error) {
+	if dgst != "" && c.Ref.Digest != "" && dgst != c.Ref.Digest {
+		return nil, xerrors.Errorf("reference digest %s does not match pinned digest %s", c.Ref.Digest, dgst)
+	}
+	if dgst == "" {
+		dgst = c.Ref.Digest
+	}
+	ref := c.Ref.Tag
+	if dgst != "" {
+		ref = dgst.String()
+	}
+
+	resp, err := c.get(ctx, "manifests/"+ref, []string{ocispec.MediaTypeImageManifest, mediaTypeDockerManifest, ocispec.MediaTypeImageIndex, mediaTypeDockerManifestList})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxManifestSize {
+		return nil, xerrors.Errorf("manifest exceeds %d bytes", maxManifestSize)
+	}
+	if dgst != "" && dgst.Algorithm().FromBytes(body) != dgst {
+		return nil, xerrors.Errorf("manifest does not match digest %s", dgst)
+	}
+
+	var manifest ocispec.Manifest
+	err = json.Unmarshal(body, &manifest)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse manifest: %w", err)
+	}
+	mediaType := manifest.MediaType
+	if ct := resp.Header.Get("Content-Type"); ct != "" {
+		mediaType = ct
+	}
+	switch mediaType {
+	case ocispec.MediaTypeImageIndex, mediaTypeDockerManifestList:
+		return nil, xerrors.Errorf("%s references an image index, but an artifact manifest is required", ref)
+	}
+
+	return &manifest, nil
+}
+
+// Blob downloads a blob of the artifact's repository. The caller must verify its digest.
+func (c *ociRegistryClient) Blob(ctx context.Context, dgst digest.Digest) (io.ReadCloser, error) {
+	resp, err := c.get(ctx, "blobs/"+dgst.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// get requests a resource of the artifact's repository and authenticates if the registry asks for it
+func (c *ociRegistryClient) get(ctx context.Context, path string, accept []string) (*http.Response, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s", c.Ref.Host, c.Ref.Repository, path)
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(accept) > 0 {
+			req.Header.Set("Accept", strings.Join(accept, ", "))
+		}
+		if c.authorization != "" {
+			req.Header.Set("Authorization", c.authorization)
+		}
+		return c.HTTPClient.Do(req)
+	}
+
+	resp, err := do()
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		err = c.authorize(ctx, challenge)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = do()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("non-OK registry response for %s: %s", u, resp.Status)
+	}
+	return resp, nil
+}
+
+// authorize answers the authentication challenge of a registry
+func (c *ociRegistryClient) authorize(ctx context.Context, challenge string) error {
+	var user, pwd string
+	if c.AuthProvider != nil {
+		var err error
+		user, pwd, err = c.AuthProvider()
+		if err != nil {
+			return err
+		}
+	}
+
+	scheme, params := parseAuthChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if user == "" && pwd == "" {
+			return xerrors.Errorf("registry requires authentication, but no credentials were provided")
+		}
+		c.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+pwd))
+		return nil
+	case "bearer":
+		token, err := c.fetchToken(ctx, params, user, pwd)
+		if err != nil {
+			return xerrors.Errorf("cannot get registry token: %w", err)
+		}
+		c.authorization = "Bearer " + token
+		return nil
+	default:
+		return xerrors.Errorf("unsupported registry authentication challenge: %q", challenge)
+	}
+}
+
+// fetchToken fetches a bearer token from the registry's token service
+func (c *ociRegistryClient) fetchToken(ctx context.Context, params map[string]string, user, pwd string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", xerrors.Errorf("invalid token realm %q", params["realm"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", c.Ref.Repository)
+	}
+	q := realm.Query()
+	q.Set("scope", scope)
+	if service := params["service"]; service != "" {
+		q.Set("service", service)
+	}
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if user != "" || pwd != "" {
+		req.SetBasicAuth(user, pwd)
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", xerrors.Errorf("non-OK token response: %s", resp.Status)
+	}
+
+	var res struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return "", xerrors.Errorf("cannot parse token response: %w", err)
+	}
+	if res.Token != "" {
+		return res.Token, nil
+	}
+	if res.AccessToken != "" {
+		return res.AccessToken, nil
+	}
+	return "", xerrors.Errorf("token response contains no token")
+}
+
+// parseAuthChallenge parses a WWW-Authenticate header, e.g. Bearer realm="https://auth.example.com/token",service="registry.example.com"
+func parseAuthChallenge(challenge string) (scheme string, params map[string]string) {
+	params = make(map[string]string)
+	challenge = strings.TrimSpace(challenge)
+	idx := strings.IndexAny(challenge, " \t")
+	if idx < 0 {
+		return strings.ToLower(challenge), params
+	}
+	scheme = strings.ToLower(challenge[:idx])
+
+	rest := challenge[idx+1:]
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			return
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else if end := strings.Index(rest, ","); end >= 0 {
+			value, rest = rest[:end], rest[end:]
+		} else {
+			value, rest = rest, ""
+		}
+		params[key] = strings.TrimSpace(value)
+	}
+}
+
+// newOCIArtifactInitializer creates an OCI artifact initializer for a request
+func newOCIArtifactInitializer(ctx context.Context, loc string, req *csapi.OCIArtifactInitializer) (*ociArtifactInitializer, error) {
+	ref, err := parseOCIReference(req.Ref)
+	if err != nil {
+		return nil, err
+	}
+	var dgst digest.Digest
+	if req.Digest != "" {
+		dgst, err = digest.Parse(req.Digest)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid digest %s: %w", req.Digest, err)
+		}
+	}
+	target := filepath.Join(loc, req.TargetLocation)
+	if rel, err := filepath.Rel(loc, target); err != nil || strings.HasPrefix(rel, "..") {
+		return nil, xerrors.Errorf("invalid target location %s", req.TargetLocation)
+	}
+
+	// the auth provider must cache the OTS because we may need to authenticate several times,
+	// but can download the one-time-secret only once.
+	authProvider := git.CachingAuthProvider(func() (user string, pwd string, err error) {
+		if req.AuthOts != "" {
+			user, pwd, err = downloadOTS(ctx, req.AuthOts)
+			if err != nil {
+				log.WithField("location", loc).WithError(err).Error("cannot download registry auth OTS")
+				return "", "", xerrors.Errorf("cannot get OTS")
+			}
+			return
+		}
+		return req.AuthUser, req.AuthPassword, nil
+	})
+
+	return &ociArtifactInitializer{
+		Ref:            *ref,
+		Digest:         dgst,
+		TargetLocation: target,
+		AuthProvider:   authProvider,
+		HTTPClient:     ociHTTPClient,
+	}, nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	dgst := digest.FromString("foo")
+	tests := []struct {
+		Ref         string
+		Expectation *ociReference
+		Error       bool
+	}{
+		{Ref: "registry.example.com/team/starter:v1", Expectation: &ociReference{Host: "registry.example.com", Repository: "team/starter", Tag: "v1"}},
+		{Ref: "registry.example.com:5000/starter", Expectation: &ociReference{Host: "registry.example.com:5000", Repository: "starter", Tag: "latest"}},
+		{Ref: "localhost/starter@" + dgst.String(), Expectation: &ociReference{Host: "localhost", Repository: "starter", Digest: dgst}},
+		{Ref: "registry.example.com/starter:v1@" + dgst.String(), Expectation: &ociReference{Host: "registry.example.com", Repository: "starter", Tag: "v1", Digest: dgst}},
+		{Ref: "team/starter", Expectation: &ociReference{Host: dockerHubRegistry, Repository: "team/starter", Tag: "latest"}},
+		{Ref: "ubuntu:22.04", Expectation: &ociReference{Host: dockerHubRegistry, Repository: "library/ubuntu", Tag: "22.04"}},
+		{Ref: "registry.example.com/Starter", Error: true},
+		{Ref: "registry.example.com/starter@sha256:foo", Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Ref, func(t *testing.T) {
+			act, err := parseOCIReference(test.Ref)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected reference (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseAuthChallenge(t *testing.T) {
+	scheme, params := parseAuthChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:team/starter:pull,push"`)
+	if scheme != "bearer" {
+		t.Errorf("unexpected scheme %s", scheme)
+	}
+	if diff := cmp.Diff(map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:team/starter:pull,push",
+	}, params); diff != "" {
+		t.Errorf("unexpected params (-want +got):\n%s", diff)
+	}
+}
+
+func TestOCIArtifactInitializer(t *testing.T) {
+	reg := newTestRegistry(t, map[string]string{
+		"hello.txt":     "hello world",
+		"data/set.json": "{}",
+	})
+	ref := reg.Host + "/team/starter"
+
+	tests := []struct {
+		Name        string
+		Ref         string
+		Digest      digest.Digest
+		User        string
+		Password    string
+		Error       string
+		Expectation map[string]string
+	}{
+		{
+			Name:        "pull by tag",
+			Ref:         ref + ":v1",
+			User:        "user",
+			Password:    "secret",
+			Expectation: map[string]string{"hello.txt": "hello world", "data/set.json": "{}"},
+		},
+		{
+			Name:        "pinned digest",
+			Ref:         ref + ":v1",
+			Digest:      reg.ManifestDigest,
+			User:        "user",
+			Password:    "secret",
+			Expectation: map[string]string{"hello.txt": "hello world", "data/set.json": "{}"},
+		},
+		{
+			Name:     "unknown pinned digest",
+			Ref:      ref + ":v1",
+			Digest:   digest.FromString("something else"),
+			User:     "user",
+			Password: "secret",
+			Error:    "404 Not Found",
+		},
+		{
+			Name:     "wrong credentials",
+			Ref:      ref + ":v1",
+			User:     "user",
+			Password: "wrong",
+			Error:    "cannot get registry token",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			parsed, err := parseOCIReference(test.Ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			loc := t.TempDir()
+			init := &ociArtifactInitializer{
+				Ref:            *parsed,
+				Digest:         test.Digest,
+				TargetLocation: filepath.Join(loc, "starter"),
+				AuthProvider:   func() (string, string, error) { return test.User, test.Password, nil },
+				HTTPClient:     reg.Client,
+			}
+			_, _, err = init.Run(context.Background(), nil)
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for name, content := range test.Expectation {
+				act, err := os.ReadFile(filepath.Join(loc, "starter", name))
+				if err != nil {
+					t.Errorf("cannot read %s: %v", name, err)
+					continue
+				}
+				if string(act) != content {
+					t.Errorf("unexpected content of %s: %s", name, act)
+				}
+			}
+		})
+	}
+}
+
+type testRegistry struct {
+	Host           string
+	Client         *http.Client
+	ManifestDigest digest.Digest
+}
+
+// newTestRegistry serves an artifact with a single gzipped tar layer at team/starter:v1 and requires a bearer token for user:secret
+func newTestRegistry(t *testing.T, files map[string]string) *testRegistry {
+	var layer bytes.Buffer
+	gz := gzip.NewWriter(&layer)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if dir := filepath.Dir(name); dir != "." {
+			_ = tw.WriteHeader(&tar.Header{Name: dir + "/", Typeflag: tar.TypeDir, Mode: 0755})
+		}
+		_ = tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))})
+		_, _ = tw.Write([]byte(content))
+	}
+	tw.Close()
+	gz.Close()
+	layerDigest := digest.FromBytes(layer.Bytes())
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config: ocispec.Descriptor{
+			MediaType: "application/vnd.oci.empty.v1+json",
+			Digest:    digest.FromString("{}"),
+			Size:      2,
+		},
+		Layers: []ocispec.Descriptor{
+			{MediaType: "text/plain", Digest: digest.FromString("readme"), Size: 6},
+			{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: layerDigest, Size: int64(layer.Len())},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifestDigest := digest.FromBytes(manifest)
+
+	const token = "test-token"
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if user, pwd, ok := r.BasicAuth(); !ok || user != "user" || pwd != "secret" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, srv.URL))
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/team/starter/manifests/v1", "/v2/team/starter/manifests/" + manifestDigest.String():
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			_, _ = w.Write(manifest)
+		case "/v2/team/starter/blobs/" + layerDigest.String():
+			_, _ = w.Write(layer.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return &testRegistry{
+		Host:           strings.TrimPrefix(srv.URL, "https://"),
+		Client:         srv.Client(),
+		ManifestDigest: manifestDigest,
+	}
+}
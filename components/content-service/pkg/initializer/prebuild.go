@@ -167,6 +167,11 @@ func runGitInit(ctx context.Context, gInit *GitInitializer) (commitChanged bool,
 		if err != nil {
 			return commitChanged, xerrors.Errorf("prebuild initializer: %w", err)
 		}
+		// The prebuild carries the sparse checkout it was made with. Make sure the workspace matches its own configuration.
+		err = gInit.ConfigureSparseCheckout(ctx)
+		if err != nil {
+			return commitChanged, xerrors.Errorf("prebuild initializer: %w", err)
+		}
 		statusAfter, err := gInit.Status(ctx)
 		if err != nil {
 			log.WithError(err).Warn("couldn't run git status - continuing")
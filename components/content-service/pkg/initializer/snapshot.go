@@ -45,7 +45,11 @@ func (s *SnapshotInitializer) Run(ctx context.Context, mappings []archive.IDMapp
 		return src, nil, nil
 	}
 
-	ok, err := s.Storage.DownloadSnapshot(ctx, s.Location, s.Snapshot, mappings)
+	// snapshots taken with chunked backups enabled come with a chunk index, all others are plain tar archives
+	ok, err := storage.DownloadChunked(ctx, s.Storage, s.Location, s.Snapshot, mappings)
+	if err == nil && !ok {
+		ok, err = s.Storage.DownloadSnapshot(ctx, s.Location, s.Snapshot, mappings)
+	}
 	if err != nil {
 		return src, nil, xerrors.Errorf("snapshot initializer: %w", err)
 	}
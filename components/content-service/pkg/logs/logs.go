@@ -28,6 +28,14 @@ const (
 
 	// UploadedHeadlessLogPathPrefix is the prefix under which headless logs are stored inside an instance
 	UploadedHeadlessLogPathPrefix = "logs"
+
+	// TerminalRecordingLocation is the path in the workspace where terminal recordings are persisted
+	TerminalRecordingLocation = TerminalStoreLocation + "/recordings"
+
+	// TerminalRecordingFileExtension is the file extension of terminal recordings in the asciicast v2 format
+	TerminalRecordingFileExtension = ".cast"
+
+	uploadedTerminalRecordingPathPrefix = UploadedHeadlessLogPathPrefix + "/recordings"
 )
 
 // UploadedHeadlessLogPath returns the path relative to the workspace instance
@@ -35,6 +43,11 @@ func UploadedHeadlessLogPath(taskID string) string {
 	return fmt.Sprintf("%s/%s", UploadedHeadlessLogPathPrefix, taskID)
 }
 
+// UploadedTerminalRecordingPath returns the path of a terminal recording relative to the workspace instance
+func UploadedTerminalRecordingPath(name string) string {
+	return fmt.Sprintf("%s/%s", uploadedTerminalRecordingPathPrefix, name)
+}
+
 // PrebuildLogFileName is the absolute path to the file containing the output of the prebuild log for the given task in recent workspaces
 func PrebuildLogFileName(storeLocation string, taskId string) string {
 	return storeLocation + "/" + prebuildLogFilePrefix + taskId
@@ -100,3 +113,20 @@ func ParseTaskIDFromPrebuildLogFilePath(filePath string) (string, error) {
 
 	return streamID, nil
 }
+
+// ListTerminalRecordings lists all terminal recordings in the workspace. Location is assumed to be the base dir of the workspace session
+func ListTerminalRecordings(ctx context.Context, location string) (filePaths []string, err error) {
+	absDirPath := filepath.Join(location, strings.TrimPrefix(TerminalRecordingLocation, "/workspace"))
+	files, err := os.ReadDir(absDirPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != TerminalRecordingFileExtension {
+			continue
+		}
+		filePaths = append(filePaths, filepath.Join(absDirPath, file.Name()))
+	}
+	return filePaths, nil
+}
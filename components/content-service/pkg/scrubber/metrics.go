@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package scrubber
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	runs            *prometheus.CounterVec
+	verifiedObjects prometheus.Counter
+	verifiedBytes   prometheus.Counter
+	findings        *prometheus.GaugeVec
+	quarantined     prometheus.Counter
+	lastRun         prometheus.Gauge
+}
+
+// newMetrics creates the scrubber metrics. reg can be nil
+func newMetrics(reg prometheus.Registerer) (*metrics, error) {
+	m := &metrics{
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "content_service_scrubber_runs_total",
+			Help: "Number of backup integrity scrubber runs",
+		}, []string{"outcome"}),
+		verifiedObjects: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "content_service_scrubber_verified_objects_total",
+			Help: "Number of manifests, chunk indices and archives verified by the scrubber",
+		}),
+		verifiedBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "content_service_scrubber_verified_bytes_total",
+			Help: "Number of bytes read from the remote storage by the scrubber",
+		}),
+		findings: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "content_service_scrubber_findings",
+			Help: "Number of corrupt, missing and orphaned objects found in the latest scrubber run",
+		}, []string{"kind"}),
+		quarantined: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "content_service_scrubber_quarantined_objects_total",
+			Help: "Number of objects moved to quarantine by the scrubber",
+		}),
+		lastRun: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "content_service_scrubber_last_run_timestamp_seconds",
+			Help: "Time the latest scrubber run finished",
+		}),
+	}
+	if reg == nil {
+		return m, nil
+	}
+
+	for _, c := range []prometheus.Collector{m.runs, m.verifiedObjects, m.verifiedBytes, m.findings, m.quarantined, m.lastRun} {
+		err := reg.Register(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *metrics) observe(rep *Report, err error) {
+	outcome := "success"
+	if err != nil || rep.FailedOwners > 0 {
+		outcome = "failure"
+	}
+	m.runs.WithLabelValues(outcome).Inc()
+	m.lastRun.Set(float64(rep.Finished.Unix()))
+	if err != nil {
+		return
+	}
+
+	for _, kind := range []FindingKind{FindingCorrupt, FindingMissing, FindingOrphaned} {
+		m.findings.WithLabelValues(string(kind)).Set(float64(rep.Count(kind)))
+	}
+}
@@ -0,0 +1,717 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package scrubber
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	// defaultInterval is the time between two scrubber runs if none is configured
+	defaultInterval = 24 * time.Hour
+
+	// ownerProbe is the workspace ID we initialize the storage with when we need access to all workspaces of an owner
+	ownerProbe = "scrubber"
+)
+
+// FindingKind classifies the problems the scrubber finds
+type FindingKind string
+
+const (
+	// FindingCorrupt marks an object which does not match its digest, or is not a readable archive, manifest or chunk index
+	FindingCorrupt FindingKind = "corrupt"
+
+	// FindingMissing marks an object which is referenced by a manifest or chunk index but does not exist
+	FindingMissing FindingKind = "missing"
+
+	// FindingOrphaned marks a backup generation or chunk which is not referenced by any manifest or chunk index
+	FindingOrphaned FindingKind = "orphaned"
+)
+
+// Finding is a problem with a single object
+type Finding struct {
+	Kind        FindingKind `json:"kind"`
+	OwnerID     string      `json:"ownerID"`
+	WorkspaceID string      `json:"workspaceID"`
+	Bucket      string      `json:"bucket"`
+	Object      string      `json:"object"`
+	Reason      string      `json:"reason,omitempty"`
+	Quarantined bool        `json:"quarantined,omitempty"`
+}
+
+// Report summarizes a scrubber run
+type Report struct {
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+
+	Owners       int   `json:"owners"`
+	FailedOwners int   `json:"failedOwners"`
+	Workspaces   int   `json:"workspaces"`
+	Objects      int   `json:"objects"`
+	Bytes        int64 `json:"bytes"`
+
+	Findings []Finding `json:"findings"`
+}
+
+// Count returns the number of findings of a kind
+func (r *Report) Count(kind FindingKind) (n int) {
+	for _, f := range r.Findings {
+		if f.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// Scrubber periodically verifies that the workspace backups and snapshots in the remote storage are restorable
+type Scrubber struct {
+	Config config.ScrubberConfig
+
+	storage   func() (storage.DirectAccess, error)
+	presigned storage.PresignedAccess
+	metrics   *metrics
+
+	// suspects are the orphans found in the previous run, keyed by bucket and object name.
+	// We only quarantine orphans found in two consecutive runs so that we never race an ongoing upload.
+	suspects map[string]struct{}
+}
+
+// New creates a new scrubber. reg can be nil
+func New(storageCfg config.StorageConfig, cfg config.ScrubberConfig, reg prometheus.Registerer) (*Scrubber, error) {
+	newStorage := func() (storage.DirectAccess, error) {
+		return storage.NewDirectAccess(&storageCfg)
+	}
+	_, err := newStorage()
+	if err != nil {
+		return nil, xerrors.Errorf("cannot use configured storage: %w", err)
+	}
+
+	var presigned storage.PresignedAccess
+	if cfg.Quarantine {
+		presigned, err = storage.NewPresignedAccess(&storageCfg)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot use configured storage: %w", err)
+		}
+	}
+
+	m, err := newMetrics(reg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Scrubber{
+		Config:    cfg,
+		storage:   newStorage,
+		presigned: presigned,
+		metrics:   m,
+	}, nil
+}
+
+// Start runs the scrubber right away and then every interval until the context is canceled
+func (s *Scrubber) Start(ctx context.Context) {
+	interval := time.Duration(s.Config.Interval)
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		_, err := s.Run(ctx)
+		if err != nil {
+			log.WithError(err).Error("backup integrity scrubber run failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Run verifies the content of all owners once and writes the report
+func (s *Scrubber) Run(ctx context.Context) (rep *Report, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Scrubber.Run")
+	defer tracing.FinishSpan(span, &err)
+
+	rep = &Report{Started: time.Now(), Findings: []Finding{}}
+	defer func() {
+		rep.Finished = time.Now()
+		s.metrics.observe(rep, err)
+	}()
+
+	owners := s.Config.Owners
+	if len(owners) == 0 {
+		rs, err := s.storage()
+		if err != nil {
+			return rep, err
+		}
+		owners, err = storage.ListOwners(ctx, rs)
+		if err != nil {
+			return rep, err
+		}
+	}
+
+	suspects := make(map[string]struct{})
+	for _, owner := range owners {
+		if ctx.Err() != nil {
+			return rep, ctx.Err()
+		}
+
+		err := s.scrubOwner(ctx, rep, owner, suspects)
+		if err != nil {
+			log.WithError(err).WithField("owner", owner).Warn("cannot scrub content of owner")
+			rep.FailedOwners++
+			continue
+		}
+		rep.Owners++
+	}
+	s.suspects = suspects
+	rep.Finished = time.Now()
+
+	log.WithFields(map[string]interface{}{
+		"owners":       rep.Owners,
+		"failedOwners": rep.FailedOwners,
+		"workspaces":   rep.Workspaces,
+		"objects":      rep.Objects,
+		"bytes":        rep.Bytes,
+		"corrupt":      rep.Count(FindingCorrupt),
+		"missing":      rep.Count(FindingMissing),
+		"orphaned":     rep.Count(FindingOrphaned),
+		"duration":     rep.Finished.Sub(rep.Started).String(),
+	}).Info("backup integrity scrubber run finished")
+
+	if s.Config.ReportPath != "" {
+		err = writeReport(s.Config.ReportPath, rep)
+		if err != nil {
+			return rep, err
+		}
+	}
+
+	return rep, nil
+}
+
+func writeReport(fn string, rep *Report) error {
+	content, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return xerrors.Errorf("cannot marshal report: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fn), ".scrubber-report-*")
+	if err != nil {
+		return xerrors.Errorf("cannot write report: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if err != nil {
+		tmp.Close()
+		return xerrors.Errorf("cannot write report: %w", err)
+	}
+	err = tmp.Close()
+	if err != nil {
+		return xerrors.Errorf("cannot write report: %w", err)
+	}
+	err = os.Rename(tmp.Name(), fn)
+	if err != nil {
+		return xerrors.Errorf("cannot write report: %w", err)
+	}
+	return nil
+}
+
+// workspace is the content of a single workspace found in the remote storage
+type workspace struct {
+	OwnerID string
+	ID      string
+	Bucket  string
+	// Prefix is the name prefix of all objects of the workspace
+	Prefix string
+	// Objects are the names of all objects of the workspace relative to Prefix
+	Objects map[string]struct{}
+}
+
+func (ws *workspace) has(name string) bool {
+	_, ok := ws.Objects[name]
+	return ok
+}
+
+// workspaceDownloader downloads objects relative to a workspace other than the one the storage was initialized for
+type workspaceDownloader struct {
+	storage.DirectDownloader
+	ws *workspace
+}
+
+func (d workspaceDownloader) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	if !strings.Contains(name, "@") {
+		name = d.ws.Prefix + name + "@" + d.ws.Bucket
+	}
+	return d.DirectDownloader.DownloadObject(ctx, name)
+}
+
+func (s *Scrubber) scrubOwner(ctx context.Context, rep *Report, owner string, suspects map[string]struct{}) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Scrubber.scrubOwner")
+	span.SetTag("owner", owner)
+	defer tracing.FinishSpan(span, &err)
+
+	rs, err := s.storage()
+	if err != nil {
+		return err
+	}
+	err = rs.Init(ctx, owner, ownerProbe, "")
+	if err != nil {
+		return err
+	}
+
+	bkt, prefix := storage.OwnerLocation(rs)
+	objs, err := rs.ListObjects(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	workspaces := make(map[string]*workspace)
+	for _, obj := range objs {
+		id, name, ok := strings.Cut(strings.TrimPrefix(obj, prefix), "/")
+		if !ok || id == "" || name == "" || strings.HasPrefix(name, storage.QuarantinePrefix) {
+			continue
+		}
+		ws, ok := workspaces[id]
+		if !ok {
+			ws = &workspace{
+				OwnerID: owner,
+				ID:      id,
+				Bucket:  bkt,
+				Prefix:  prefix + id + "/",
+				Objects: make(map[string]struct{}),
+			}
+			workspaces[id] = ws
+		}
+		ws.Objects[name] = struct{}{}
+	}
+
+	ids := make([]string, 0, len(workspaces))
+	for id := range workspaces {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		ws := workspaces[id]
+		findings := s.scrubWorkspace(ctx, rep, workspaceDownloader{DirectDownloader: rs, ws: ws}, ws)
+		for i := range findings {
+			f := &findings[i]
+			if f.Kind == FindingOrphaned {
+				key := f.Bucket + "/" + f.Object
+				_, suspect := s.suspects[key]
+				suspects[key] = struct{}{}
+				if !suspect {
+					continue
+				}
+			}
+			if !s.Config.Quarantine || f.Kind == FindingMissing {
+				continue
+			}
+
+			err := s.quarantine(ctx, ws, strings.TrimPrefix(f.Object, ws.Prefix))
+			if err != nil {
+				log.WithError(err).WithFields(log.OWI(owner, id, "")).WithField("object", f.Object).Warn("cannot quarantine object")
+				continue
+			}
+			f.Quarantined = true
+			s.metrics.quarantined.Inc()
+		}
+		for _, f := range findings {
+			log.WithFields(log.OWI(owner, id, "")).WithField("kind", f.Kind).WithField("object", f.Object).WithField("reason", f.Reason).WithField("quarantined", f.Quarantined).Warn("backup integrity scrubber finding")
+		}
+
+		rep.Workspaces++
+		rep.Findings = append(rep.Findings, findings...)
+	}
+	return nil
+}
+
+// scrubWorkspace verifies the manifests, chunk indices and archives of a workspace
+func (s *Scrubber) scrubWorkspace(ctx context.Context, rep *Report, dl workspaceDownloader, ws *workspace) (findings []Finding) {
+	var (
+		referenced = make(map[string]struct{})
+		// layers are checked against their content manifest and are not verified again as plain archives
+		layers  = make(map[string]struct{})
+		missing = make(map[string]struct{})
+		corrupt = make(map[string]struct{})
+		// attributable is false if a manifest or chunk index could not be read, in which case we don't know which objects are orphans
+		attributable = true
+	)
+	report := func(kind FindingKind, name string, reason string) {
+		findings = append(findings, Finding{
+			Kind:        kind,
+			OwnerID:     ws.OwnerID,
+			WorkspaceID: ws.ID,
+			Bucket:      ws.Bucket,
+			Object:      ws.Prefix + name,
+			Reason:      reason,
+		})
+	}
+	verifiedObject := func(name string) {
+		rep.Objects++
+		s.metrics.verifiedObjects.Inc()
+	}
+
+	if ws.has(storage.BackupManifest) {
+		mf, err := storage.DownloadBackupManifest(ctx, dl)
+		if err != nil {
+			report(FindingCorrupt, storage.BackupManifest, err.Error())
+			attributable = false
+		} else {
+			verifiedObject(storage.BackupManifest)
+			for _, b := range mf.Backups {
+				referenced[b.Name] = struct{}{}
+				referenced[b.Name+storage.ChunkIndexSuffix] = struct{}{}
+				if !ws.has(b.Name) && !ws.has(b.Name+storage.ChunkIndexSuffix) {
+					report(FindingMissing, b.Name, "backup generation "+b.ID+" does not exist")
+				}
+			}
+		}
+	}
+
+	if ws.has(storage.DefaultBackupManifest) {
+		mf, err := downloadContentManifest(ctx, dl, storage.DefaultBackupManifest)
+		if err != nil {
+			report(FindingCorrupt, storage.DefaultBackupManifest, err.Error())
+			attributable = false
+		} else {
+			verifiedObject(storage.DefaultBackupManifest)
+			for _, l := range mf.Layers {
+				// layers stored outside of this workspace, e.g. those of prebuilds, are verified with their workspace
+				if l.Bucket != ws.Bucket || !strings.HasPrefix(l.Object, ws.Prefix) {
+					continue
+				}
+				name := strings.TrimPrefix(l.Object, ws.Prefix)
+				referenced[name] = struct{}{}
+				layers[name] = struct{}{}
+				if !ws.has(name) {
+					report(FindingMissing, name, "content layer does not exist")
+					continue
+				}
+				err := s.verifyLayer(ctx, rep, dl, l)
+				if err != nil {
+					report(FindingCorrupt, name, err.Error())
+					continue
+				}
+				verifiedObject(name)
+			}
+		}
+	}
+
+	for _, idxName := range sortedNames(ws, func(name string) bool { return strings.HasSuffix(name, storage.ChunkIndexSuffix) }) {
+		name := strings.TrimSuffix(idxName, storage.ChunkIndexSuffix)
+		idx, err := storage.DownloadChunkIndex(ctx, dl, name)
+		if err != nil {
+			report(FindingCorrupt, idxName, err.Error())
+			attributable = false
+			continue
+		}
+
+		var incomplete bool
+		for _, c := range idx.Chunks {
+			chunkName, ok := chunkName(ws, idx, c.Digest)
+			if !ok {
+				continue
+			}
+			referenced[chunkName] = struct{}{}
+			if ws.has(chunkName) {
+				continue
+			}
+			incomplete = true
+			if _, reported := missing[chunkName]; !reported {
+				missing[chunkName] = struct{}{}
+				report(FindingMissing, chunkName, "chunk referenced by "+idxName+" does not exist")
+			}
+		}
+		if incomplete {
+			continue
+		}
+
+		err = s.verifyArchive(ctx, rep, dl, name)
+		if err == nil {
+			verifiedObject(idxName)
+			continue
+		}
+
+		// find out which chunks are to blame, if any
+		var blamed bool
+		for _, c := range idx.Chunks {
+			chunkName, ok := chunkName(ws, idx, c.Digest)
+			if !ok {
+				continue
+			}
+			if _, reported := corrupt[chunkName]; reported {
+				blamed = true
+				continue
+			}
+			cerr := s.verifyChunk(ctx, rep, dl, idx, c)
+			if cerr != nil {
+				corrupt[chunkName] = struct{}{}
+				report(FindingCorrupt, chunkName, cerr.Error())
+				blamed = true
+			}
+		}
+		if !blamed {
+			report(FindingCorrupt, idxName, err.Error())
+		}
+	}
+
+	for _, name := range sortedNames(ws, func(name string) bool {
+		_, ok := layers[name]
+		return !ok && strings.HasSuffix(name, ".tar")
+	}) {
+		err := s.verifyArchive(ctx, rep, dl, name)
+		if err != nil {
+			report(FindingCorrupt, name, err.Error())
+			continue
+		}
+		verifiedObject(name)
+	}
+
+	if !attributable {
+		return findings
+	}
+	for _, name := range sortedNames(ws, func(name string) bool {
+		_, ok := referenced[name]
+		if ok {
+			return false
+		}
+		return strings.HasPrefix(name, storage.BackupGenerationPrefix()) || strings.HasPrefix(name, storage.ChunkPrefix())
+	}) {
+		if _, ok := corrupt[name]; ok {
+			continue
+		}
+		report(FindingOrphaned, name, "not referenced by any backup manifest or chunk index")
+	}
+
+	return findings
+}
+
+// sortedNames returns the names of all objects of ws which match filter in lexical order
+func sortedNames(ws *workspace, filter func(name string) bool) []string {
+	var res []string
+	for name := range ws.Objects {
+		if filter(name) {
+			res = append(res, name)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// chunkName returns the name of a chunk relative to the workspace. Returns false if the chunk is stored outside of the workspace.
+func chunkName(ws *workspace, idx *csapi.WorkspaceChunkIndex, dgst digest.Digest) (name string, ok bool) {
+	if idx.Bucket != ws.Bucket || !strings.HasPrefix(idx.ChunkPrefix, ws.Prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(idx.ChunkPrefix, ws.Prefix) + dgst.Encoded(), true
+}
+
+func downloadContentManifest(ctx context.Context, dl storage.DirectDownloader, name string) (*csapi.WorkspaceContentManifest, error) {
+	rc, err := dl.DownloadObject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var mf csapi.WorkspaceContentManifest
+	err = json.NewDecoder(rc).Decode(&mf)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse content manifest: %w", err)
+	}
+	return &mf, nil
+}
+
+// verifyArchive reads a plain or chunked backup or snapshot and checks that it is a complete tar archive.
+// Chunked backups are checked against the digests of their chunk index while reading.
+func (s *Scrubber) verifyArchive(ctx context.Context, rep *Report, dl storage.DirectDownloader, name string) error {
+	rc, err := storage.OpenBackup(ctx, dl, name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	cr := &countingReader{Reader: rc}
+	defer func() { s.countBytes(rep, cr.N) }()
+
+	_, err = archive.VerifyTarbal(ctx, cr)
+	return err
+}
+
+// verifyChunk checks a single chunk against the digest its chunk index records
+func (s *Scrubber) verifyChunk(ctx context.Context, rep *Report, dl storage.DirectDownloader, idx *csapi.WorkspaceChunkIndex, c csapi.WorkspaceChunk) error {
+	rc, err := dl.DownloadObject(ctx, storage.QualifiedChunkName(idx, c.Digest))
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	cr := &countingReader{Reader: rc}
+	defer func() { s.countBytes(rep, cr.N) }()
+
+	verifier := c.Digest.Verifier()
+	_, err = io.Copy(verifier, cr)
+	if err != nil {
+		return xerrors.Errorf("cannot read chunk: %w", err)
+	}
+	if !verifier.Verified() {
+		return xerrors.Errorf("chunk digest mismatch: expected %s", c.Digest)
+	}
+	return nil
+}
+
+// verifyLayer checks a content layer against the digests and size recorded in the content manifest
+func (s *Scrubber) verifyLayer(ctx context.Context, rep *Report, dl storage.DirectDownloader, l csapi.WorkspaceContentLayer) error {
+	err := l.Digest.Validate()
+	if err != nil {
+		return xerrors.Errorf("invalid layer digest: %w", err)
+	}
+
+	rc, err := dl.DownloadObject(ctx, l.Object+"@"+l.Bucket)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	cr := &countingReader{Reader: rc}
+	defer func() { s.countBytes(rep, cr.N) }()
+
+	var (
+		verifier     = l.Digest.Verifier()
+		raw          = io.TeeReader(cr, verifier)
+		src          = raw
+		diffIDVerify digest.Verifier
+	)
+	if strings.HasSuffix(l.MediaType, "gzip") {
+		gz, err := gzip.NewReader(raw)
+		if err != nil {
+			return xerrors.Errorf("cannot decompress layer: %w", err)
+		}
+		defer gz.Close()
+		src = gz
+	}
+	if l.DiffID != "" {
+		err = l.DiffID.Validate()
+		if err != nil {
+			return xerrors.Errorf("invalid layer diffID: %w", err)
+		}
+		diffIDVerify = l.DiffID.Verifier()
+		src = io.TeeReader(src, diffIDVerify)
+	}
+
+	_, err = archive.VerifyTarbal(ctx, src)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(io.Discard, raw)
+	if err != nil {
+		return xerrors.Errorf("cannot read layer: %w", err)
+	}
+
+	if l.Size > 0 && cr.N != l.Size {
+		return xerrors.Errorf("layer size mismatch: expected %d, got %d", l.Size, cr.N)
+	}
+	if !verifier.Verified() {
+		return xerrors.Errorf("layer digest mismatch: expected %s", l.Digest)
+	}
+	if diffIDVerify != nil && !diffIDVerify.Verified() {
+		return xerrors.Errorf("layer diffID mismatch: expected %s", l.DiffID)
+	}
+	return nil
+}
+
+func (s *Scrubber) countBytes(rep *Report, n int64) {
+	rep.Bytes += n
+	s.metrics.verifiedBytes.Add(float64(n))
+}
+
+// quarantine moves an object of a workspace to the quarantine prefix of that workspace. Encrypted objects are moved
+// as they are, i.e. without decrypting them.
+func (s *Scrubber) quarantine(ctx context.Context, ws *workspace, name string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Scrubber.quarantine")
+	span.SetTag("object", name)
+	defer tracing.FinishSpan(span, &err)
+
+	rs, err := s.storage()
+	if err != nil {
+		return err
+	}
+	if enc, ok := rs.(*storage.EncryptedDirectAccess); ok {
+		rs = enc.DirectAccess
+	}
+	err = rs.Init(ctx, ws.OwnerID, ws.ID, "")
+	if err != nil {
+		return err
+	}
+
+	rc, err := rs.DownloadObject(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	tmpf, err := os.CreateTemp("", "scrubber-quarantine-*")
+	if err != nil {
+		return xerrors.Errorf("cannot create temporary file: %w", err)
+	}
+	defer os.Remove(tmpf.Name())
+
+	_, err = io.Copy(tmpf, rc)
+	tmpf.Close()
+	if err != nil {
+		return xerrors.Errorf("cannot download %s: %w", name, err)
+	}
+
+	_, _, err = rs.Upload(ctx, tmpf.Name(), storage.QuarantinePrefix+name)
+	if err != nil {
+		return xerrors.Errorf("cannot upload %s to quarantine: %w", name, err)
+	}
+	err = s.presigned.DeleteObject(ctx, ws.Bucket, &storage.DeleteObjectQuery{Name: ws.Prefix + name})
+	if err != nil {
+		return xerrors.Errorf("cannot delete %s: %w", name, err)
+	}
+	return nil
+}
+
+type countingReader struct {
+	io.Reader
+	N int64
+}
+
+func (r *countingReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	r.N += int64(n)
+	return n, err
+}
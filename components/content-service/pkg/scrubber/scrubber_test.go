@@ -0,0 +1,302 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package scrubber
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunk"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	testOwner  = "owner"
+	testBucket = "gitpod-user-owner"
+)
+
+func TestScrubber(t *testing.T) {
+	ctx := context.Background()
+	storageCfg := config.StorageConfig{
+		Stage: config.StageDevStaging,
+		Kind:  config.LocalStorage,
+		LocalConfig: &config.LocalConfig{
+			Path:       t.TempDir(),
+			BaseURL:    "http://unused",
+			SigningKey: "secret",
+		},
+	}
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+
+	// ws1 has a healthy chunked backup, a generation that's missing, an orphaned chunk and a corrupt snapshot
+	ws1 := newTestWorkspace(t, storageCfg, "ws1")
+	gen := ws1.uploadChunked(t, 1)
+	missing := storage.NewBackupGeneration(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), "instance")
+	ws1.uploadManifest(t, gen, missing)
+	ws1.upload(t, "chunks/deadbeef", []byte("orphan"))
+	ws1.upload(t, "snapshot-1.tar", bytes.Repeat([]byte("x"), 1024))
+
+	// ws2 has a chunked backup with a corrupt chunk
+	ws2 := newTestWorkspace(t, storageCfg, "ws2")
+	gen2 := ws2.uploadChunked(t, 2)
+	ws2.uploadManifest(t, gen2)
+	idx, err := storage.DownloadChunkIndex(ctx, ws2.rs, gen2.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corruptChunk := "chunks/" + idx.Chunks[1].Digest.Encoded()
+	ws2.upload(t, corruptChunk, []byte("corrupt"))
+
+	// ws3 has a content manifest with a healthy compressed layer and one that does not match its digest
+	ws3 := newTestWorkspace(t, storageCfg, "ws3")
+	layer := buildTar(t, 3)
+	var gzLayer bytes.Buffer
+	gw := gzip.NewWriter(&gzLayer)
+	_, _ = gw.Write(layer)
+	gw.Close()
+	ws3.upload(t, "layer.tar.gz", gzLayer.Bytes())
+	ws3.upload(t, "bad.tar", layer)
+	ws3.uploadContentManifest(t, csapi.WorkspaceContentManifest{Layers: []csapi.WorkspaceContentLayer{
+		{
+			Descriptor: ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerGzip, Digest: digest.FromBytes(gzLayer.Bytes()), Size: int64(gzLayer.Len())},
+			Bucket:     testBucket,
+			Object:     "workspaces/ws3/layer.tar.gz",
+			DiffID:     digest.FromBytes(layer),
+		},
+		{
+			Descriptor: ociv1.Descriptor{MediaType: csapi.MediaTypeUncompressedLayer, Digest: digest.FromString("other"), Size: int64(len(layer))},
+			Bucket:     testBucket,
+			Object:     "workspaces/ws3/bad.tar",
+			DiffID:     digest.FromString("other"),
+		},
+	}})
+
+	scrubber, err := New(storageCfg, config.ScrubberConfig{Quarantine: true, ReportPath: reportPath}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finding := func(kind FindingKind, ws, name string, quarantined bool) Finding {
+		return Finding{
+			Kind:        kind,
+			OwnerID:     testOwner,
+			WorkspaceID: ws,
+			Bucket:      testBucket,
+			Object:      "workspaces/" + ws + "/" + name,
+			Quarantined: quarantined,
+		}
+	}
+	ignoreReason := cmpopts.IgnoreFields(Finding{}, "Reason")
+
+	// the first run quarantines corrupt objects, but only reports the orphan
+	rep, err := scrubber.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectation := []Finding{
+		finding(FindingMissing, "ws1", missing.Name, false),
+		finding(FindingCorrupt, "ws1", "snapshot-1.tar", true),
+		finding(FindingOrphaned, "ws1", "chunks/deadbeef", false),
+		finding(FindingCorrupt, "ws2", corruptChunk, true),
+		finding(FindingCorrupt, "ws3", "bad.tar", true),
+	}
+	if diff := cmp.Diff(expectation, rep.Findings, ignoreReason); diff != "" {
+		t.Errorf("unexpected findings (-want +got):\n%s", diff)
+	}
+	if rep.Owners != 1 || rep.Workspaces != 3 {
+		t.Errorf("unexpected report: %d owners, %d workspaces", rep.Owners, rep.Workspaces)
+	}
+
+	var written Report
+	content, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = json.Unmarshal(content, &written)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(rep.Findings, written.Findings); diff != "" {
+		t.Errorf("unexpected written report (-want +got):\n%s", diff)
+	}
+
+	expectObjects := []string{
+		"workspaces/ws1/quarantine/snapshot-1.tar",
+		"workspaces/ws2/quarantine/" + corruptChunk,
+	}
+	for _, obj := range expectObjects {
+		if !ws1.exists(t, obj) {
+			t.Errorf("expected %s to exist", obj)
+		}
+	}
+	if ws1.exists(t, "workspaces/ws1/snapshot-1.tar") {
+		t.Error("expected corrupt snapshot to be removed")
+	}
+
+	// the second run finds the orphan again and quarantines it, and the backup of ws2 is now incomplete
+	rep, err = scrubber.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectation = []Finding{
+		finding(FindingMissing, "ws1", missing.Name, false),
+		finding(FindingOrphaned, "ws1", "chunks/deadbeef", true),
+		finding(FindingMissing, "ws2", corruptChunk, false),
+		finding(FindingMissing, "ws3", "bad.tar", false),
+	}
+	if diff := cmp.Diff(expectation, rep.Findings, ignoreReason); diff != "" {
+		t.Errorf("unexpected findings in second run (-want +got):\n%s", diff)
+	}
+	if !ws1.exists(t, "workspaces/ws1/quarantine/chunks/deadbeef") {
+		t.Error("expected orphan to be quarantined")
+	}
+}
+
+func TestStartRunsImmediately(t *testing.T) {
+	storageCfg := config.StorageConfig{
+		Stage: config.StageDevStaging,
+		Kind:  config.LocalStorage,
+		LocalConfig: &config.LocalConfig{
+			Path:       t.TempDir(),
+			BaseURL:    "http://unused",
+			SigningKey: "secret",
+		},
+	}
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	scrubber, err := New(storageCfg, config.ScrubberConfig{Interval: util.Duration(time.Hour), ReportPath: reportPath}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		scrubber.Start(ctx)
+		close(done)
+	}()
+
+	for {
+		if _, err := os.Stat(reportPath); err == nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("scrubber did not run before the first interval elapsed")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	cancel()
+	<-done
+}
+
+type testWorkspace struct {
+	ID string
+	rs storage.DirectAccess
+}
+
+func newTestWorkspace(t *testing.T, cfg config.StorageConfig, id string) *testWorkspace {
+	rs, err := storage.NewDirectAccess(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.Init(context.Background(), testOwner, id, "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.EnsureExists(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testWorkspace{ID: id, rs: rs}
+}
+
+func (ws *testWorkspace) upload(t *testing.T, name string, content []byte) {
+	fn := filepath.Join(t.TempDir(), "object")
+	err := os.WriteFile(fn, content, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = ws.rs.Upload(context.Background(), fn, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func buildTar(t *testing.T, seed int64) []byte {
+	content := make([]byte, 64*1024)
+	rand.New(rand.NewSource(seed)).Read(content)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err := tw.WriteHeader(&tar.Header{Name: "content.bin", Size: int64(len(content)), Mode: 0644, Typeflag: tar.TypeReg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tw.Write(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+	return buf.Bytes()
+}
+
+func (ws *testWorkspace) uploadChunked(t *testing.T, seed int64) csapi.WorkspaceBackupGeneration {
+	gen := storage.NewBackupGeneration(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), "instance")
+	gen.Chunked = true
+	_, err := storage.UploadChunked(context.Background(), ws.rs, bytes.NewReader(buildTar(t, seed)), gen.Name, storage.ChunkedUploadOptions{
+		TmpDir:   t.TempDir(),
+		Chunking: chunk.Config{MinSize: 512, AvgSize: 2048, MaxSize: 8192},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen
+}
+
+func (ws *testWorkspace) uploadManifest(t *testing.T, gens ...csapi.WorkspaceBackupGeneration) {
+	sort.Slice(gens, func(i, j int) bool { return gens[i].Created.Before(gens[j].Created) })
+	err := storage.UploadBackupManifest(context.Background(), ws.rs, &csapi.WorkspaceBackupManifest{Backups: gens}, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (ws *testWorkspace) uploadContentManifest(t *testing.T, mf csapi.WorkspaceContentManifest) {
+	content, err := json.Marshal(mf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws.upload(t, storage.DefaultBackupManifest, content)
+}
+
+func (ws *testWorkspace) exists(t *testing.T, obj string) bool {
+	rc, err := ws.rs.DownloadObject(context.Background(), obj+"@"+testBucket)
+	if err == storage.ErrNotFound {
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc.Close()
+	return true
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	exportDefaultURLExpiry = 30 * time.Minute
+
+	exportParamName       = "name"
+	exportParamFormat     = "format"
+	exportParamExcludeGit = "excludeGit"
+	exportParamGitignore  = "gitignore"
+	exportParamExpires    = "expires"
+	exportParamSignature  = "signature"
+)
+
+// exportRequest describes the workspace content export an export URL grants access to
+type exportRequest struct {
+	OwnerID     string
+	WorkspaceID string
+	// Name is either a backup name or a qualified snapshot name
+	Name       string
+	Format     archive.ExportFormat
+	ExcludeGit bool
+	Gitignore  bool
+}
+
+// exportURLSigner produces and verifies the HMAC-signed URLs served by the export handler
+type exportURLSigner struct {
+	key     []byte
+	baseURL string
+	expiry  time.Duration
+
+	now func() time.Time
+}
+
+func newExportURLSigner(cfg config.ExportConfig) (*exportURLSigner, error) {
+	if cfg.SigningKeyFile != "" {
+		value, err := os.ReadFile(cfg.SigningKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.SigningKey = strings.TrimSpace(string(value))
+	}
+	err := validation.ValidateStruct(&cfg,
+		validation.Field(&cfg.BaseURL, validation.Required),
+		validation.Field(&cfg.SigningKey, validation.Required),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := time.Duration(cfg.URLExpiry)
+	if expiry == 0 {
+		expiry = exportDefaultURLExpiry
+	}
+	return &exportURLSigner{
+		key:     []byte(cfg.SigningKey),
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		expiry:  expiry,
+		now:     time.Now,
+	}, nil
+}
+
+// Sign produces a URL which grants access to an export until the signer's expiry elapsed
+func (s *exportURLSigner) Sign(req exportRequest) (u string, expires time.Time) {
+	expires = s.now().Add(s.expiry)
+	exp := strconv.FormatInt(expires.Unix(), 10)
+
+	q := url.Values{}
+	q.Set(exportParamName, req.Name)
+	q.Set(exportParamFormat, string(req.Format))
+	q.Set(exportParamExcludeGit, strconv.FormatBool(req.ExcludeGit))
+	q.Set(exportParamGitignore, strconv.FormatBool(req.Gitignore))
+	q.Set(exportParamExpires, exp)
+	q.Set(exportParamSignature, s.signature(req, exp))
+
+	return fmt.Sprintf("%s/%s/%s?%s", s.baseURL, url.PathEscape(req.OwnerID), url.PathEscape(req.WorkspaceID), q.Encode()), expires
+}
+
+// Verify checks the signature of an export URL and returns the export it grants access to
+func (s *exportURLSigner) Verify(ownerID, workspaceID string, q url.Values) (*exportRequest, error) {
+	exp := q.Get(exportParamExpires)
+	expires, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid expiry")
+	}
+	if s.now().After(time.Unix(expires, 0)) {
+		return nil, xerrors.Errorf("URL has expired")
+	}
+
+	req := exportRequest{
+		OwnerID:     ownerID,
+		WorkspaceID: workspaceID,
+		Name:        q.Get(exportParamName),
+		Format:      archive.ExportFormat(q.Get(exportParamFormat)),
+		ExcludeGit:  q.Get(exportParamExcludeGit) == "true",
+		Gitignore:   q.Get(exportParamGitignore) == "true",
+	}
+	sig, err := hex.DecodeString(q.Get(exportParamSignature))
+	if err != nil {
+		return nil, xerrors.Errorf("invalid signature")
+	}
+	expected, _ := hex.DecodeString(s.signature(req, exp))
+	if !hmac.Equal(sig, expected) {
+		return nil, xerrors.Errorf("invalid signature")
+	}
+	return &req, nil
+}
+
+func (s *exportURLSigner) signature(req exportRequest, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	_, _ = mac.Write([]byte(strings.Join([]string{
+		req.OwnerID,
+		req.WorkspaceID,
+		req.Name,
+		string(req.Format),
+		strconv.FormatBool(req.ExcludeGit),
+		strconv.FormatBool(req.Gitignore),
+		expires,
+	}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewExportHandler produces an HTTP handler which serves the workspace content exports signed by ExportWorkspaceContent.
+// The handler expects request paths of the form /<owner>/<workspace>, i.e. it must be served under the configured
+// export BaseURL with that prefix stripped.
+func NewExportHandler(storageCfg config.StorageConfig, exportCfg config.ExportConfig) (http.Handler, error) {
+	signer, err := newExportURLSigner(exportCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &exportHandler{
+		storage: storageCfg,
+		signer:  signer,
+	}, nil
+}
+
+type exportHandler struct {
+	storage config.StorageConfig
+	signer  *exportURLSigner
+}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	ownerID, workspaceID, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	req, err := h.signer.Verify(ownerID, workspaceID, r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	streaming, err := h.export(w, r, req)
+	if err == nil {
+		return
+	}
+	log.WithFields(log.OWI(req.OwnerID, req.WorkspaceID, "")).WithField("name", req.Name).WithError(err).Error("cannot export workspace content")
+	if streaming {
+		// once we've started streaming the archive, all we can do is to abort the response
+		panic(http.ErrAbortHandler)
+	}
+	if errors.Is(err, storage.ErrNotFound) {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	http.Error(w, "cannot export workspace content", http.StatusInternalServerError)
+}
+
+// export streams the archive of an export to w. Once streaming is true, the response has been started.
+func (h *exportHandler) export(w http.ResponseWriter, r *http.Request, req *exportRequest) (streaming bool, err error) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "exportWorkspaceContent")
+	span.SetTag("user", req.OwnerID)
+	span.SetTag("workspaceId", req.WorkspaceID)
+	span.LogKV("name", req.Name, "format", req.Format, "excludeGit", req.ExcludeGit, "gitignore", req.Gitignore)
+	defer tracing.FinishSpan(span, &err)
+
+	rs, err := storage.NewDirectAccess(&h.storage)
+	if err != nil {
+		return false, err
+	}
+	err = rs.Init(ctx, req.OwnerID, req.WorkspaceID, "")
+	if err != nil {
+		return false, err
+	}
+
+	opts := archive.ExportOptions{
+		Format:     req.Format,
+		ExcludeGit: req.ExcludeGit,
+	}
+	if req.Gitignore {
+		// .gitignore files can come after the files they ignore, hence we need to read the backup twice
+		rc, err := storage.OpenBackup(ctx, rs, req.Name)
+		if err != nil {
+			return false, err
+		}
+		opts.Ignore, err = archive.ReadGitignore(ctx, rc)
+		rc.Close()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	rc, err := storage.OpenBackup(ctx, rs, req.Name)
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+
+	contentType := "application/x-tar"
+	if req.Format == archive.ExportZip {
+		contentType = "application/zip"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", req.WorkspaceID+"."+string(req.Format)))
+	return true, archive.ExportTarbal(ctx, rc, w, opts)
+}
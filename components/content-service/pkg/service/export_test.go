@@ -0,0 +1,142 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	storagemock "github.com/gitpod-io/gitpod/content-service/pkg/storage/mock"
+)
+
+func TestExportURLSigner(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	req := exportRequest{
+		OwnerID:     "owner",
+		WorkspaceID: "amber-baboon-cij4wozf",
+		Name:        "full.tar",
+		Format:      archive.ExportZip,
+		ExcludeGit:  true,
+	}
+
+	tests := []struct {
+		Name   string
+		Tamper func(ownerID, workspaceID *string, q url.Values)
+		Later  time.Duration
+		Error  string
+	}{
+		{Name: "valid"},
+		{Name: "expired", Later: time.Hour, Error: "URL has expired"},
+		{Name: "other workspace", Tamper: func(_, ws *string, _ url.Values) { *ws = "other" }, Error: "invalid signature"},
+		{Name: "other backup", Tamper: func(_, _ *string, q url.Values) { q.Set(exportParamName, "backups/x.tar") }, Error: "invalid signature"},
+		{Name: "include git", Tamper: func(_, _ *string, q url.Values) { q.Set(exportParamExcludeGit, "false") }, Error: "invalid signature"},
+		{Name: "missing signature", Tamper: func(_, _ *string, q url.Values) { q.Del(exportParamSignature) }, Error: "invalid signature"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			signer, err := newExportURLSigner(config.ExportConfig{BaseURL: "https://example.com/export/", SigningKey: "secret"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			signer.now = func() time.Time { return now }
+
+			u, expires := signer.Sign(req)
+			if !expires.Equal(now.Add(exportDefaultURLExpiry)) {
+				t.Errorf("unexpected expiry %v", expires)
+			}
+			parsed, err := url.Parse(u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			segs := strings.Split(strings.TrimPrefix(parsed.Path, "/export/"), "/")
+			if len(segs) != 2 {
+				t.Fatalf("unexpected URL path %s", parsed.Path)
+			}
+			ownerID, workspaceID, q := segs[0], segs[1], parsed.Query()
+			if test.Tamper != nil {
+				test.Tamper(&ownerID, &workspaceID, q)
+			}
+
+			signer.now = func() time.Time { return now.Add(test.Later) }
+			act, err := signer.Verify(ownerID, workspaceID, q)
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(&req, act); diff != "" {
+				t.Errorf("unexpected export request (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExportWorkspaceContentSnapshot(t *testing.T) {
+	const (
+		ownerID     = "owner"
+		workspaceID = "amber-baboon-cij4wozf"
+		bucket      = "gitpod-user-owner"
+	)
+
+	tests := []struct {
+		Name     string
+		Snapshot string
+		Code     codes.Code
+	}{
+		{Name: "own snapshot", Snapshot: "workspaces/" + workspaceID + "/snapshot-1.tar@" + bucket, Code: codes.OK},
+		{Name: "other bucket", Snapshot: "workspaces/" + workspaceID + "/snapshot-1.tar@gitpod-user-other", Code: codes.PermissionDenied},
+		{Name: "other workspace", Snapshot: "workspaces/" + workspaceID + "x/snapshot-1.tar@" + bucket, Code: codes.PermissionDenied},
+		{Name: "invalid name", Snapshot: "snapshot-1.tar", Code: codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := storagemock.NewMockPresignedAccess(ctrl)
+			s.EXPECT().Bucket(ownerID).Return(bucket).AnyTimes()
+			s.EXPECT().BackupObject(ownerID, workspaceID, gomock.Any()).DoAndReturn(func(_, ws, name string) string {
+				return "workspaces/" + ws + "/" + name
+			}).AnyTimes()
+			s.EXPECT().ObjectExists(gomock.Any(), bucket, "workspaces/"+workspaceID+"/snapshot-1.tar").Return(true, nil).AnyTimes()
+
+			signer, err := newExportURLSigner(config.ExportConfig{BaseURL: "https://example.com/export", SigningKey: "secret"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			svc := WorkspaceService{s: s, export: signer}
+
+			resp, err := svc.ExportWorkspaceContent(context.Background(), &api.ExportWorkspaceContentRequest{
+				OwnerId:     ownerID,
+				WorkspaceId: workspaceID,
+				Snapshot:    test.Snapshot,
+			})
+			if code := status.Code(err); code != test.Code {
+				t.Fatalf("unexpected status code %v: %v", code, err)
+			}
+			if err != nil {
+				return
+			}
+			if !strings.HasPrefix(resp.Url, "https://example.com/export/"+ownerID+"/"+workspaceID+"?") {
+				t.Errorf("unexpected URL %s", resp.Url)
+			}
+		})
+	}
+}
@@ -12,29 +12,39 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	"github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 )
 
 // WorkspaceService implements WorkspaceServiceServer
 type WorkspaceService struct {
-	cfg config.StorageConfig
-	s   storage.PresignedAccess
+	cfg    config.StorageConfig
+	s      storage.PresignedAccess
+	export *exportURLSigner
 
 	api.UnimplementedWorkspaceServiceServer
 }
 
-// NewWorkspaceService create a new content service
-func NewWorkspaceService(cfg config.StorageConfig) (res *WorkspaceService, err error) {
+// NewWorkspaceService create a new content service. If export is nil, ExportWorkspaceContent is unavailable.
+func NewWorkspaceService(cfg config.StorageConfig, export *config.ExportConfig) (res *WorkspaceService, err error) {
 	s, err := storage.NewPresignedAccess(&cfg)
 	if err != nil {
 		return nil, err
 	}
-	return &WorkspaceService{cfg: cfg, s: s}, nil
+	res = &WorkspaceService{cfg: cfg, s: s}
+	if export != nil {
+		res.export, err = newExportURLSigner(*export)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
 // WorkspaceDownloadURL provides a URL from where the content of a workspace can be downloaded from
@@ -89,25 +99,39 @@ func (cs *WorkspaceService) DeleteWorkspace(ctx context.Context, req *api.Delete
 		return &api.DeleteWorkspaceResponse{}, nil
 	}
 
-	blobName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackup)
-	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: blobName})
-	if err != nil {
+	// Workspaces may have a regular backup, a chunked one, or a backup history - or any combination thereof
+	// if the backup configuration changed over time. Hence, any of the objects may be missing.
+	bkt := cs.s.Bucket(req.OwnerId)
+	for _, name := range []string{storage.DefaultBackup, storage.DefaultBackup + storage.ChunkIndexSuffix, storage.BackupManifest} {
+		blobName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, name)
+		err = cs.s.DeleteObject(ctx, bkt, &storage.DeleteObjectQuery{Name: blobName})
 		if errors.Is(err, storage.ErrNotFound) {
 			log.WithError(err).Debug("deleting workspace backup: NotFound, ", blobName)
-			return &api.DeleteWorkspaceResponse{}, nil
+			continue
+		}
+		if err != nil {
+			log.WithError(err).Error("error deleting workspace backup: ", blobName)
+			return nil, status.Error(codes.Unknown, err.Error())
 		}
-		log.WithError(err).Error("error deleting workspace backup: ", blobName)
-		return nil, status.Error(codes.Unknown, err.Error())
 	}
-
-	trailPrefix := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, "trail-")
-	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Prefix: trailPrefix})
-	if err != nil {
+	for _, name := range []string{storage.BackupGenerationPrefix(), "trail-"} {
+		prefix := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, name)
+		err = cs.s.DeleteObject(ctx, bkt, &storage.DeleteObjectQuery{Prefix: prefix})
 		if errors.Is(err, storage.ErrNotFound) {
-			log.WithError(err).Debug("deleting workspace backup: NotFound, ", trailPrefix)
-			return &api.DeleteWorkspaceResponse{}, nil
+			log.WithError(err).Debug("deleting workspace backup: NotFound, ", prefix)
+			continue
 		}
-		log.WithError(err).Error("error deleting workspace backup: ", trailPrefix)
+		if err != nil {
+			log.WithError(err).Error("error deleting workspace backup: ", prefix)
+			return nil, status.Error(codes.Unknown, err.Error())
+		}
+	}
+
+	// The chunks of chunked backups are shared with the snapshots of the workspace, which we keep.
+	// Hence, we can only delete the chunks no snapshot references.
+	err = cs.garbageCollectChunks(ctx, req.OwnerId, req.WorkspaceId)
+	if err != nil {
+		log.WithFields(log.OWI(req.OwnerId, req.WorkspaceId, "")).WithError(err).Error("error deleting workspace backup chunks")
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
@@ -129,3 +153,157 @@ func (cs *WorkspaceService) WorkspaceSnapshotExists(ctx context.Context, req *ap
 		Exists: exists,
 	}, nil
 }
+
+// ListWorkspaceBackups lists the backup generations of a workspace
+func (cs *WorkspaceService) ListWorkspaceBackups(ctx context.Context, req *api.ListWorkspaceBackupsRequest) (resp *api.ListWorkspaceBackupsResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListWorkspaceBackups")
+	span.SetTag("user", req.OwnerId)
+	span.SetTag("workspaceId", req.WorkspaceId)
+	defer tracing.FinishSpan(span, &err)
+
+	resp = &api.ListWorkspaceBackupsResponse{}
+	mf, err := cs.downloadBackupManifest(ctx, req.OwnerId, req.WorkspaceId)
+	if errors.Is(err, storage.ErrNotFound) {
+		// workspaces without backup history have no generations
+		return resp, nil
+	}
+	if err != nil {
+		log.WithFields(log.OWI(req.OwnerId, req.WorkspaceId, "")).WithError(err).Error("cannot download backup manifest")
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	for i := len(mf.Backups) - 1; i >= 0; i-- {
+		b := mf.Backups[i]
+		resp.Backups = append(resp.Backups, &api.WorkspaceBackup{
+			Id:         b.ID,
+			Created:    timestamppb.New(b.Created),
+			InstanceId: b.InstanceID,
+			Chunked:    b.Chunked,
+		})
+	}
+	return resp, nil
+}
+
+// garbageCollectChunks deletes the chunks of a workspace which none of its chunk indices references
+func (cs *WorkspaceService) garbageCollectChunks(ctx context.Context, ownerID, workspaceID string) error {
+	// we read the chunk indices using direct access, because they're encrypted if the workspace content is
+	rs, err := storage.NewDirectAccess(&cs.cfg)
+	if err != nil {
+		return err
+	}
+	err = rs.Init(ctx, ownerID, workspaceID, "")
+	if err != nil {
+		return err
+	}
+	_, err = storage.GarbageCollectChunks(ctx, rs, cs.s)
+	return err
+}
+
+// downloadBackupManifest downloads the backup manifest of a workspace. Returns ErrNotFound if the workspace has none.
+func (cs *WorkspaceService) downloadBackupManifest(ctx context.Context, ownerID, workspaceID string) (*api.WorkspaceBackupManifest, error) {
+	// we read the manifest using direct access, because it's encrypted if the workspace content is
+	rs, err := storage.NewDirectAccess(&cs.cfg)
+	if err != nil {
+		return nil, err
+	}
+	err = rs.Init(ctx, ownerID, workspaceID, "")
+	if err != nil {
+		return nil, err
+	}
+	return storage.DownloadBackupManifest(ctx, rs)
+}
+
+// ExportWorkspaceContent provides a signed URL from where the content of a workspace backup or snapshot can be downloaded as an archive
+func (cs *WorkspaceService) ExportWorkspaceContent(ctx context.Context, req *api.ExportWorkspaceContentRequest) (resp *api.ExportWorkspaceContentResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ExportWorkspaceContent")
+	span.SetTag("user", req.OwnerId)
+	span.SetTag("workspaceId", req.WorkspaceId)
+	span.SetTag("backupId", req.BackupId)
+	span.SetTag("snapshot", req.Snapshot)
+	defer tracing.FinishSpan(span, &err)
+
+	if cs.export == nil {
+		return nil, status.Error(codes.FailedPrecondition, "workspace content export is not configured")
+	}
+	if req.OwnerId == "" || req.WorkspaceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner and workspace ID are required")
+	}
+	if req.BackupId != "" && req.Snapshot != "" {
+		return nil, status.Error(codes.InvalidArgument, "backup ID and snapshot are mutually exclusive")
+	}
+
+	var format archive.ExportFormat
+	switch req.Format {
+	case api.WorkspaceExportFormat_EXPORT_FORMAT_TAR:
+		format = archive.ExportTar
+	case api.WorkspaceExportFormat_EXPORT_FORMAT_ZIP:
+		format = archive.ExportZip
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", req.Format)
+	}
+
+	bkt := cs.s.Bucket(req.OwnerId)
+	var name, obj string
+	if req.Snapshot != "" {
+		var snapshotBkt string
+		snapshotBkt, obj, err = storage.ParseSnapshotName(req.Snapshot)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		prefix := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, "")
+		if !strings.HasSuffix(prefix, "/") {
+			prefix = prefix + "/"
+		}
+		// the snapshot must belong to the workspace, otherwise we'd hand out the content of someone else's workspace
+		if snapshotBkt != bkt || !strings.HasPrefix(obj, prefix) {
+			return nil, status.Error(codes.PermissionDenied, "snapshot does not belong to the workspace")
+		}
+		name = req.Snapshot
+	} else {
+		mf, err := cs.downloadBackupManifest(ctx, req.OwnerId, req.WorkspaceId)
+		switch {
+		case errors.Is(err, storage.ErrNotFound) && req.BackupId == "":
+			// workspaces without backup history only have their latest backup
+			name = storage.DefaultBackup
+		case errors.Is(err, storage.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "workspace has no backup %s", req.BackupId)
+		case err != nil:
+			log.WithFields(log.OWI(req.OwnerId, req.WorkspaceId, "")).WithError(err).Error("cannot download backup manifest")
+			return nil, status.Error(codes.Unknown, err.Error())
+		default:
+			backup, err := storage.ResolveBackup(mf, req.BackupId)
+			if err != nil {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			name = backup.Name
+		}
+		obj = cs.s.BackupObject(req.OwnerId, req.WorkspaceId, name)
+	}
+
+	var exists bool
+	for _, o := range []string{obj, obj + storage.ChunkIndexSuffix} {
+		exists, err = cs.s.ObjectExists(ctx, bkt, o)
+		if err != nil {
+			return nil, status.Error(codes.Unknown, err.Error())
+		}
+		if exists {
+			break
+		}
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "workspace content not found")
+	}
+
+	url, expires := cs.export.Sign(exportRequest{
+		OwnerID:     req.OwnerId,
+		WorkspaceID: req.WorkspaceId,
+		Name:        name,
+		Format:      format,
+		ExcludeGit:  req.ExcludeGit,
+		Gitignore:   req.RespectGitignore,
+	})
+	return &api.ExportWorkspaceContentResponse{
+		Url:     url,
+		Expires: timestamppb.New(expires),
+	}, nil
+}
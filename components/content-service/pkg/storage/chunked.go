@@ -0,0 +1,506 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunk"
+)
+
+const (
+	// ChunkIndexSuffix is appended to a backup name to form the name of its chunk index
+	ChunkIndexSuffix = ".idx"
+
+	// chunkDir is the directory (relative to the workspace) in which we store backup chunks
+	chunkDir = "chunks"
+
+	// chunkUploadConcurrency is the number of chunks we upload in parallel
+	chunkUploadConcurrency = 4
+
+	// chunkDownloadConcurrency is the number of chunks we download ahead while restoring
+	chunkDownloadConcurrency = 4
+
+	// chunkSignConcurrency is the number of chunk download URLs we sign in parallel
+	chunkSignConcurrency = 16
+)
+
+// ChunkIndexName returns the name of the chunk index of a backup. The name can either be a backup name
+// or one produced by Qualify.
+func ChunkIndexName(name string) string {
+	if obj, bkt, ok := strings.Cut(name, "@"); ok {
+		return obj + ChunkIndexSuffix + "@" + bkt
+	}
+	return name + ChunkIndexSuffix
+}
+
+// QualifiedChunkName returns the fully qualified name of a chunk referenced by an index,
+// i.e. one that can be passed to DownloadObject.
+func QualifiedChunkName(idx *csapi.WorkspaceChunkIndex, dgst digest.Digest) string {
+	return idx.ChunkPrefix + dgst.Encoded() + "@" + idx.Bucket
+}
+
+// ChunkPrefix is the name prefix of all chunks of a workspace
+func ChunkPrefix() string {
+	return chunkDir + "/"
+}
+
+func chunkObjectName(dgst digest.Digest) string {
+	return chunkDir + "/" + dgst.Encoded()
+}
+
+// workspaceLocation computes the bucket and object name prefix under which rs stores the objects of a workspace
+func workspaceLocation(rs DirectAccess) (bkt, prefix string) {
+	const probe = "probe"
+	obj, bkt, _ := strings.Cut(rs.Qualify(probe), "@")
+	return bkt, strings.TrimSuffix(obj, probe)
+}
+
+// chunkLocation computes the bucket and object name prefix under which rs stores chunks
+func chunkLocation(rs DirectAccess) (bkt, prefix string) {
+	bkt, prefix = workspaceLocation(rs)
+	return bkt, prefix + chunkDir + "/"
+}
+
+// ChunkedUploadOptions configure UploadChunked
+type ChunkedUploadOptions struct {
+	// TmpDir is where chunks are staged before they're uploaded
+	TmpDir string
+	// InstanceID is recorded in the chunk index
+	InstanceID string
+	// Chunking configures the chunk sizes. Defaults to chunk.DefaultConfig.
+	Chunking chunk.Config
+}
+
+// UploadChunked splits the tar archive read from src into content-defined chunks and uploads all chunks
+// that do not exist in the remote storage yet. Once all chunks are uploaded, the chunk index is uploaded
+// as ChunkIndexName(name). Chunks are only removed by GarbageCollectChunks, so retrying a failed upload only uploads
+// the missing chunks.
+func UploadChunked(ctx context.Context, rs DirectAccess, src io.Reader, name string, opts ChunkedUploadOptions) (idx *csapi.WorkspaceChunkIndex, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UploadChunked")
+	span.SetTag("name", name)
+	defer tracing.FinishSpan(span, &err)
+
+	if opts.Chunking == (chunk.Config{}) {
+		opts.Chunking = chunk.DefaultConfig
+	}
+
+	bkt, prefix := chunkLocation(rs)
+	existing, err := rs.ListObjects(ctx, prefix)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list existing chunks: %w", err)
+	}
+	known := make(map[string]struct{}, len(existing))
+	for _, obj := range existing {
+		known[strings.TrimPrefix(obj, prefix)] = struct{}{}
+	}
+
+	chunker, err := chunk.NewChunker(src, opts.Chunking)
+	if err != nil {
+		return nil, err
+	}
+
+	idx = &csapi.WorkspaceChunkIndex{
+		Bucket:      bkt,
+		ChunkPrefix: prefix,
+		InstanceID:  opts.InstanceID,
+	}
+	var (
+		diffID   = sha256.New()
+		uploaded int
+	)
+	eg, egctx := errgroup.WithContext(ctx)
+	eg.SetLimit(chunkUploadConcurrency)
+	for {
+		data, cerr := chunker.Next()
+		if cerr == io.EOF {
+			break
+		}
+		if cerr != nil {
+			err = xerrors.Errorf("cannot read archive: %w", cerr)
+			break
+		}
+		if egctx.Err() != nil {
+			break
+		}
+
+		_, _ = diffID.Write(data)
+		dgst := digest.FromBytes(data)
+		idx.Size += int64(len(data))
+		idx.Chunks = append(idx.Chunks, csapi.WorkspaceChunk{Digest: dgst, Size: int64(len(data))})
+		if _, exists := known[dgst.Encoded()]; exists {
+			continue
+		}
+		known[dgst.Encoded()] = struct{}{}
+
+		// the chunk data is only valid until the next call to chunker.Next(), hence we stage it on disk
+		// before uploading it in the background.
+		fn, serr := stageChunk(opts.TmpDir, data)
+		if serr != nil {
+			err = serr
+			break
+		}
+		uploaded++
+		eg.Go(func() error {
+			defer os.Remove(fn)
+
+			_, _, err := rs.Upload(egctx, fn, chunkObjectName(dgst), WithContentType("application/octet-stream"))
+			if err != nil {
+				return xerrors.Errorf("cannot upload chunk %s: %w", dgst, err)
+			}
+			return nil
+		})
+	}
+	if egerr := eg.Wait(); err == nil {
+		err = egerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	idx.DiffID = digest.NewDigest(digest.SHA256, diffID)
+	span.LogKV("chunks", len(idx.Chunks), "uploaded", uploaded, "size", idx.Size)
+	log.WithField("chunks", len(idx.Chunks)).WithField("uploaded", uploaded).WithField("size", idx.Size).Debug("uploaded chunked backup")
+
+	fc, err := json.Marshal(idx)
+	if err != nil {
+		return nil, err
+	}
+	fn, err := stageChunk(opts.TmpDir, fc)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(fn)
+
+	_, _, err = rs.Upload(ctx, fn, ChunkIndexName(name), WithContentType(csapi.ContentTypeChunkIndex))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot upload chunk index: %w", err)
+	}
+
+	return idx, nil
+}
+
+func stageChunk(tmpdir string, data []byte) (string, error) {
+	f, err := os.CreateTemp(tmpdir, "chunk-*")
+	if err != nil {
+		return "", xerrors.Errorf("cannot stage chunk: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(data)
+	if err != nil {
+		os.Remove(f.Name())
+		return "", xerrors.Errorf("cannot stage chunk: %w", err)
+	}
+	return f.Name(), nil
+}
+
+// GarbageCollectChunks deletes all chunks of a workspace which are no longer referenced by any of its chunk indices,
+// e.g. because the backup generations which referenced them were deleted. If any chunk index cannot be read,
+// nothing is deleted. This must not run concurrently with UploadChunked for the same workspace, as the upload
+// might reference chunks which are about to be deleted.
+func GarbageCollectChunks(ctx context.Context, rs DirectAccess, ps PresignedAccess) (deleted int, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GarbageCollectChunks")
+	defer tracing.FinishSpan(span, &err)
+
+	bkt, prefix := workspaceLocation(rs)
+	_, chunkPrefix := chunkLocation(rs)
+	objs, err := rs.ListObjects(ctx, prefix)
+	if err != nil {
+		return 0, xerrors.Errorf("cannot list workspace content: %w", err)
+	}
+
+	var (
+		chunks     []string
+		referenced = make(map[string]struct{})
+	)
+	for _, obj := range objs {
+		if strings.HasPrefix(obj, chunkPrefix) {
+			chunks = append(chunks, obj)
+			continue
+		}
+		if !strings.HasSuffix(obj, ChunkIndexSuffix) {
+			continue
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(obj, prefix), ChunkIndexSuffix)
+		idx, err := DownloadChunkIndex(ctx, rs, name)
+		if errors.Is(err, ErrNotFound) {
+			// the index was deleted since we listed the objects
+			continue
+		}
+		if err != nil {
+			return 0, xerrors.Errorf("cannot download chunk index of %s: %w", name, err)
+		}
+		if idx.Bucket != bkt || idx.ChunkPrefix != chunkPrefix {
+			continue
+		}
+		for _, c := range idx.Chunks {
+			referenced[chunkPrefix+c.Digest.Encoded()] = struct{}{}
+		}
+	}
+
+	for _, obj := range chunks {
+		if _, ok := referenced[obj]; ok {
+			continue
+		}
+		err = ps.DeleteObject(ctx, bkt, &DeleteObjectQuery{Name: obj})
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return deleted, xerrors.Errorf("cannot delete chunk %s: %w", obj, err)
+		}
+		deleted++
+	}
+	span.LogKV("chunks", len(chunks), "deleted", deleted)
+	return deleted, nil
+}
+
+// DownloadChunkIndex downloads and parses the chunk index of a backup. Returns ErrNotFound if the backup has no chunk index.
+func DownloadChunkIndex(ctx context.Context, rs DirectDownloader, name string) (*csapi.WorkspaceChunkIndex, error) {
+	rc, err := rs.DownloadObject(ctx, ChunkIndexName(name))
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var idx csapi.WorkspaceChunkIndex
+	err = json.NewDecoder(rc).Decode(&idx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse chunk index: %w", err)
+	}
+	return &idx, nil
+}
+
+// DownloadChunked restores a chunked backup to destination. The name is either a backup name or one produced by Qualify.
+// If the backup has no chunk index, found is false.
+func DownloadChunked(ctx context.Context, rs DirectDownloader, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DownloadChunked")
+	span.SetTag("name", name)
+	defer tracing.FinishSpan(span, &err)
+
+	idx, err := DownloadChunkIndex(ctx, rs, name)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	span.LogKV("chunks", len(idx.Chunks), "size", idx.Size)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	errc := make(chan error, 1)
+	go func() {
+		err := writeChunks(ctx, rs, idx, pw)
+		pw.CloseWithError(err)
+		errc <- err
+	}()
+
+	err = extractTarbal(ctx, destination, pr, mappings)
+	pr.Close()
+	cancel()
+	if werr := <-errc; werr != nil && !xerrors.Is(werr, io.ErrClosedPipe) && !xerrors.Is(werr, context.Canceled) {
+		return true, werr
+	}
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// OpenBackup streams the tar archive of a backup without extracting it. Chunked backups are assembled from their chunks.
+// The name is either a backup name or one produced by Qualify.
+func OpenBackup(ctx context.Context, rs DirectDownloader, name string) (io.ReadCloser, error) {
+	idx, err := DownloadChunkIndex(ctx, rs, name)
+	if err == ErrNotFound {
+		return rs.DownloadObject(ctx, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeChunks(ctx, rs, idx, pw))
+	}()
+	return &chunkedBackupReader{PipeReader: pr, cancel: cancel}, nil
+}
+
+type chunkedBackupReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (r *chunkedBackupReader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
+
+// writeChunks writes all chunks of idx to w in order, downloading a few chunks ahead
+func writeChunks(ctx context.Context, rs DirectDownloader, idx *csapi.WorkspaceChunkIndex, w io.Writer) error {
+	type fetched struct {
+		Data []byte
+		Err  error
+	}
+
+	futures := make(chan chan fetched, chunkDownloadConcurrency)
+	go func() {
+		defer close(futures)
+		for _, c := range idx.Chunks {
+			f := make(chan fetched, 1)
+			select {
+			case futures <- f:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(c csapi.WorkspaceChunk) {
+				data, err := downloadChunk(ctx, rs, idx, c)
+				f <- fetched{Data: data, Err: err}
+			}(c)
+		}
+	}()
+
+	diffID := sha256.New()
+	for f := range futures {
+		var res fetched
+		select {
+		case res = <-f:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if res.Err != nil {
+			return res.Err
+		}
+
+		_, _ = diffID.Write(res.Data)
+		_, err := w.Write(res.Data)
+		if err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if act := digest.NewDigest(digest.SHA256, diffID); act != idx.DiffID {
+		return xerrors.Errorf("chunked backup digest mismatch: expected %s, got %s", idx.DiffID, act)
+	}
+	return nil
+}
+
+func downloadChunk(ctx context.Context, rs DirectDownloader, idx *csapi.WorkspaceChunkIndex, c csapi.WorkspaceChunk) ([]byte, error) {
+	rc, err := rs.DownloadObject(ctx, QualifiedChunkName(idx, c.Digest))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download chunk %s: %w", c.Digest, err)
+	}
+	defer rc.Close()
+
+	buf := bytes.NewBuffer(make([]byte, 0, c.Size))
+	_, err = io.Copy(buf, rc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download chunk %s: %w", c.Digest, err)
+	}
+	if act := digest.FromBytes(buf.Bytes()); act != c.Digest {
+		return nil, xerrors.Errorf("chunk digest mismatch: expected %s, got %s", c.Digest, act)
+	}
+	return buf.Bytes(), nil
+}
+
+// SignChunkedDownload presigns the download of the chunk index of the backup at bkt/obj and of all chunks it references.
+// The index is read using rs, which must be able to download the backup by name. The result is keyed by the names
+// DownloadChunked asks for. Returns ErrNotFound if there is no such chunk index.
+func SignChunkedDownload(ctx context.Context, rs DirectDownloader, ps PresignedAccess, bkt, obj, name string) (res map[string]DownloadInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SignChunkedDownload")
+	span.SetTag("bucket", bkt)
+	span.SetTag("object", obj)
+	defer func() {
+		if err == ErrNotFound {
+			span.LogKV("found", false)
+			tracing.FinishSpan(span, nil)
+			return
+		}
+		tracing.FinishSpan(span, &err)
+	}()
+
+	idx, err := DownloadChunkIndex(ctx, rs, name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := ps.SignDownload(ctx, bkt, obj+ChunkIndexSuffix, &SignedURLOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]DownloadInfo, len(idx.Chunks))
+	eg, egctx := errgroup.WithContext(ctx)
+	eg.SetLimit(chunkSignConcurrency)
+	for i, c := range idx.Chunks {
+		i, c := i, c
+		eg.Go(func() error {
+			nfo, err := ps.SignDownload(egctx, idx.Bucket, idx.ChunkPrefix+c.Digest.Encoded(), &SignedURLOptions{})
+			if err != nil {
+				return xerrors.Errorf("cannot sign chunk %s: %w", c.Digest, err)
+			}
+			infos[i] = *nfo
+			return nil
+		})
+	}
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	res = make(map[string]DownloadInfo, len(idx.Chunks)+1)
+	res[ChunkIndexName(name)] = *info
+	for i, c := range idx.Chunks {
+		res[QualifiedChunkName(idx, c.Digest)] = infos[i]
+	}
+	return res, nil
+}
+
+func downloadURL(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("non-OK status code: %v", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunk"
+)
+
+func TestChunkIndexName(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{Name: "backup name", Input: DefaultBackup, Expected: "full.tar.idx"},
+		{Name: "qualified name", Input: "workspaces/foo/snapshot-1.tar@gitpod-user-bar", Expected: "workspaces/foo/snapshot-1.tar.idx@gitpod-user-bar"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := ChunkIndexName(test.Input); act != test.Expected {
+				t.Errorf("unexpected index name: is '%s' but expected '%s'", act, test.Expected)
+			}
+		})
+	}
+}
+
+func TestChunkedRoundtrip(t *testing.T) {
+	var (
+		ctx = context.Background()
+		rs  = newMemoryStorage()
+		cfg = chunk.Config{MinSize: 512, AvgSize: 2048, MaxSize: 8192}
+	)
+
+	content := make([]byte, 64*1024)
+	rand.New(rand.NewSource(1)).Read(content)
+
+	idx, err := UploadChunked(ctx, rs, bytes.NewReader(buildTestTar(t, content)), DefaultBackup, ChunkedUploadOptions{TmpDir: t.TempDir(), Chunking: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(idx.Chunks) < 2 {
+		t.Fatalf("expected more than one chunk, got %d", len(idx.Chunks))
+	}
+	firstUploads := rs.Uploads()
+
+	// change a single byte - only a fraction of the chunks should be uploaded again
+	content[len(content)/2]++
+	_, err = UploadChunked(ctx, rs, bytes.NewReader(buildTestTar(t, content)), DefaultBackup, ChunkedUploadOptions{TmpDir: t.TempDir(), Chunking: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// each upload is the index plus the new chunks
+	if reuploaded := rs.Uploads() - firstUploads - 1; reuploaded >= len(idx.Chunks)/2 {
+		t.Errorf("expected few chunks to be uploaded again, but %d of %d were", reuploaded, len(idx.Chunks))
+	}
+
+	dst := t.TempDir()
+	found, err := DownloadChunked(ctx, rs, dst, DefaultBackup, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Fatal("expected chunked backup to be found")
+	}
+	act, err := os.ReadFile(filepath.Join(dst, "content.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(act, content) {
+		t.Errorf("restored content does not match the latest backup")
+	}
+
+	found, err = DownloadChunked(ctx, rs, t.TempDir(), "does-not-exist.tar", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Errorf("expected backup without chunk index not to be found")
+	}
+}
+
+func TestOpenBackup(t *testing.T) {
+	var (
+		ctx = context.Background()
+		rs  = newMemoryStorage()
+		cfg = chunk.Config{MinSize: 512, AvgSize: 2048, MaxSize: 8192}
+	)
+
+	content := make([]byte, 16*1024)
+	rand.New(rand.NewSource(1)).Read(content)
+	tarbal := buildTestTar(t, content)
+
+	_, err := UploadChunked(ctx, rs, bytes.NewReader(tarbal), DefaultBackup, ChunkedUploadOptions{TmpDir: t.TempDir(), Chunking: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := filepath.Join(t.TempDir(), "plain.tar")
+	err = os.WriteFile(fn, tarbal, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = rs.Upload(ctx, fn, "plain.tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{DefaultBackup, "plain.tar"} {
+		t.Run(name, func(t *testing.T) {
+			rc, err := OpenBackup(ctx, rs, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			act, err := io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(act, tarbal) {
+				t.Errorf("backup content does not match")
+			}
+		})
+	}
+
+	_, err = OpenBackup(ctx, rs, "does-not-exist.tar")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestChunkedCorruptChunk(t *testing.T) {
+	var (
+		ctx = context.Background()
+		rs  = newMemoryStorage()
+	)
+
+	_, err := UploadChunked(ctx, rs, bytes.NewReader(buildTestTar(t, []byte("hello world"))), DefaultBackup, ChunkedUploadOptions{TmpDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs.mu.Lock()
+	for name := range rs.objects {
+		if strings.Contains(name, "/"+chunkDir+"/") {
+			rs.objects[name] = []byte("corrupt")
+		}
+	}
+	rs.mu.Unlock()
+
+	_, err = DownloadChunked(ctx, rs, t.TempDir(), DefaultBackup, []archive.IDMapping{})
+	if err == nil {
+		t.Errorf("expected corrupt chunk to fail the download")
+	}
+}
+
+func TestGarbageCollectChunks(t *testing.T) {
+	var (
+		ctx = context.Background()
+		rs  = newMemoryStorage()
+		ps  = &memoryPresignedAccess{rs: rs}
+		cfg = chunk.Config{MinSize: 512, AvgSize: 2048, MaxSize: 8192}
+	)
+
+	content := make([]byte, 64*1024)
+	rand.New(rand.NewSource(1)).Read(content)
+	_, err := UploadChunked(ctx, rs, bytes.NewReader(buildTestTar(t, content)), "backups/1.tar", ChunkedUploadOptions{TmpDir: t.TempDir(), Chunking: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rand.New(rand.NewSource(2)).Read(content[:len(content)/2])
+	_, err = UploadChunked(ctx, rs, bytes.NewReader(buildTestTar(t, content)), "backups/2.tar", ChunkedUploadOptions{TmpDir: t.TempDir(), Chunking: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := GarbageCollectChunks(ctx, rs, ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 0 {
+		t.Errorf("expected no chunks to be deleted while all backups exist, but %d were", deleted)
+	}
+
+	rs.mu.Lock()
+	delete(rs.objects, rs.BackupObject("backups/1.tar"+ChunkIndexSuffix))
+	rs.mu.Unlock()
+	deleted, err = GarbageCollectChunks(ctx, rs, ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted == 0 {
+		t.Errorf("expected chunks only referenced by the deleted backup to be deleted")
+	}
+
+	_, err = DownloadChunked(ctx, rs, t.TempDir(), "backups/2.tar", nil)
+	if err != nil {
+		t.Errorf("remaining backup is broken: %v", err)
+	}
+
+	rs.mu.Lock()
+	rs.objects[rs.BackupObject("backups/2.tar"+ChunkIndexSuffix)] = []byte("corrupt")
+	rs.mu.Unlock()
+	_, err = GarbageCollectChunks(ctx, rs, ps)
+	if err == nil {
+		t.Errorf("expected an unreadable chunk index to fail the garbage collection")
+	}
+	if chunks, _ := rs.ListObjects(ctx, rs.BackupObject(ChunkPrefix())); len(chunks) == 0 {
+		t.Errorf("expected no chunks to be deleted if a chunk index cannot be read")
+	}
+}
+
+func buildTestTar(t *testing.T, content []byte) []byte {
+	var (
+		buf = bytes.NewBuffer(nil)
+		tw  = tar.NewWriter(buf)
+	)
+	err := tw.WriteHeader(&tar.Header{
+		Name:     "content.bin",
+		Size:     int64(len(content)),
+		Uid:      os.Getuid(),
+		Gid:      os.Getgid(),
+		Mode:     0644,
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tw.Write(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+	return buf.Bytes()
+}
+
+// memoryStorage is a DirectAccess which keeps all objects in memory
+type memoryStorage struct {
+	DirectNoopStorage
+
+	mu      sync.Mutex
+	objects map[string][]byte
+	uploads int
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{objects: make(map[string][]byte)}
+}
+
+func (rs *memoryStorage) Uploads() int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.uploads
+}
+
+func (rs *memoryStorage) Qualify(name string) string {
+	return rs.BackupObject(name) + "@test-bucket"
+}
+
+func (rs *memoryStorage) BackupObject(name string) string {
+	return "workspaces/test/" + name
+}
+
+func (rs *memoryStorage) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var res []string
+	for name := range rs.objects {
+		if strings.HasPrefix(name, prefix) {
+			res = append(res, name)
+		}
+	}
+	return res, nil
+}
+
+func (rs *memoryStorage) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (string, string, error) {
+	fc, err := os.ReadFile(source)
+	if err != nil {
+		return "", "", err
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.objects[rs.BackupObject(name)] = fc
+	rs.uploads++
+	return "test-bucket", rs.BackupObject(name), nil
+}
+
+func (rs *memoryStorage) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	obj := rs.BackupObject(name)
+	if strings.Contains(name, "@") {
+		obj, _, _ = strings.Cut(name, "@")
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	fc, ok := rs.objects[obj]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(fc)), nil
+}
+
+// memoryPresignedAccess deletes objects from a memoryStorage
+type memoryPresignedAccess struct {
+	PresignedNoopStorage
+
+	rs *memoryStorage
+}
+
+func (ps *memoryPresignedAccess) DeleteObject(ctx context.Context, bucket string, query *DeleteObjectQuery) error {
+	ps.rs.mu.Lock()
+	defer ps.rs.mu.Unlock()
+
+	if _, ok := ps.rs.objects[query.Name]; !ok {
+		return ErrNotFound
+	}
+	delete(ps.rs.objects, query.Name)
+	return nil
+}
@@ -0,0 +1,760 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+const (
+	// EncryptionAlgorithmAESGCMStream encrypts objects in 64KiB segments using AES-256-GCM
+	EncryptionAlgorithmAESGCMStream = "AES256-GCM-STREAM64K"
+
+	// FileKeyWrapperProvider is the name of the key wrapper provider which reads its keys from a keyring file
+	FileKeyWrapperProvider = "file"
+
+	// encryptionMagic prefixes every encrypted object so that we can tell encrypted and plain objects apart
+	encryptionMagic = "\x89GPENC\r\n"
+
+	encryptionSegmentSize = 64 * 1024
+	encryptionNoncePrefix = 7
+	encryptionMaxHeader   = 64 * 1024
+
+	dataKeySize = 32
+
+	dataKeyResolveConcurrency = 16
+)
+
+// KeyWrapper wraps and unwraps per-object data keys using key encryption keys (KEKs)
+type KeyWrapper interface {
+	// PrimaryKeyID identifies the KEK which is used for wrapping new data keys
+	PrimaryKeyID() string
+
+	// Wrap encrypts a data key with the primary KEK
+	Wrap(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+
+	// Unwrap decrypts a data key which was wrapped using the KEK identified by keyID
+	Unwrap(ctx context.Context, keyID string, wrapped []byte) (dataKey []byte, err error)
+}
+
+// KeyWrapperFactory produces a key wrapper from its configuration
+type KeyWrapperFactory func(cfg *config.EncryptionConfig) (KeyWrapper, error)
+
+var (
+	keyWrapperProvidersMu sync.Mutex
+	keyWrapperProviders   = map[string]KeyWrapperFactory{
+		FileKeyWrapperProvider: newFileKeyWrapper,
+	}
+)
+
+// RegisterKeyWrapperProvider makes a key wrapper provider, e.g. one backed by a KMS, available for configuration
+func RegisterKeyWrapperProvider(name string, factory KeyWrapperFactory) {
+	keyWrapperProvidersMu.Lock()
+	defer keyWrapperProvidersMu.Unlock()
+
+	keyWrapperProviders[name] = factory
+}
+
+// NewKeyWrapper produces the key wrapper configured in cfg
+func NewKeyWrapper(cfg *config.EncryptionConfig) (KeyWrapper, error) {
+	provider := cfg.Provider
+	if provider == "" {
+		provider = FileKeyWrapperProvider
+	}
+
+	keyWrapperProvidersMu.Lock()
+	factory, ok := keyWrapperProviders[provider]
+	keyWrapperProvidersMu.Unlock()
+	if !ok {
+		return nil, xerrors.Errorf("unknown key wrapper provider: %s", provider)
+	}
+	return factory(cfg)
+}
+
+// FileKeyring is the content of the keyring file read by the file key wrapper provider.
+// All keys are base64 encoded 256 bit AES keys. Keys must never be removed from the keyring
+// as long as there are objects which have their data keys wrapped with them.
+type FileKeyring struct {
+	// Primary is the ID of the key used to wrap new data keys
+	Primary string `json:"primary"`
+	// Keys maps key IDs to keys
+	Keys map[string]string `json:"keys"`
+}
+
+type fileKeyWrapper struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+func newFileKeyWrapper(cfg *config.EncryptionConfig) (KeyWrapper, error) {
+	if cfg.KeyringFile == "" {
+		return nil, xerrors.Errorf("keyringFile is required")
+	}
+	fc, err := os.ReadFile(cfg.KeyringFile)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read keyring: %w", err)
+	}
+	var keyring FileKeyring
+	err = json.Unmarshal(fc, &keyring)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse keyring: %w", err)
+	}
+	return NewFileKeyWrapper(keyring)
+}
+
+// NewFileKeyWrapper produces a key wrapper which uses the keys of a keyring
+func NewFileKeyWrapper(keyring FileKeyring) (KeyWrapper, error) {
+	if _, ok := keyring.Keys[keyring.Primary]; !ok {
+		return nil, xerrors.Errorf("primary key %s is not part of the keyring", keyring.Primary)
+	}
+
+	res := &fileKeyWrapper{
+		primary: keyring.Primary,
+		keys:    make(map[string]cipher.AEAD, len(keyring.Keys)),
+	}
+	for id, k := range keyring.Keys {
+		key, err := base64.StdEncoding.DecodeString(k)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid key %s: %w", id, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, xerrors.Errorf("invalid key %s: must be %d bytes long", id, dataKeySize)
+		}
+		aead, err := newAESGCM(key)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid key %s: %w", id, err)
+		}
+		res.keys[id] = aead
+	}
+	return res, nil
+}
+
+func (w *fileKeyWrapper) PrimaryKeyID() string {
+	return w.primary
+}
+
+func (w *fileKeyWrapper) Wrap(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error) {
+	aead := w.keys[w.primary]
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", nil, err
+	}
+	return w.primary, aead.Seal(nonce, nonce, dataKey, []byte(w.primary)), nil
+}
+
+func (w *fileKeyWrapper) Unwrap(ctx context.Context, keyID string, wrapped []byte) (dataKey []byte, err error) {
+	aead, ok := w.keys[keyID]
+	if !ok {
+		return nil, xerrors.Errorf("unknown key encryption key: %s", keyID)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, xerrors.Errorf("wrapped data key is too short")
+	}
+	nonce, ciphertext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err = aead.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptionHeader follows encryptionMagic and a uint32 length at the beginning of each encrypted object
+type encryptionHeader struct {
+	Algorithm   string `json:"alg"`
+	KeyID       string `json:"keyId"`
+	WrappedKey  []byte `json:"wrappedKey"`
+	NoncePrefix []byte `json:"noncePrefix"`
+}
+
+func (h *encryptionHeader) annotations() map[string]string {
+	return map[string]string{
+		ObjectAnnotationEncryptionAlgorithm: h.Algorithm,
+		ObjectAnnotationEncryptionKeyID:     h.KeyID,
+	}
+}
+
+func writeEncryptionHeader(w io.Writer, hdr *encryptionHeader) error {
+	fc, err := json.Marshal(hdr)
+	if err != nil {
+		return err
+	}
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(fc)))
+
+	for _, b := range [][]byte{[]byte(encryptionMagic), l[:], fc} {
+		_, err = w.Write(b)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readEncryptionHeader reads the encryption header of an object. If the object is not encrypted,
+// hdr is nil and nothing has been consumed from r.
+func readEncryptionHeader(r *bufio.Reader) (hdr *encryptionHeader, err error) {
+	magic, err := r.Peek(len(encryptionMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if string(magic) != encryptionMagic {
+		return nil, nil
+	}
+	_, _ = r.Discard(len(encryptionMagic))
+
+	var l [4]byte
+	_, err = io.ReadFull(r, l[:])
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read encryption header: %w", err)
+	}
+	size := binary.BigEndian.Uint32(l[:])
+	if size > encryptionMaxHeader {
+		return nil, xerrors.Errorf("encryption header is too large")
+	}
+	fc := make([]byte, size)
+	_, err = io.ReadFull(r, fc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read encryption header: %w", err)
+	}
+
+	hdr = &encryptionHeader{}
+	err = json.Unmarshal(fc, hdr)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse encryption header: %w", err)
+	}
+	if hdr.Algorithm != EncryptionAlgorithmAESGCMStream {
+		return nil, xerrors.Errorf("unsupported encryption algorithm: %s", hdr.Algorithm)
+	}
+	if len(hdr.NoncePrefix) != encryptionNoncePrefix {
+		return nil, xerrors.Errorf("invalid nonce prefix")
+	}
+	return hdr, nil
+}
+
+// segmentNonce computes the nonce of a segment. The last segment is marked so that truncation
+// of an encrypted object is detected.
+func segmentNonce(prefix []byte, idx uint32, last bool) []byte {
+	nonce := make([]byte, encryptionNoncePrefix+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[encryptionNoncePrefix:], idx)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// newEncryptionHeader produces a header with a new data key, which is wrapped using keys
+func newEncryptionHeader(ctx context.Context, keys KeyWrapper) (hdr *encryptionHeader, dataKey []byte, err error) {
+	dataKey = make([]byte, dataKeySize)
+	_, err = rand.Read(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyID, wrapped, err := keys.Wrap(ctx, dataKey)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot wrap data key: %w", err)
+	}
+	hdr = &encryptionHeader{
+		Algorithm:   EncryptionAlgorithmAESGCMStream,
+		KeyID:       keyID,
+		WrappedKey:  wrapped,
+		NoncePrefix: make([]byte, encryptionNoncePrefix),
+	}
+	_, err = rand.Read(hdr.NoncePrefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	return hdr, dataKey, nil
+}
+
+// encryptStream writes the encrypted content of src to dst. The data key is wrapped using keys.
+func encryptStream(ctx context.Context, dst io.Writer, src io.Reader, keys KeyWrapper) (hdr *encryptionHeader, err error) {
+	hdr, dataKey, err := newEncryptionHeader(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	err = writeEncryptionHeader(dst, hdr)
+	if err != nil {
+		return nil, err
+	}
+	err = encryptSegments(dst, src, hdr, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return hdr, nil
+}
+
+// encryptSegments writes the encrypted segments of src to dst
+func encryptSegments(dst io.Writer, src io.Reader, hdr *encryptionHeader, dataKey []byte) error {
+	aead, err := newAESGCM(dataKey)
+	if err != nil {
+		return err
+	}
+
+	var (
+		in  = bufio.NewReaderSize(src, encryptionSegmentSize)
+		buf = make([]byte, encryptionSegmentSize)
+		out = make([]byte, 0, encryptionSegmentSize+aead.Overhead())
+	)
+	for idx := uint32(0); ; idx++ {
+		n, rerr := io.ReadFull(in, buf)
+		if rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF {
+			return rerr
+		}
+		last := rerr != nil
+		if !last {
+			_, perr := in.Peek(1)
+			last = perr == io.EOF
+		}
+
+		out = aead.Seal(out[:0], segmentNonce(hdr.NoncePrefix, idx, last), buf[:n], nil)
+		_, err = dst.Write(out)
+		if err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// decryptingReader decrypts the segments following an encryption header
+type decryptingReader struct {
+	src         *bufio.Reader
+	aead        cipher.AEAD
+	noncePrefix []byte
+
+	idx   uint32
+	buf   []byte
+	plain []byte
+	done  bool
+}
+
+func (r *decryptingReader) Read(p []byte) (n int, err error) {
+	for len(r.plain) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		err = r.nextSegment()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n = copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *decryptingReader) nextSegment() error {
+	n, err := io.ReadFull(r.src, r.buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	last := err != nil
+	if !last {
+		_, perr := r.src.Peek(1)
+		last = perr == io.EOF
+	}
+
+	r.plain, err = r.aead.Open(r.buf[:0], segmentNonce(r.noncePrefix, r.idx, last), r.buf[:n], nil)
+	if err != nil {
+		return xerrors.Errorf("cannot decrypt segment %d: %w", r.idx, err)
+	}
+	r.idx++
+	r.done = last
+	return nil
+}
+
+// decryptStream returns a reader which decrypts src using the data key returned by dataKey.
+// If src is not encrypted, its content is passed through unchanged.
+func decryptStream(src io.Reader, dataKey func(hdr *encryptionHeader) ([]byte, error)) (io.Reader, error) {
+	in := bufio.NewReaderSize(src, encryptionSegmentSize)
+	hdr, err := readEncryptionHeader(in)
+	if err != nil {
+		return nil, err
+	}
+	if hdr == nil {
+		return in, nil
+	}
+
+	key, err := dataKey(hdr)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptingReader{
+		src:         in,
+		aead:        aead,
+		noncePrefix: hdr.NoncePrefix,
+		buf:         make([]byte, encryptionSegmentSize+aead.Overhead()),
+	}, nil
+}
+
+// DecryptWithDataKey returns a reader which decrypts src using an unwrapped data key, e.g. one from DownloadInfo.DataKey.
+// If src is not encrypted, its content is passed through unchanged.
+func DecryptWithDataKey(src io.Reader, dataKey []byte) (io.Reader, error) {
+	return decryptStream(src, func(hdr *encryptionHeader) ([]byte, error) {
+		if len(dataKey) == 0 {
+			return nil, xerrors.Errorf("object is encrypted but no data key is available")
+		}
+		return dataKey, nil
+	})
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+var _ DirectAccess = &EncryptedDirectAccess{}
+
+// NewEncryptedDirectAccess wraps a remote storage so that all content uploaded using Upload is encrypted
+// with a per-object data key, which in turn is wrapped using keys.
+func NewEncryptedDirectAccess(rs DirectAccess, keys KeyWrapper) *EncryptedDirectAccess {
+	return &EncryptedDirectAccess{
+		DirectAccess: rs,
+		Keys:         keys,
+	}
+}
+
+// EncryptedDirectAccess encrypts workspace backups and snapshots on upload and transparently decrypts them on download.
+// Objects uploaded using UploadInstance (e.g. headless logs) are consumed by other components through presigned URLs
+// and are therefore not encrypted. Plain objects, e.g. those uploaded before encryption was enabled, can still be downloaded.
+type EncryptedDirectAccess struct {
+	DirectAccess
+
+	Keys KeyWrapper
+}
+
+func (rs *EncryptedDirectAccess) dataKey(ctx context.Context) func(hdr *encryptionHeader) ([]byte, error) {
+	return func(hdr *encryptionHeader) ([]byte, error) {
+		return rs.Keys.Unwrap(ctx, hdr.KeyID, hdr.WrappedKey)
+	}
+}
+
+// Upload encrypts the source and uploads it to the remote storage
+func (rs *EncryptedDirectAccess) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "EncryptedUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	src, err := os.Open(source)
+	if err != nil {
+		return "", "", err
+	}
+	defer src.Close()
+
+	tmpf, err := os.CreateTemp(filepath.Dir(source), filepath.Base(source)+"-enc-*")
+	if err != nil {
+		return "", "", xerrors.Errorf("cannot create temp file: %w", err)
+	}
+	defer os.Remove(tmpf.Name())
+	defer tmpf.Close()
+
+	hdr, err := encryptStream(ctx, tmpf, src, rs.Keys)
+	if err != nil {
+		return "", "", xerrors.Errorf("cannot encrypt %s: %w", name, err)
+	}
+	err = tmpf.Close()
+	if err != nil {
+		return "", "", err
+	}
+
+	return rs.DirectAccess.Upload(ctx, tmpf.Name(), name, withEncryptionAnnotations(opts, hdr)...)
+}
+
+// newStreamTransform encrypts streams uploaded using NewStreamUpload. All attempts of an upload use the
+// same data key and nonce prefix, s.t. an upload of the same content can be resumed.
+func (rs *EncryptedDirectAccess) newStreamTransform(ctx context.Context) (streamTransform, error) {
+	hdr, dataKey, err := newEncryptionHeader(ctx, rs.Keys)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedStream{hdr: hdr, dataKey: dataKey}, nil
+}
+
+// encryptedStream encrypts the attempts of a stream upload
+type encryptedStream struct {
+	hdr     *encryptionHeader
+	dataKey []byte
+}
+
+func (s *encryptedStream) transform(src io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		err := writeEncryptionHeader(pw, s.hdr)
+		if err == nil {
+			err = encryptSegments(pw, src, s.hdr, s.dataKey)
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+func (s *encryptedStream) options() []UploadOption {
+	return withEncryptionAnnotations(nil, s.hdr)
+}
+
+// withEncryptionAnnotations adds the encryption annotations to the ones configured in opts
+func withEncryptionAnnotations(opts []UploadOption, hdr *encryptionHeader) []UploadOption {
+	return append(opts, func(o *UploadOptions) error {
+		annotations := hdr.annotations()
+		for k, v := range o.Annotations {
+			annotations[k] = v
+		}
+		o.Annotations = annotations
+		return nil
+	})
+}
+
+// Download takes the latest state from the remote storage, decrypts it and extracts it to a local path
+func (rs *EncryptedDirectAccess) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
+	return rs.download(ctx, destination, name, mappings)
+}
+
+// DownloadSnapshot downloads and decrypts a snapshot. The snapshot name is expected to be one produced by Qualify
+func (rs *EncryptedDirectAccess) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
+	return rs.download(ctx, destination, name, mappings)
+}
+
+func (rs *EncryptedDirectAccess) download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "EncryptedDownload")
+	span.SetTag("name", name)
+	defer tracing.FinishSpan(span, &err)
+
+	rc, err := rs.DownloadObject(ctx, name)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+
+	err = extractTarbal(ctx, destination, rc, mappings)
+	if err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// DownloadObject streams the decrypted content of an object. The name is either a backup name or one produced by Qualify
+func (rs *EncryptedDirectAccess) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	rc, err := rs.DirectAccess.DownloadObject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := decryptStream(rc, rs.dataKey(ctx))
+	if err != nil {
+		rc.Close()
+		return nil, xerrors.Errorf("cannot decrypt %s: %w", name, err)
+	}
+	return readCloser{Reader: r, Closer: rc}, nil
+}
+
+// ResolveDataKeys sets the DataKey of all encrypted objects in rc, so that they can be downloaded
+// and decrypted by a process which has no access to the key encryption keys.
+func (rs *EncryptedDirectAccess) ResolveDataKeys(ctx context.Context, rc map[string]DownloadInfo) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ResolveDataKeys")
+	defer tracing.FinishSpan(span, &err)
+
+	var (
+		mu  sync.Mutex
+		eg  errgroup.Group
+		cnt int
+	)
+	eg.SetLimit(dataKeyResolveConcurrency)
+	for name, info := range rc {
+		if info.Meta.EncryptionAlgorithm == "" {
+			continue
+		}
+		cnt++
+
+		name, info := name, info
+		eg.Go(func() error {
+			key, err := rs.fetchDataKey(ctx, info.URL)
+			if err != nil {
+				return xerrors.Errorf("cannot resolve data key of %s: %w", name, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			info.DataKey = key
+			rc[name] = info
+			return nil
+		})
+	}
+	span.LogKV("encryptedObjects", cnt)
+	return eg.Wait()
+}
+
+// fetchDataKey reads the encryption header of an object and unwraps its data key
+func (rs *EncryptedDirectAccess) fetchDataKey(ctx context.Context, url string) ([]byte, error) {
+	body, err := downloadURL(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	hdr, err := readEncryptionHeader(bufio.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if hdr == nil {
+		return nil, nil
+	}
+	return rs.Keys.Unwrap(ctx, hdr.KeyID, hdr.WrappedKey)
+}
+
+// Rewrap re-wraps the data key of an object using the primary key encryption key. The object's content
+// is not re-encrypted. Returns false if the object is not encrypted or already uses the primary key.
+func (rs *EncryptedDirectAccess) Rewrap(ctx context.Context, name string) (rewrapped bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Rewrap")
+	span.SetTag("name", name)
+	defer tracing.FinishSpan(span, &err)
+
+	ar, ok := rs.DirectAccess.(ObjectAttributesReader)
+	if !ok {
+		return false, xerrors.Errorf("storage cannot read object attributes")
+	}
+
+	rc, err := rs.DirectAccess.DownloadObject(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+
+	in := bufio.NewReaderSize(rc, encryptionSegmentSize)
+	hdr, err := readEncryptionHeader(in)
+	if err != nil {
+		return false, err
+	}
+	if hdr == nil || hdr.KeyID == rs.Keys.PrimaryKeyID() {
+		return false, nil
+	}
+
+	// the re-upload replaces the object, hence we must carry over everything it was uploaded with
+	attrs, err := ar.ObjectAttributes(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	dataKey, err := rs.Keys.Unwrap(ctx, hdr.KeyID, hdr.WrappedKey)
+	if err != nil {
+		return false, err
+	}
+	hdr.KeyID, hdr.WrappedKey, err = rs.Keys.Wrap(ctx, dataKey)
+	if err != nil {
+		return false, err
+	}
+
+	tmpf, err := os.CreateTemp("", "rewrap-*")
+	if err != nil {
+		return false, err
+	}
+	defer os.Remove(tmpf.Name())
+	defer tmpf.Close()
+
+	err = writeEncryptionHeader(tmpf, hdr)
+	if err != nil {
+		return false, err
+	}
+	_, err = io.Copy(tmpf, in)
+	if err != nil {
+		return false, err
+	}
+	err = tmpf.Close()
+	if err != nil {
+		return false, err
+	}
+
+	_, _, err = rs.DirectAccess.Upload(ctx, tmpf.Name(), name, WithContentType(attrs.ContentType), WithAnnotations(mergeAnnotations(attrs.Annotations, hdr.annotations())))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// mergeAnnotations overrides the annotations in base with those of overrides. Some storages change the case of
+// annotation keys, hence keys are compared case-insensitively.
+func mergeAnnotations(base, overrides map[string]string) map[string]string {
+	res := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		res[k] = v
+	}
+	for ok, ov := range overrides {
+		for k := range res {
+			if strings.EqualFold(k, ok) {
+				delete(res, k)
+			}
+		}
+		res[ok] = ov
+	}
+	return res
+}
+
+// RewrapAll re-wraps the data keys of all objects of the workspace using the primary key encryption key
+func (rs *EncryptedDirectAccess) RewrapAll(ctx context.Context) (rewrapped int, err error) {
+	_, prefix := workspaceLocation(rs)
+	objs, err := rs.ListObjects(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, obj := range objs {
+		name := strings.TrimPrefix(obj, prefix)
+		ok, err := rs.Rewrap(ctx, name)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return rewrapped, xerrors.Errorf("cannot rewrap %s: %w", name, err)
+		}
+		if ok {
+			rewrapped++
+			log.WithField("name", name).Debug("rewrapped data key")
+		}
+	}
+	return rewrapped, nil
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+func newTestKeyWrapper(t *testing.T, primary string, ids ...string) KeyWrapper {
+	keyring := FileKeyring{Primary: primary, Keys: make(map[string]string)}
+	for _, id := range append(ids, primary) {
+		key := make([]byte, dataKeySize)
+		_, _ = rand.Read(key)
+		keyring.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	}
+	keys, err := NewFileKeyWrapper(keyring)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func TestEncryptStream(t *testing.T) {
+	keys := newTestKeyWrapper(t, "k1")
+	dataKey := func(hdr *encryptionHeader) ([]byte, error) {
+		return keys.Unwrap(context.Background(), hdr.KeyID, hdr.WrappedKey)
+	}
+
+	tests := []struct {
+		Name string
+		Size int
+	}{
+		{Name: "empty", Size: 0},
+		{Name: "small", Size: 100},
+		{Name: "one segment", Size: encryptionSegmentSize},
+		{Name: "multiple segments", Size: 3*encryptionSegmentSize + 17},
+		{Name: "exact multiple", Size: 4 * encryptionSegmentSize},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			plain := make([]byte, test.Size)
+			_, _ = rand.Read(plain)
+
+			var enc bytes.Buffer
+			hdr, err := encryptStream(context.Background(), &enc, bytes.NewReader(plain), keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hdr.KeyID != "k1" {
+				t.Errorf("unexpected key ID %s", hdr.KeyID)
+			}
+			if test.Size > 0 && bytes.Contains(enc.Bytes(), plain) {
+				t.Fatal("encrypted content contains plain text")
+			}
+
+			r, err := decryptStream(bytes.NewReader(enc.Bytes()), dataKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			act, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(act, plain) {
+				t.Errorf("decrypted content does not match")
+			}
+
+			// drop the last byte, or the entire last segment if there are multiple segments
+			cut := 1
+			if test.Size > encryptionSegmentSize {
+				cut = test.Size%encryptionSegmentSize + 16
+				if test.Size%encryptionSegmentSize == 0 {
+					cut = encryptionSegmentSize + 16
+				}
+			}
+			truncated := enc.Bytes()[:enc.Len()-cut]
+			r, err = decryptStream(bytes.NewReader(truncated), dataKey)
+			if err == nil {
+				_, err = io.ReadAll(r)
+			}
+			if err == nil {
+				t.Errorf("expected truncated content to fail decryption")
+			}
+		})
+	}
+}
+
+func TestDecryptPlainContent(t *testing.T) {
+	plain := []byte("this is not encrypted")
+	r, err := DecryptWithDataKey(bytes.NewReader(plain), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	act, _ := io.ReadAll(r)
+	if !bytes.Equal(act, plain) {
+		t.Errorf("plain content was not passed through: %q", act)
+	}
+}
+
+func TestEncryptedDirectAccess(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.LocalConfig{
+		Path:       t.TempDir(),
+		SigningKey: "secret",
+	}
+	handler, err := NewLocalStorageHandler(config.LocalConfig{Path: cfg.Path, SigningKey: cfg.SigningKey, BaseURL: "http://unused"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	cfg.BaseURL = srv.URL
+
+	local, err := newDirectLocalAccess(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs := NewEncryptedDirectAccess(local, newTestKeyWrapper(t, "k1"))
+	err = rs.Init(ctx, "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := newPresignedLocalAccess(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := []byte("some source code")
+	src := filepath.Join(t.TempDir(), "src")
+	err = os.WriteFile(src, content, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bkt, obj, err := rs.Upload(ctx, src, DefaultBackup, WithContentType("application/tar"), WithAnnotations(map[string]string{ObjectAnnotationDigest: "sha256:abc"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readObject := func(rc io.ReadCloser, err error) []byte {
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rc.Close()
+		res, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	if raw := readObject(local.DownloadObject(ctx, DefaultBackup)); bytes.Contains(raw, content) {
+		t.Errorf("stored object is not encrypted")
+	}
+	if act := readObject(rs.DownloadObject(ctx, DefaultBackup)); !bytes.Equal(act, content) {
+		t.Errorf("unexpected content: %q", act)
+	}
+
+	info, err := ps.SignDownload(ctx, bkt, obj, &SignedURLOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Meta.EncryptionAlgorithm != EncryptionAlgorithmAESGCMStream || info.Meta.EncryptionKeyID != "k1" {
+		t.Errorf("unexpected object meta: %+v", info.Meta)
+	}
+	rc := map[string]DownloadInfo{DefaultBackup: *info}
+	err = rs.ResolveDataKeys(ctx, rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := downloadURL(ctx, rc[DefaultBackup].URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := DecryptWithDataKey(body, rc[DefaultBackup].DataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act := readObject(io.NopCloser(r), nil); !bytes.Equal(act, content) {
+		t.Errorf("unexpected content using resolved data key: %q", act)
+	}
+	body.Close()
+
+	// rotate the key encryption key
+	rotated := newTestKeyWrapper(t, "k2")
+	rs.Keys = &multiKeyWrapper{KeyWrapper: rotated, old: rs.Keys}
+	n, err := rs.RewrapAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("expected one object to be rewrapped, got %d", n)
+	}
+	rs.Keys = rotated
+	if act := readObject(rs.DownloadObject(ctx, DefaultBackup)); !bytes.Equal(act, content) {
+		t.Errorf("unexpected content after rewrap: %q", act)
+	}
+	hdr, err := readEncryptionHeader(bufio.NewReader(bytes.NewReader(readObject(local.DownloadObject(ctx, DefaultBackup)))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hdr.KeyID != "k2" {
+		t.Errorf("expected object to be wrapped with k2, got %s", hdr.KeyID)
+	}
+	attrs, err := local.ObjectAttributes(ctx, DefaultBackup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attrs.ContentType != "application/tar" || attrs.Annotations[ObjectAnnotationDigest] != "sha256:abc" || attrs.Annotations[ObjectAnnotationEncryptionKeyID] != "k2" {
+		t.Errorf("rewrap did not keep the object's attributes: %+v", attrs)
+	}
+}
+
+// multiKeyWrapper wraps with its primary key and unwraps with either key
+type multiKeyWrapper struct {
+	KeyWrapper
+	old KeyWrapper
+}
+
+func (m *multiKeyWrapper) Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	if keyID == m.PrimaryKeyID() {
+		return m.KeyWrapper.Unwrap(ctx, keyID, wrapped)
+	}
+	return m.old.Unwrap(ctx, keyID, wrapped)
+}
@@ -6,15 +6,19 @@ package storage
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"io/fs"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +39,7 @@ import (
 )
 
 var _ DirectAccess = &DirectGCPStorage{}
+var _ ObjectAttributesReader = &DirectGCPStorage{}
 
 var validateExistsInFilesystem = validation.By(func(o interface{}) error {
 	s, ok := o.(string)
@@ -301,6 +306,48 @@ func (rs *DirectGCPStorage) DownloadSnapshot(ctx context.Context, destination st
 	return rs.download(ctx, destination, bkt, obj, mappings)
 }
 
+// DownloadObject streams the raw content of an object. The name is either a backup name or one produced by Qualify
+func (rs *DirectGCPStorage) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	bkt, obj := rs.bucketName(), rs.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		bkt, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rc, _, err := rs.ObjectAccess(ctx, bkt, obj)
+	if errors.Is(err, gcpstorage.ErrObjectNotExist) || errors.Is(err, gcpstorage.ErrBucketNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rc, nil
+}
+
+// ObjectAttributes returns the content type and annotations of an object
+func (rs *DirectGCPStorage) ObjectAttributes(ctx context.Context, name string) (*UploadOptions, error) {
+	bkt, obj := rs.bucketName(), rs.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		bkt, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	attrs, err := rs.client.Bucket(bkt).Object(obj).Attrs(ctx)
+	if errors.Is(err, gcpstorage.ErrObjectNotExist) || errors.Is(err, gcpstorage.ErrBucketNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &UploadOptions{ContentType: attrs.ContentType, Annotations: attrs.Metadata}, nil
+}
+
 // ParseSnapshotName parses the name of a snapshot into bucket and object
 func ParseSnapshotName(name string) (bkt, obj string, err error) {
 	segments := strings.Split(name, "@")
@@ -429,6 +476,142 @@ func (rs *DirectGCPStorage) Upload(ctx context.Context, source string, name stri
 	return
 }
 
+// gcpMaxComposeSources is the maximum number of objects GCS can compose in a single request
+const gcpMaxComposeSources = 32
+
+// NewMultipartUpload starts the upload of an object in parts. GCS has no multipart uploads
+// with individually uploaded parts, hence we upload each part as a temporary object and compose them.
+func (rs *DirectGCPStorage) NewMultipartUpload(ctx context.Context, name string, opts ...UploadOption) (MultipartUpload, error) {
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get options: %w", err)
+	}
+	if rs.client == nil {
+		return nil, xerrors.Errorf("no gcloud client available - did you call Init()?")
+	}
+
+	bucket := rs.bucketName()
+	err = gcpEnsureExists(ctx, rs.client, bucket, rs.GCPConfig)
+	if err != nil {
+		return nil, xerrors.Errorf("unexpected error: %w", err)
+	}
+
+	id := make([]byte, 8)
+	_, err = rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	object := rs.objectName(name)
+	return &gcpMultipartUpload{
+		bucket:     rs.client.Bucket(bucket),
+		object:     object,
+		partPrefix: fmt.Sprintf("%s.parts/%s/", object, hex.EncodeToString(id)),
+		options:    options,
+	}, nil
+}
+
+type gcpMultipartUpload struct {
+	bucket     *gcpstorage.BucketHandle
+	object     string
+	partPrefix string
+	options    *UploadOptions
+}
+
+// UploadPart uploads a part as temporary object. GCS verifies the part's CRC32C checksum.
+func (u *gcpMultipartUpload) UploadPart(ctx context.Context, part *UploadedPart, data io.ReadSeeker) error {
+	h := sha256.New()
+	crc := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	_, err := io.Copy(io.MultiWriter(h, crc), data)
+	if err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != part.Checksum {
+		return xerrors.Errorf("checksum mismatch for part %d: expected %s, got %s", part.Number, part.Checksum, sum)
+	}
+	_, err = data.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	w := u.bucket.Object(u.partName(part.Number)).NewWriter(ctx)
+	w.CRC32C = crc.Sum32()
+	w.SendCRC32C = true
+	_, err = io.Copy(w, data)
+	if err != nil {
+		_ = w.Close()
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+	part.ETag = strconv.FormatInt(w.Attrs().Generation, 10)
+	return nil
+}
+
+// Complete composes the parts into the object and removes the temporary objects
+func (u *gcpMultipartUpload) Complete(ctx context.Context, parts []UploadedPart) (size int64, err error) {
+	srcs := make([]*gcpstorage.ObjectHandle, 0, len(parts))
+	for _, p := range parts {
+		srcs = append(srcs, u.bucket.Object(u.partName(p.Number)))
+	}
+
+	// compose the parts in a tree, because a single compose request is limited in the number of source objects
+	for level := 0; len(srcs) > gcpMaxComposeSources; level++ {
+		var next []*gcpstorage.ObjectHandle
+		for i := 0; i < len(srcs); i += gcpMaxComposeSources {
+			end := i + gcpMaxComposeSources
+			if end > len(srcs) {
+				end = len(srcs)
+			}
+			dst := u.bucket.Object(fmt.Sprintf("%scompose-%d-%d", u.partPrefix, level, i/gcpMaxComposeSources))
+			_, err = dst.ComposerFrom(srcs[i:end]...).Run(ctx)
+			if err != nil {
+				return 0, xerrors.Errorf("cannot compose parts: %w", err)
+			}
+			next = append(next, dst)
+		}
+		srcs = next
+	}
+
+	composer := u.bucket.Object(u.object).ComposerFrom(srcs...)
+	composer.ContentType = u.options.ContentType
+	composer.Metadata = u.options.Annotations
+	attrs, err := composer.Run(ctx)
+	if err != nil {
+		return 0, xerrors.Errorf("cannot compose object: %w", err)
+	}
+
+	err = u.Abort(ctx)
+	if err != nil {
+		log.WithError(err).WithField("prefix", u.partPrefix).Warn("cannot remove uploaded parts")
+	}
+	return attrs.Size, nil
+}
+
+// Abort removes all temporary objects of this upload
+func (u *gcpMultipartUpload) Abort(ctx context.Context) error {
+	it := u.bucket.Objects(ctx, &gcpstorage.Query{Prefix: u.partPrefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = u.bucket.Object(attrs.Name).Delete(ctx)
+		if err != nil && !errors.Is(err, gcpstorage.ErrObjectNotExist) {
+			return err
+		}
+	}
+}
+
+func (u *gcpMultipartUpload) partName(num int) string {
+	return fmt.Sprintf("%s%05d", u.partPrefix, num)
+}
+
 func (rs *DirectGCPStorage) bucketName() string {
 	return gcpBucketName(rs.Stage, rs.Username)
 }
@@ -618,6 +801,9 @@ func (p *PresignedGCPStorage) downloadInfo(ctx context.Context, client *gcpstora
 		OCIMediaType:       obj.Metadata[ObjectAnnotationOCIContentType],
 		Digest:             obj.Metadata[ObjectAnnotationDigest],
 		UncompressedDigest: obj.Metadata[ObjectAnnotationUncompressedDigest],
+
+		EncryptionAlgorithm: obj.Metadata[ObjectAnnotationEncryptionAlgorithm],
+		EncryptionKeyID:     obj.Metadata[ObjectAnnotationEncryptionKeyID],
 	}
 	url, err := gcpstorage.SignedURL(obj.Bucket, obj.Name, &gcpstorage.SignedURLOptions{
 		Method:         "GET",
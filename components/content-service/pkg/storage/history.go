@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+const (
+	// BackupManifest is the name of the manifest which lists the backup generations of a workspace
+	BackupManifest = "backups.json"
+
+	// backupGenerationDir is the directory (relative to the workspace) in which we store backup generations
+	backupGenerationDir = "backups"
+
+	// backupIDFormat formats backup IDs such that they sort in the order the backups were made
+	backupIDFormat = "20060102T150405.000Z"
+)
+
+// ErrUnknownBackup is returned when a backup ID is not part of a workspace's backup history
+var ErrUnknownBackup = errors.New("unknown backup")
+
+// BackupGenerationPrefix is the name prefix of all backup generations of a workspace
+func BackupGenerationPrefix() string {
+	return backupGenerationDir + "/"
+}
+
+// NewBackupGeneration describes a new backup generation made at t by a workspace instance
+func NewBackupGeneration(t time.Time, instanceID string) csapi.WorkspaceBackupGeneration {
+	t = t.UTC()
+	id := t.Format(backupIDFormat)
+	return csapi.WorkspaceBackupGeneration{
+		ID:         id,
+		Name:       BackupGenerationPrefix() + id + ".tar",
+		Created:    t,
+		InstanceID: instanceID,
+	}
+}
+
+// DownloadBackupManifest downloads and parses the backup manifest of a workspace.
+// Returns ErrNotFound if the workspace has no backup history.
+func DownloadBackupManifest(ctx context.Context, rs DirectDownloader) (*csapi.WorkspaceBackupManifest, error) {
+	rc, err := rs.DownloadObject(ctx, BackupManifest)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var mf csapi.WorkspaceBackupManifest
+	err = json.NewDecoder(rc).Decode(&mf)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse backup manifest: %w", err)
+	}
+	return &mf, nil
+}
+
+// UploadBackupManifest uploads the backup manifest of a workspace. tmpdir is where the manifest is staged before it's uploaded.
+func UploadBackupManifest(ctx context.Context, rs DirectAccess, mf *csapi.WorkspaceBackupManifest, tmpdir string) error {
+	fc, err := json.Marshal(mf)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(tmpdir, "backup-manifest-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(fc)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = rs.Upload(ctx, f.Name(), BackupManifest, WithContentType(csapi.ContentTypeBackupManifest))
+	if err != nil {
+		return xerrors.Errorf("cannot upload backup manifest: %w", err)
+	}
+	return nil
+}
+
+// ResolveBackup finds a backup in the manifest. An empty ID resolves to the latest backup.
+// Returns ErrUnknownBackup if there is no such backup.
+func ResolveBackup(mf *csapi.WorkspaceBackupManifest, id string) (*csapi.WorkspaceBackupGeneration, error) {
+	if mf == nil || len(mf.Backups) == 0 {
+		return nil, ErrUnknownBackup
+	}
+	if id == "" {
+		return &mf.Backups[len(mf.Backups)-1], nil
+	}
+	for i := range mf.Backups {
+		if mf.Backups[i].ID == id {
+			return &mf.Backups[i], nil
+		}
+	}
+	return nil, xerrors.Errorf("%w: %s", ErrUnknownBackup, id)
+}
+
+// AddBackupGeneration adds a backup to the manifest and removes all but the latest keep generations from it.
+// It returns the removed generations, which the caller is expected to delete from the remote storage.
+func AddBackupGeneration(mf *csapi.WorkspaceBackupManifest, backup csapi.WorkspaceBackupGeneration, keep int) (removed []csapi.WorkspaceBackupGeneration) {
+	mf.Backups = append(mf.Backups, backup)
+	sort.SliceStable(mf.Backups, func(i, j int) bool { return mf.Backups[i].Created.Before(mf.Backups[j].Created) })
+
+	if keep < 1 {
+		keep = 1
+	}
+	if len(mf.Backups) <= keep {
+		return nil
+	}
+	n := len(mf.Backups) - keep
+	removed = append(removed, mf.Backups[:n]...)
+	mf.Backups = append([]csapi.WorkspaceBackupGeneration{}, mf.Backups[n:]...)
+	return removed
+}
+
+// DeleteBackupGeneration deletes the objects of a backup generation, including its chunk index.
+// The chunks of chunked backups are shared between generations, use GarbageCollectChunks to delete those
+// no generation references anymore.
+func DeleteBackupGeneration(ctx context.Context, ps PresignedAccess, bkt, obj string) error {
+	for _, name := range []string{obj, obj + ChunkIndexSuffix} {
+		err := ps.DeleteObject(ctx, bkt, &DeleteObjectQuery{Name: name})
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return xerrors.Errorf("cannot delete %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+func TestAddBackupGeneration(t *testing.T) {
+	start := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	gen := func(i int) csapi.WorkspaceBackupGeneration {
+		return NewBackupGeneration(start.Add(time.Duration(i)*time.Minute), "instance")
+	}
+	ids := func(bs []csapi.WorkspaceBackupGeneration) []string {
+		res := []string{}
+		for _, b := range bs {
+			res = append(res, b.ID)
+		}
+		return res
+	}
+
+	tests := []struct {
+		Name            string
+		Existing        int
+		Keep            int
+		ExpectedBackups []string
+		ExpectedRemoved []string
+	}{
+		{
+			Name:            "first backup",
+			Keep:            3,
+			ExpectedBackups: []string{gen(0).ID},
+			ExpectedRemoved: []string{},
+		},
+		{
+			Name:            "below limit",
+			Existing:        1,
+			Keep:            3,
+			ExpectedBackups: []string{gen(0).ID, gen(1).ID},
+			ExpectedRemoved: []string{},
+		},
+		{
+			Name:            "prune oldest",
+			Existing:        3,
+			Keep:            3,
+			ExpectedBackups: []string{gen(1).ID, gen(2).ID, gen(3).ID},
+			ExpectedRemoved: []string{gen(0).ID},
+		},
+		{
+			Name:            "history disabled keeps latest",
+			Existing:        3,
+			Keep:            0,
+			ExpectedBackups: []string{gen(3).ID},
+			ExpectedRemoved: []string{gen(0).ID, gen(1).ID, gen(2).ID},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mf := &csapi.WorkspaceBackupManifest{}
+			for i := 0; i < test.Existing; i++ {
+				mf.Backups = append(mf.Backups, gen(i))
+			}
+
+			removed := AddBackupGeneration(mf, gen(test.Existing), test.Keep)
+			if diff := cmp.Diff(test.ExpectedBackups, ids(mf.Backups)); diff != "" {
+				t.Errorf("unexpected backups (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpectedRemoved, ids(removed)); diff != "" {
+				t.Errorf("unexpected removed backups (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveBackup(t *testing.T) {
+	older := NewBackupGeneration(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC), "a")
+	latest := NewBackupGeneration(time.Date(2023, 5, 2, 12, 0, 0, 0, time.UTC), "b")
+	mf := &csapi.WorkspaceBackupManifest{Backups: []csapi.WorkspaceBackupGeneration{older, latest}}
+
+	tests := []struct {
+		Name        string
+		Manifest    *csapi.WorkspaceBackupManifest
+		ID          string
+		Expectation string
+		Error       error
+	}{
+		{Name: "latest", Manifest: mf, Expectation: latest.Name},
+		{Name: "by id", Manifest: mf, ID: older.ID, Expectation: older.Name},
+		{Name: "unknown id", Manifest: mf, ID: "foobar", Error: ErrUnknownBackup},
+		{Name: "empty manifest", Manifest: &csapi.WorkspaceBackupManifest{}, Error: ErrUnknownBackup},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := ResolveBackup(test.Manifest, test.ID)
+			if !errors.Is(err, test.Error) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if b.Name != test.Expectation {
+				t.Errorf("expected %s, got %s", test.Expectation, b.Name)
+			}
+		})
+	}
+}
+
+func TestBackupManifestRoundtrip(t *testing.T) {
+	ctx := context.Background()
+	rs := newTestLocalStorage(t)
+
+	_, err := DownloadBackupManifest(ctx, rs)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	mf := &csapi.WorkspaceBackupManifest{}
+	AddBackupGeneration(mf, NewBackupGeneration(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC), "instance"), 3)
+	err = UploadBackupManifest(ctx, rs, mf, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	act, err := DownloadBackupManifest(ctx, rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(mf, act); diff != "" {
+		t.Errorf("unexpected manifest (-want +got):\n%s", diff)
+	}
+}
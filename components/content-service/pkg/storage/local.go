@@ -0,0 +1,970 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+var (
+	_ DirectAccess    = &DirectLocalStorage{}
+	_ PresignedAccess = &presignedLocalStorage{}
+)
+
+const (
+	// localMetaDir is the directory (relative to the storage path) in which we keep object metadata.
+	// Bucket names never start with a dot, hence this cannot clash with a bucket.
+	localMetaDir = ".meta"
+
+	// localUploadDir is the directory (relative to the storage path) in which we stage the parts of multipart uploads
+	localUploadDir = ".uploads"
+
+	localDefaultURLExpiry = 30 * time.Minute
+
+	localParamExpires     = "expires"
+	localParamContentType = "contentType"
+	localParamSignature   = "signature"
+)
+
+// ValidateLocalConfig checks if the local storage config is valid
+func ValidateLocalConfig(c *config.LocalConfig) error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Path, validation.Required),
+	)
+}
+
+// validateLocalSigningConfig checks if the local storage config is valid for signing URLs
+func validateLocalSigningConfig(c *config.LocalConfig) error {
+	err := ValidateLocalConfig(c)
+	if err != nil {
+		return err
+	}
+	return validation.ValidateStruct(c,
+		validation.Field(&c.BaseURL, validation.Required),
+		validation.Field(&c.SigningKey, validation.Required),
+	)
+}
+
+// addLocalParamsFromMounts allows for the signing key to be read from a file
+func addLocalParamsFromMounts(c *config.LocalConfig) error {
+	if c.SigningKeyFile != "" {
+		value, err := os.ReadFile(c.SigningKeyFile)
+		if err != nil {
+			return err
+		}
+		c.SigningKey = strings.TrimSpace(string(value))
+	}
+	return nil
+}
+
+func localBucketName(ownerID string) string {
+	return fmt.Sprintf("gitpod-user-%s", ownerID)
+}
+
+func localWorkspaceBackupObjectName(workspaceID, name string) string {
+	return path.Join("workspaces", workspaceID, name)
+}
+
+// localObjectMeta is the metadata we store alongside each object
+type localObjectMeta struct {
+	ContentType string            `json:"contentType,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// localStore stores objects as files in <root>/<bucket>/<object>
+type localStore struct {
+	Root string
+}
+
+func (s localStore) bucketPath(bkt string) (string, error) {
+	if bkt == "" || strings.HasPrefix(bkt, ".") || strings.ContainsAny(bkt, `/\`) {
+		return "", xerrors.Errorf("invalid bucket name: %s", bkt)
+	}
+	return filepath.Join(s.Root, bkt), nil
+}
+
+func (s localStore) objectPath(bkt, obj string) (string, error) {
+	if obj == "" {
+		return "", xerrors.Errorf("invalid object name: %s", obj)
+	}
+	for _, seg := range strings.Split(obj, "/") {
+		if seg == "" || seg == "." || seg == ".." || strings.Contains(seg, `\`) {
+			return "", xerrors.Errorf("invalid object name: %s", obj)
+		}
+	}
+	bp, err := s.bucketPath(bkt)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(bp, filepath.FromSlash(obj)), nil
+}
+
+func (s localStore) metaPath(bkt, obj string) (string, error) {
+	_, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.Root, localMetaDir, bkt, filepath.FromSlash(obj)+".json"), nil
+}
+
+// open opens an object for reading. Returns ErrNotFound if the object does not exist.
+func (s localStore) open(bkt, obj string) (*os.File, localObjectMeta, error) {
+	var meta localObjectMeta
+
+	fn, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return nil, meta, err
+	}
+	f, err := os.Open(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, meta, ErrNotFound
+	}
+	if err != nil {
+		return nil, meta, err
+	}
+
+	mfn, _ := s.metaPath(bkt, obj)
+	mc, err := os.ReadFile(mfn)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		f.Close()
+		return nil, meta, err
+	}
+	if err == nil {
+		err = json.Unmarshal(mc, &meta)
+		if err != nil {
+			f.Close()
+			return nil, meta, xerrors.Errorf("cannot parse metadata of %s/%s: %w", bkt, obj, err)
+		}
+	}
+	return f, meta, nil
+}
+
+// put atomically writes an object and its metadata
+func (s localStore) put(bkt, obj string, src io.Reader, meta localObjectMeta) (err error) {
+	fn, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return err
+	}
+	mfn, _ := s.metaPath(bkt, obj)
+
+	err = os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(mfn), 0755)
+	if err != nil {
+		return err
+	}
+
+	mc, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	err = writeFileAtomic(mfn, func(w io.Writer) error {
+		_, err := w.Write(mc)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(fn, func(w io.Writer) error {
+		_, err := io.Copy(w, src)
+		return err
+	})
+}
+
+// writeFileAtomic writes to a temporary file next to fn and renames it once write succeeded,
+// so that readers never observe a partially written object.
+func writeFileAtomic(fn string, write func(w io.Writer) error) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(fn), "."+filepath.Base(fn)+"-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+
+	err = write(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), fn)
+}
+
+// list returns all objects in a bucket that have the given prefix
+func (s localStore) list(bkt, prefix string, fn func(obj string, info fs.FileInfo) error) error {
+	bp, err := s.bucketPath(bkt)
+	if err != nil {
+		return err
+	}
+
+	err = filepath.Walk(bp, func(p string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			return nil
+		}
+		rel, err := filepath.Rel(bp, p)
+		if err != nil {
+			return err
+		}
+		obj := filepath.ToSlash(rel)
+		if !strings.HasPrefix(obj, prefix) {
+			return nil
+		}
+		return fn(obj, info)
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		// bucket does not exist: nothing to list
+		return nil
+	}
+	return err
+}
+
+func (s localStore) remove(bkt, obj string) error {
+	fn, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return err
+	}
+	mfn, _ := s.metaPath(bkt, obj)
+
+	err = os.Remove(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	err = os.Remove(mfn)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// newDirectLocalAccess provides direct access to the local storage
+func newDirectLocalAccess(cfg config.LocalConfig) (*DirectLocalStorage, error) {
+	err := ValidateLocalConfig(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &DirectLocalStorage{LocalConfig: cfg}, nil
+}
+
+// DirectLocalStorage implements a local or network filesystem as remote storage backend
+type DirectLocalStorage struct {
+	Username      string
+	WorkspaceName string
+	InstanceID    string
+	LocalConfig   config.LocalConfig
+
+	store localStore
+}
+
+// Validate checks if the local storage is configured properly
+func (rs *DirectLocalStorage) Validate() error {
+	err := ValidateLocalConfig(&rs.LocalConfig)
+	if err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(rs,
+		validation.Field(&rs.Username, validation.Required),
+		validation.Field(&rs.WorkspaceName, validation.Required),
+	)
+}
+
+// Init initializes the remote storage - call this before calling anything else on the interface
+func (rs *DirectLocalStorage) Init(ctx context.Context, owner, workspace, instance string) (err error) {
+	rs.Username = owner
+	rs.WorkspaceName = workspace
+	rs.InstanceID = instance
+
+	err = rs.Validate()
+	if err != nil {
+		return err
+	}
+
+	rs.store = localStore{Root: rs.LocalConfig.Path}
+	return nil
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (rs *DirectLocalStorage) EnsureExists(ctx context.Context) (err error) {
+	return localEnsureExists(ctx, rs.store, rs.bucketName())
+}
+
+func localEnsureExists(ctx context.Context, store localStore, bucket string) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.EnsureExists")
+	defer tracing.FinishSpan(span, &err)
+
+	bp, err := store.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(bp, 0755)
+	if err != nil {
+		return xerrors.Errorf("cannot create bucket: %w", err)
+	}
+	return nil
+}
+
+func (rs *DirectLocalStorage) download(ctx context.Context, destination string, bkt string, obj string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "download")
+	span.SetTag("bucket", bkt)
+	span.SetTag("object", obj)
+	defer tracing.FinishSpan(span, &err)
+
+	f, _, err := rs.store.open(bkt, obj)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	err = extractTarbal(ctx, destination, f, mappings)
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// Download takes the latest state from the remote storage and downloads it to a local path
+func (rs *DirectLocalStorage) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	return rs.download(ctx, destination, rs.bucketName(), rs.objectName(name), mappings)
+}
+
+// DownloadSnapshot downloads a snapshot. The snapshot name is expected to be one produced by Qualify
+func (rs *DirectLocalStorage) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	bkt, obj, err := ParseSnapshotName(name)
+	if err != nil {
+		return false, err
+	}
+
+	return rs.download(ctx, destination, bkt, obj, mappings)
+}
+
+// DownloadObject streams the raw content of an object. The name is either a backup name or one produced by Qualify
+func (rs *DirectLocalStorage) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	bkt, obj := rs.bucketName(), rs.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		bkt, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f, _, err := rs.store.open(bkt, obj)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ObjectAttributes returns the content type and annotations of an object
+func (rs *DirectLocalStorage) ObjectAttributes(ctx context.Context, name string) (*UploadOptions, error) {
+	bkt, obj := rs.bucketName(), rs.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		bkt, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f, meta, err := rs.store.open(bkt, obj)
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	return &UploadOptions{ContentType: meta.ContentType, Annotations: meta.Annotations}, nil
+}
+
+// ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
+func (rs *DirectLocalStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
+	err = rs.store.list(rs.bucketName(), prefix, func(obj string, info fs.FileInfo) error {
+		objects = append(objects, obj)
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+	return objects, nil
+}
+
+// Qualify fully qualifies a snapshot name so that it can be downloaded using DownloadSnapshot
+func (rs *DirectLocalStorage) Qualify(name string) string {
+	return fmt.Sprintf("%s@%s", rs.objectName(name), rs.bucketName())
+}
+
+// UploadInstance takes all files from a local location and uploads it to the per-instance remote storage
+func (rs *DirectLocalStorage) UploadInstance(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, object string, err error) {
+	if rs.InstanceID == "" {
+		return "", "", xerrors.Errorf("instanceID is required to comput object name")
+	}
+	return rs.Upload(ctx, source, InstanceObjectName(rs.InstanceID, name), opts...)
+}
+
+// Upload takes all files from a local location and uploads it to the remote storage
+func (rs *DirectLocalStorage) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		err = xerrors.Errorf("cannot get options: %w", err)
+		return
+	}
+
+	bucket = rs.bucketName()
+	obj = rs.objectName(name)
+	span.LogKV("bucket", bucket)
+	span.LogKV("obj", obj)
+
+	f, err := os.Open(source)
+	if err != nil {
+		err = xerrors.Errorf("cannot read upload source: %w", err)
+		return
+	}
+	defer f.Close()
+
+	err = rs.store.put(bucket, obj, f, localObjectMeta{
+		ContentType: options.ContentType,
+		Annotations: options.Annotations,
+	})
+	if err != nil {
+		err = xerrors.Errorf("cannot store object: %w", err)
+		return
+	}
+
+	return
+}
+
+// NewMultipartUpload starts the upload of an object in parts
+func (rs *DirectLocalStorage) NewMultipartUpload(ctx context.Context, name string, opts ...UploadOption) (MultipartUpload, error) {
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get options: %w", err)
+	}
+
+	bkt, obj := rs.bucketName(), rs.objectName(name)
+	_, err = rs.store.objectPath(bkt, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Join(rs.store.Root, localUploadDir), 0755)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp(filepath.Join(rs.store.Root, localUploadDir), "upload-")
+	if err != nil {
+		return nil, err
+	}
+
+	return &localMultipartUpload{
+		store:  rs.store,
+		bucket: bkt,
+		object: obj,
+		dir:    dir,
+		meta: localObjectMeta{
+			ContentType: options.ContentType,
+			Annotations: options.Annotations,
+		},
+	}, nil
+}
+
+// localMultipartUpload stages parts in a directory and concatenates them on completion
+type localMultipartUpload struct {
+	store  localStore
+	bucket string
+	object string
+	dir    string
+	meta   localObjectMeta
+}
+
+func (u *localMultipartUpload) partFile(num int) string {
+	return filepath.Join(u.dir, strconv.Itoa(num))
+}
+
+// UploadPart stores a part and verifies its checksum
+func (u *localMultipartUpload) UploadPart(ctx context.Context, part *UploadedPart, data io.ReadSeeker) error {
+	h := sha256.New()
+	err := writeFileAtomic(u.partFile(part.Number), func(w io.Writer) error {
+		_, err := io.Copy(io.MultiWriter(w, h), data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	sum := hex.EncodeToString(h.Sum(nil))
+	if sum != part.Checksum {
+		return xerrors.Errorf("checksum mismatch for part %d: expected %s, got %s", part.Number, part.Checksum, sum)
+	}
+	part.ETag = sum
+	return nil
+}
+
+// Complete concatenates all parts into the object
+func (u *localMultipartUpload) Complete(ctx context.Context, parts []UploadedPart) (size int64, err error) {
+	files := make([]io.Reader, 0, len(parts))
+	for _, p := range parts {
+		f, err := os.Open(u.partFile(p.Number))
+		if err != nil {
+			return 0, xerrors.Errorf("cannot open part %d: %w", p.Number, err)
+		}
+		defer f.Close()
+		files = append(files, f)
+	}
+
+	err = u.store.put(u.bucket, u.object, io.MultiReader(files...), u.meta)
+	if err != nil {
+		return 0, err
+	}
+
+	fn, _ := u.store.objectPath(u.bucket, u.object)
+	stat, err := os.Stat(fn)
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.RemoveAll(u.dir)
+	if err != nil {
+		log.WithError(err).WithField("dir", u.dir).Warn("cannot remove upload parts")
+	}
+	return stat.Size(), nil
+}
+
+// Abort removes all parts uploaded so far
+func (u *localMultipartUpload) Abort(ctx context.Context) error {
+	return os.RemoveAll(u.dir)
+}
+
+// Bucket provides the bucket name for a particular user
+func (rs *DirectLocalStorage) Bucket(ownerID string) string {
+	return localBucketName(ownerID)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (rs *DirectLocalStorage) BackupObject(name string) string {
+	return rs.objectName(name)
+}
+
+func (rs *DirectLocalStorage) bucketName() string {
+	return localBucketName(rs.Username)
+}
+
+func (rs *DirectLocalStorage) objectName(name string) string {
+	return localWorkspaceBackupObjectName(rs.WorkspaceName, name)
+}
+
+func newPresignedLocalAccess(cfg config.LocalConfig) (*presignedLocalStorage, error) {
+	signer, err := newLocalURLSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &presignedLocalStorage{
+		store:  localStore{Root: cfg.Path},
+		signer: signer,
+	}, nil
+}
+
+type presignedLocalStorage struct {
+	store  localStore
+	signer *localURLSigner
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (s *presignedLocalStorage) EnsureExists(ctx context.Context, bucket string) (err error) {
+	return localEnsureExists(ctx, s.store, bucket)
+}
+
+func (s *presignedLocalStorage) DiskUsage(ctx context.Context, bucket string, prefix string) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.DiskUsage")
+	defer tracing.FinishSpan(span, &err)
+
+	err = s.store.list(bucket, prefix, func(obj string, info fs.FileInfo) error {
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+func (s *presignedLocalStorage) SignDownload(ctx context.Context, bucket, object string, options *SignedURLOptions) (info *DownloadInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.SignDownload")
+	defer func() {
+		if err == ErrNotFound {
+			span.LogKV("found", false)
+			tracing.FinishSpan(span, nil)
+			return
+		}
+
+		tracing.FinishSpan(span, &err)
+	}()
+
+	f, meta, err := s.store.open(bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DownloadInfo{
+		Meta: ObjectMeta{
+			ContentType:        meta.ContentType,
+			OCIMediaType:       meta.Annotations[ObjectAnnotationOCIContentType],
+			Digest:             meta.Annotations[ObjectAnnotationDigest],
+			UncompressedDigest: meta.Annotations[ObjectAnnotationUncompressedDigest],
+
+			EncryptionAlgorithm: meta.Annotations[ObjectAnnotationEncryptionAlgorithm],
+			EncryptionKeyID:     meta.Annotations[ObjectAnnotationEncryptionKeyID],
+		},
+		Size: stat.Size(),
+		URL:  s.signer.Sign(http.MethodGet, bucket, object, ""),
+	}, nil
+}
+
+// SignUpload describes an object for upload
+func (s *presignedLocalStorage) SignUpload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *UploadInfo, err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.SignUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	_, err = s.store.objectPath(bucket, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var contentType string
+	if options != nil {
+		contentType = options.ContentType
+	}
+	return &UploadInfo{URL: s.signer.Sign(http.MethodPut, bucket, obj, contentType)}, nil
+}
+
+func (s *presignedLocalStorage) DeleteObject(ctx context.Context, bucket string, query *DeleteObjectQuery) (err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.DeleteObject")
+	defer tracing.FinishSpan(span, &err)
+
+	if query.Name != "" {
+		err = s.store.remove(bucket, query.Name)
+		if err != nil {
+			log.WithField("bucket", bucket).WithField("object", query.Name).Error(err)
+			return err
+		}
+		return nil
+	}
+	if query.Prefix != "" {
+		var objs []string
+		err = s.store.list(bucket, strings.TrimPrefix(query.Prefix, "/"), func(obj string, info fs.FileInfo) error {
+			objs = append(objs, obj)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			rerr := s.store.remove(bucket, obj)
+			if rerr != nil {
+				err = rerr
+				log.WithField("bucket", bucket).WithField("object", obj).Error(err)
+			}
+		}
+	}
+	return err
+}
+
+// DeleteBucket deletes a bucket
+func (s *presignedLocalStorage) DeleteBucket(ctx context.Context, userID, bucket string) (err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.DeleteBucket")
+	defer tracing.FinishSpan(span, &err)
+
+	bp, err := s.store.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+	err = os.RemoveAll(filepath.Join(s.store.Root, localMetaDir, bucket))
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(bp)
+}
+
+// ObjectHash gets a hash value of an object
+func (s *presignedLocalStorage) ObjectHash(ctx context.Context, bucket string, obj string) (hash string, err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.ObjectHash")
+	defer tracing.FinishSpan(span, &err)
+
+	f, _, err := s.store.open(bucket, obj)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (s *presignedLocalStorage) ObjectExists(ctx context.Context, bucket, obj string) (exists bool, err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.ObjectExists")
+	defer tracing.FinishSpan(span, &err)
+
+	fn, err := s.store.objectPath(bucket, obj)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Bucket provides the bucket name for a particular user
+func (s *presignedLocalStorage) Bucket(ownerID string) string {
+	return localBucketName(ownerID)
+}
+
+// BlobObject returns a blob's object name
+func (s *presignedLocalStorage) BlobObject(userID, name string) (string, error) {
+	return blobObjectName(name)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (s *presignedLocalStorage) BackupObject(ownerID string, workspaceID, name string) string {
+	return localWorkspaceBackupObjectName(workspaceID, name)
+}
+
+// InstanceObject returns a instance's object name that a direct downloader would download
+func (s *presignedLocalStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
+	return s.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
+}
+
+// localURLSigner produces and verifies the HMAC-signed URLs served by the local storage handler
+type localURLSigner struct {
+	key     []byte
+	baseURL string
+	expiry  time.Duration
+
+	now func() time.Time
+}
+
+func newLocalURLSigner(cfg config.LocalConfig) (*localURLSigner, error) {
+	err := addLocalParamsFromMounts(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	err = validateLocalSigningConfig(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := time.Duration(cfg.URLExpiry)
+	if expiry == 0 {
+		expiry = localDefaultURLExpiry
+	}
+	return &localURLSigner{
+		key:     []byte(cfg.SigningKey),
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		expiry:  expiry,
+		now:     time.Now,
+	}, nil
+}
+
+// Sign produces a URL which grants method access to an object until the signer's expiry elapsed.
+// If contentType is not empty, uploads must provide this content type.
+func (s *localURLSigner) Sign(method, bkt, obj, contentType string) string {
+	expires := strconv.FormatInt(s.now().Add(s.expiry).Unix(), 10)
+
+	q := url.Values{}
+	q.Set(localParamExpires, expires)
+	if contentType != "" {
+		q.Set(localParamContentType, contentType)
+	}
+	q.Set(localParamSignature, s.signature(method, bkt, obj, expires, contentType))
+
+	segs := strings.Split(obj, "/")
+	for i := range segs {
+		segs[i] = url.PathEscape(segs[i])
+	}
+	return fmt.Sprintf("%s/%s/%s?%s", s.baseURL, url.PathEscape(bkt), strings.Join(segs, "/"), q.Encode())
+}
+
+// Verify checks that a signature grants method access to an object
+func (s *localURLSigner) Verify(method, bkt, obj string, q url.Values) error {
+	expires := q.Get(localParamExpires)
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return xerrors.Errorf("invalid expiry")
+	}
+	if s.now().After(time.Unix(exp, 0)) {
+		return xerrors.Errorf("URL has expired")
+	}
+
+	sig, err := hex.DecodeString(q.Get(localParamSignature))
+	if err != nil {
+		return xerrors.Errorf("invalid signature")
+	}
+	expected, _ := hex.DecodeString(s.signature(method, bkt, obj, expires, q.Get(localParamContentType)))
+	if !hmac.Equal(sig, expected) {
+		return xerrors.Errorf("invalid signature")
+	}
+	return nil
+}
+
+func (s *localURLSigner) signature(method, bkt, obj, expires, contentType string) string {
+	mac := hmac.New(sha256.New, s.key)
+	_, _ = mac.Write([]byte(strings.Join([]string{method, bkt, obj, expires, contentType}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewLocalStorageHandler produces an HTTP handler which serves the signed URLs produced by the local
+// presigned storage. The handler expects request paths of the form /<bucket>/<object>, i.e. it must
+// be served under the configured BaseURL with that prefix stripped.
+func NewLocalStorageHandler(cfg config.LocalConfig) (http.Handler, error) {
+	signer, err := newLocalURLSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &localStorageHandler{
+		store:  localStore{Root: cfg.Path},
+		signer: signer,
+	}, nil
+}
+
+type localStorageHandler struct {
+	store  localStore
+	signer *localURLSigner
+}
+
+func (h *localStorageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bkt, obj, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	err := h.signer.Verify(method, bkt, obj, r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		h.serveObject(w, r, bkt, obj)
+	case http.MethodPut:
+		h.storeObject(w, r, bkt, obj)
+	}
+}
+
+func (h *localStorageHandler) serveObject(w http.ResponseWriter, r *http.Request, bkt, obj string) {
+	f, meta, err := h.store.open(bkt, obj)
+	if err == ErrNotFound {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("bucket", bkt).WithField("object", obj).Error("cannot open object")
+		http.Error(w, "cannot open object", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		http.Error(w, "cannot open object", http.StatusInternalServerError)
+		return
+	}
+	if meta.ContentType != "" {
+		w.Header().Set("Content-Type", meta.ContentType)
+	}
+	http.ServeContent(w, r, "", stat.ModTime(), f)
+}
+
+func (h *localStorageHandler) storeObject(w http.ResponseWriter, r *http.Request, bkt, obj string) {
+	contentType := r.Header.Get("Content-Type")
+	if expected := r.URL.Query().Get(localParamContentType); expected != "" && contentType != expected {
+		http.Error(w, "content type does not match signed URL", http.StatusForbidden)
+		return
+	}
+
+	err := h.store.put(bkt, obj, r.Body, localObjectMeta{ContentType: contentType})
+	if err != nil {
+		log.WithError(err).WithField("bucket", bkt).WithField("object", obj).Error("cannot store object")
+		http.Error(w, "cannot store object", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
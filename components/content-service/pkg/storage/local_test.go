@@ -0,0 +1,220 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+func TestLocalObjectPath(t *testing.T) {
+	tests := []struct {
+		Bucket  string
+		Object  string
+		Invalid bool
+	}{
+		{Bucket: "gitpod-user-foo", Object: "workspaces/ws/full.tar"},
+		{Bucket: "gitpod-user-foo", Object: "../gitpod-user-bar/full.tar", Invalid: true},
+		{Bucket: "gitpod-user-foo", Object: "workspaces/../../etc/passwd", Invalid: true},
+		{Bucket: "gitpod-user-foo", Object: "/etc/passwd", Invalid: true},
+		{Bucket: "gitpod-user-foo", Object: "", Invalid: true},
+		{Bucket: "..", Object: "full.tar", Invalid: true},
+		{Bucket: ".meta", Object: "full.tar", Invalid: true},
+		{Bucket: "a/b", Object: "full.tar", Invalid: true},
+	}
+
+	store := localStore{Root: "/storage"}
+	for _, test := range tests {
+		t.Run(test.Bucket+"/"+test.Object, func(t *testing.T) {
+			p, err := store.objectPath(test.Bucket, test.Object)
+			if test.Invalid {
+				if err == nil {
+					t.Errorf("expected error, got path %s", p)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(p, filepath.Join(store.Root, test.Bucket)+"/") {
+				t.Errorf("path %s escapes its bucket", p)
+			}
+		})
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.LocalConfig{
+		Path:       t.TempDir(),
+		SigningKey: "secret",
+	}
+
+	handler, err := NewLocalStorageHandler(config.LocalConfig{Path: cfg.Path, SigningKey: cfg.SigningKey, BaseURL: "http://unused"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	cfg.BaseURL = srv.URL
+
+	rs, err := newDirectLocalAccess(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.Init(ctx, "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.EnsureExists(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := newPresignedLocalAccess(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := []byte("hello world")
+	src := filepath.Join(t.TempDir(), "src")
+	err = os.WriteFile(src, content, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bkt, obj, err := rs.Upload(ctx, src, "foo.txt", WithContentType("text/plain"), WithAnnotations(map[string]string{ObjectAnnotationDigest: "sha256:abc"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bkt != ps.Bucket("owner") || obj != ps.BackupObject("owner", "workspace", "foo.txt") {
+		t.Errorf("unexpected object location %s/%s", bkt, obj)
+	}
+
+	objs, err := rs.ListObjects(ctx, "workspaces/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{obj}, objs); diff != "" {
+		t.Errorf("unexpected objects (-want +got):\n%s", diff)
+	}
+
+	info, err := ps.SignDownload(ctx, bkt, obj, &SignedURLOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != int64(len(content)) || info.Meta.ContentType != "text/plain" || info.Meta.Digest != "sha256:abc" {
+		t.Errorf("unexpected download info: %+v", info)
+	}
+	resp, err := http.Get(info.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !bytes.Equal(body, content) {
+		t.Errorf("unexpected download: %d %q", resp.StatusCode, body)
+	}
+
+	resp, err = http.Get(strings.Replace(info.URL, "foo.txt", "bar.txt", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("tampered URL: expected status %d, got %d", http.StatusForbidden, resp.StatusCode)
+	}
+
+	_, err = ps.SignDownload(ctx, bkt, "does/not/exist", &SignedURLOptions{})
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	up, err := ps.SignUpload(ctx, bkt, "blobs/plugin", &SignedURLOptions{ContentType: "application/zip"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		ContentType    string
+		ExpectedStatus int
+	}{
+		{ContentType: "text/plain", ExpectedStatus: http.StatusForbidden},
+		{ContentType: "application/zip", ExpectedStatus: http.StatusOK},
+	} {
+		req, _ := http.NewRequest(http.MethodPut, up.URL, bytes.NewReader(content))
+		req.Header.Set("Content-Type", test.ContentType)
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.ExpectedStatus {
+			t.Errorf("upload with %s: expected status %d, got %d", test.ContentType, test.ExpectedStatus, resp.StatusCode)
+		}
+	}
+	exists, err := ps.ObjectExists(ctx, bkt, "blobs/plugin")
+	if err != nil || !exists {
+		t.Errorf("uploaded object does not exist: %v", err)
+	}
+
+	usage, err := ps.DiskUsage(ctx, bkt, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage != 2*int64(len(content)) {
+		t.Errorf("unexpected disk usage: %d", usage)
+	}
+
+	err = ps.DeleteObject(ctx, bkt, &DeleteObjectQuery{Prefix: "workspaces/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc, err := rs.DownloadObject(ctx, "foo.txt")
+	if err != ErrNotFound {
+		if rc != nil {
+			rc.Close()
+		}
+		t.Errorf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestLocalURLSignerExpiry(t *testing.T) {
+	signer, err := newLocalURLSigner(config.LocalConfig{Path: "/storage", BaseURL: "http://localhost", SigningKey: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	signer.now = func() time.Time { return now }
+	u, err := http.NewRequest(http.MethodGet, signer.Sign(http.MethodGet, "bkt", "obj", ""), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = signer.Verify(http.MethodGet, "bkt", "obj", u.URL.Query())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err = signer.Verify(http.MethodPut, "bkt", "obj", u.URL.Query())
+	if err == nil {
+		t.Errorf("signature for GET must not grant PUT")
+	}
+
+	signer.now = func() time.Time { return now.Add(localDefaultURLExpiry + time.Minute) }
+	err = signer.Verify(http.MethodGet, "bkt", "obj", u.URL.Query())
+	if err == nil {
+		t.Errorf("expected expired URL to be rejected")
+	}
+}
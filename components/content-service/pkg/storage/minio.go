@@ -27,6 +27,7 @@ import (
 )
 
 var _ DirectAccess = &DirectMinIOStorage{}
+var _ ObjectAttributesReader = &DirectMinIOStorage{}
 
 // Validate checks if the GCloud storage MinIOconfig is valid
 func ValidateMinIOConfig(c *config.MinIOConfig) error {
@@ -234,6 +235,42 @@ func (rs *DirectMinIOStorage) DownloadSnapshot(ctx context.Context, destination
 	return rs.download(ctx, destination, bkt, obj, mappings)
 }
 
+// DownloadObject streams the raw content of an object. The name is either a backup name or one produced by Qualify
+func (rs *DirectMinIOStorage) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	bkt, obj := rs.bucketName(), rs.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		bkt, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rc, err := rs.ObjectAccess(ctx, bkt, obj)
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+	return rc, nil
+}
+
+// ObjectAttributes returns the content type and annotations of an object
+func (rs *DirectMinIOStorage) ObjectAttributes(ctx context.Context, name string) (*UploadOptions, error) {
+	bkt, obj := rs.bucketName(), rs.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		bkt, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stat, err := rs.client.StatObject(ctx, bkt, obj, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+	return &UploadOptions{ContentType: stat.ContentType, Annotations: stat.UserMetadata}, nil
+}
+
 // ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
 func (rs *DirectMinIOStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -312,6 +349,80 @@ func (rs *DirectMinIOStorage) Upload(ctx context.Context, source string, name st
 	return
 }
 
+// NewMultipartUpload starts the upload of an object in parts
+func (rs *DirectMinIOStorage) NewMultipartUpload(ctx context.Context, name string, opts ...UploadOption) (MultipartUpload, error) {
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get options: %w", err)
+	}
+	if rs.client == nil {
+		return nil, xerrors.Errorf("no minio client available - did you call Init()?")
+	}
+
+	var (
+		core    = minio.Core{Client: rs.client}
+		bucket  = rs.bucketName()
+		obj     = rs.objectName(name)
+		putOpts = minio.PutObjectOptions{
+			UserMetadata: options.Annotations,
+			ContentType:  options.ContentType,
+		}
+	)
+	id, err := core.NewMultipartUpload(ctx, bucket, obj, putOpts)
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+
+	return &minioMultipartUpload{
+		core:     core,
+		bucket:   bucket,
+		object:   obj,
+		uploadID: id,
+		opts:     putOpts,
+	}, nil
+}
+
+type minioMultipartUpload struct {
+	core     minio.Core
+	bucket   string
+	object   string
+	uploadID string
+	opts     minio.PutObjectOptions
+}
+
+// UploadPart uploads a part. The storage verifies the part's checksum.
+func (u *minioMultipartUpload) UploadPart(ctx context.Context, part *UploadedPart, data io.ReadSeeker) error {
+	res, err := u.core.PutObjectPart(ctx, u.bucket, u.object, u.uploadID, part.Number, data, part.Size, "", part.Checksum, nil)
+	if err != nil {
+		return translateMinioError(err)
+	}
+	part.ETag = res.ETag
+	return nil
+}
+
+// Complete assembles the object from its parts
+func (u *minioMultipartUpload) Complete(ctx context.Context, parts []UploadedPart) (size int64, err error) {
+	cps := make([]minio.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		cps = append(cps, minio.CompletePart{PartNumber: p.Number, ETag: p.ETag})
+	}
+	_, err = u.core.CompleteMultipartUpload(ctx, u.bucket, u.object, u.uploadID, cps, u.opts)
+	if err != nil {
+		return 0, translateMinioError(err)
+	}
+
+	stat, err := u.core.StatObject(ctx, u.bucket, u.object, minio.StatObjectOptions{})
+	if err != nil {
+		return 0, translateMinioError(err)
+	}
+	return stat.Size, nil
+}
+
+// Abort removes all parts uploaded so far
+func (u *minioMultipartUpload) Abort(ctx context.Context) error {
+	return translateMinioError(u.core.AbortMultipartUpload(ctx, u.bucket, u.object, u.uploadID))
+}
+
 func minioBucketName(ownerID, bucketName string) string {
 	if bucketName != "" {
 		return bucketName
@@ -418,6 +529,9 @@ func (s *presignedMinIOStorage) SignDownload(ctx context.Context, bucket, object
 			OCIMediaType:       stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationOCIContentType)),
 			Digest:             stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationDigest)),
 			UncompressedDigest: stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationUncompressedDigest)),
+
+			EncryptionAlgorithm: stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationEncryptionAlgorithm)),
+			EncryptionKeyID:     stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationEncryptionKeyID)),
 		},
 		Size: stat.Size,
 		URL:  url.String(),
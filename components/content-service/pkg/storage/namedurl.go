@@ -6,6 +6,7 @@ package storage
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"golang.org/x/xerrors"
@@ -54,3 +55,13 @@ func (d *NamedURLDownloader) Download(ctx context.Context, destination string, n
 func (d *NamedURLDownloader) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
 	return d.Download(ctx, destination, name, mappings)
 }
+
+// DownloadObject streams the raw content of a named URL
+func (d *NamedURLDownloader) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	url, found := d.URLs[name]
+	if !found {
+		return nil, ErrNotFound
+	}
+
+	return downloadURL(ctx, url)
+}
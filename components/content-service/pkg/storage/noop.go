@@ -6,6 +6,7 @@ package storage
 
 import (
 	"context"
+	"io"
 
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
 )
@@ -35,6 +36,11 @@ func (rs *DirectNoopStorage) DownloadSnapshot(ctx context.Context, destination s
 	return false, nil
 }
 
+// DownloadObject always returns ErrNotFound
+func (rs *DirectNoopStorage) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	return nil, ErrNotFound
+}
+
 // ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
 func (rs *DirectNoopStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
 	return nil, nil
@@ -55,6 +61,31 @@ func (rs *DirectNoopStorage) Upload(ctx context.Context, source string, name str
 	return "", "", nil
 }
 
+// NewMultipartUpload returns an upload which does nothing
+func (rs *DirectNoopStorage) NewMultipartUpload(ctx context.Context, name string, opts ...UploadOption) (MultipartUpload, error) {
+	return noopMultipartUpload{}, nil
+}
+
+type noopMultipartUpload struct{}
+
+// UploadPart does nothing
+func (noopMultipartUpload) UploadPart(ctx context.Context, part *UploadedPart, data io.ReadSeeker) error {
+	return nil
+}
+
+// Complete does nothing and returns the size of all parts
+func (noopMultipartUpload) Complete(ctx context.Context, parts []UploadedPart) (size int64, err error) {
+	for _, p := range parts {
+		size += p.Size
+	}
+	return size, nil
+}
+
+// Abort does nothing
+func (noopMultipartUpload) Abort(ctx context.Context) error {
+	return nil
+}
+
 // Bucket returns an empty string
 func (rs *DirectNoopStorage) Bucket(string) string {
 	return ""
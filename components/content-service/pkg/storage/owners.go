@@ -0,0 +1,158 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/minio/minio-go/v7"
+	"golang.org/x/xerrors"
+	"google.golang.org/api/iterator"
+)
+
+// QuarantinePrefix is the name prefix (relative to the workspace) under which objects that failed verification are moved
+const QuarantinePrefix = "quarantine/"
+
+// ownerLister is implemented by storage backends which can enumerate the owners that have content stored
+type ownerLister interface {
+	listOwners(ctx context.Context) ([]string, error)
+}
+
+// ListOwners returns the IDs of all owners which have content stored in the remote storage, in lexical order.
+// rs does not have to be initialized.
+func ListOwners(ctx context.Context, rs DirectAccess) ([]string, error) {
+	if enc, ok := rs.(*EncryptedDirectAccess); ok {
+		rs = enc.DirectAccess
+	}
+	lister, ok := rs.(ownerLister)
+	if !ok {
+		return nil, xerrors.Errorf("storage backend %T cannot list owners", rs)
+	}
+
+	owners, err := lister.listOwners(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list owners: %w", err)
+	}
+	sort.Strings(owners)
+	return owners, nil
+}
+
+// OwnerLocation computes the bucket and object name prefix under which an initialized rs stores the workspaces of its owner
+func OwnerLocation(rs DirectAccess) (bkt, prefix string) {
+	bkt, prefix = workspaceLocation(rs)
+	prefix = strings.TrimSuffix(prefix, "/")
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		return bkt, prefix[:i+1]
+	}
+	return bkt, ""
+}
+
+func (rs *DirectGCPStorage) listOwners(ctx context.Context) ([]string, error) {
+	client, err := newGCPClient(ctx, rs.GCPConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	namePrefix := gcpBucketName(rs.Stage, "")
+	it := client.Buckets(ctx, rs.GCPConfig.Project)
+	it.Prefix = namePrefix
+
+	var res []string
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, strings.TrimPrefix(attrs.Name, namePrefix))
+	}
+	return res, nil
+}
+
+func (rs *DirectMinIOStorage) listOwners(ctx context.Context) ([]string, error) {
+	client, err := NewMinIOClient(&rs.MinIOConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	if rs.MinIOConfig.BucketName != "" {
+		// all owners share one bucket and are distinguished by the first path segment
+		for obj := range client.ListObjects(ctx, rs.MinIOConfig.BucketName, minio.ListObjectsOptions{}) {
+			if obj.Err != nil {
+				return nil, translateMinioError(obj.Err)
+			}
+			if owner, ok := strings.CutSuffix(obj.Key, "/"); ok {
+				res = append(res, owner)
+			}
+		}
+		return res, nil
+	}
+
+	namePrefix := minioBucketName("", "")
+	buckets, err := client.ListBuckets(ctx)
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+	for _, b := range buckets {
+		if owner, ok := strings.CutPrefix(b.Name, namePrefix); ok {
+			res = append(res, owner)
+		}
+	}
+	return res, nil
+}
+
+func (s3st *s3Storage) listOwners(ctx context.Context) ([]string, error) {
+	var res []string
+	listParams := &s3.ListObjectsV2Input{
+		Bucket:    aws.String(s3st.Config.Bucket),
+		Delimiter: aws.String("/"),
+	}
+	fetchObjects := true
+	for fetchObjects {
+		objs, err := s3st.client.ListObjectsV2(ctx, listParams)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range objs.CommonPrefixes {
+			res = append(res, strings.TrimSuffix(*p.Prefix, "/"))
+		}
+
+		listParams.ContinuationToken = objs.NextContinuationToken
+		fetchObjects = objs.IsTruncated
+	}
+	return res, nil
+}
+
+func (rs *DirectLocalStorage) listOwners(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(rs.LocalConfig.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	namePrefix := localBucketName("")
+	var res []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if owner, ok := strings.CutPrefix(e.Name(), namePrefix); ok {
+			res = append(res, owner)
+		}
+	}
+	return res, nil
+}
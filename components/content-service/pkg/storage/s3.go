@@ -6,8 +6,11 @@ package storage
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -30,6 +33,7 @@ const (
 )
 
 var _ DirectAccess = &s3Storage{}
+var _ ObjectAttributesReader = &s3Storage{}
 var _ PresignedAccess = &PresignedS3Storage{}
 
 type S3Config struct {
@@ -41,6 +45,11 @@ type S3Client interface {
 	DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
 	GetObjectAttributes(ctx context.Context, params *s3.GetObjectAttributesInput, optFns ...func(*s3.Options)) (*s3.GetObjectAttributesOutput, error)
 	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
 }
 
 type PresignedS3Client interface {
@@ -214,17 +223,13 @@ func (rs *PresignedS3Storage) ObjectHash(ctx context.Context, bucket string, obj
 
 // SignDownload implements PresignedAccess
 func (rs *PresignedS3Storage) SignDownload(ctx context.Context, bucket string, obj string, options *SignedURLOptions) (info *DownloadInfo, err error) {
-	resp, err := rs.client.GetObjectAttributes(ctx, &s3.GetObjectAttributesInput{
-		Bucket:           &rs.Config.Bucket,
-		Key:              aws.String(obj),
-		ObjectAttributes: []types.ObjectAttributes{types.ObjectAttributesObjectSize},
+	resp, err := rs.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: &rs.Config.Bucket,
+		Key:    aws.String(obj),
 	})
-
-	var nsk *types.NoSuchKey
-	if errors.As(err, &nsk) {
+	if isS3NotFound(err) {
 		return nil, ErrNotFound
 	}
-
 	if err != nil {
 		return nil, err
 	}
@@ -239,13 +244,42 @@ func (rs *PresignedS3Storage) SignDownload(ctx context.Context, bucket string, o
 
 	return &DownloadInfo{
 		Meta: ObjectMeta{
-			// TODO(cw): implement this if we need to support FWB with S3
+			ContentType:        aws.ToString(resp.ContentType),
+			OCIMediaType:       s3Annotation(resp.Metadata, ObjectAnnotationOCIContentType),
+			Digest:             s3Annotation(resp.Metadata, ObjectAnnotationDigest),
+			UncompressedDigest: s3Annotation(resp.Metadata, ObjectAnnotationUncompressedDigest),
+
+			EncryptionAlgorithm: s3Annotation(resp.Metadata, ObjectAnnotationEncryptionAlgorithm),
+			EncryptionKeyID:     s3Annotation(resp.Metadata, ObjectAnnotationEncryptionKeyID),
 		},
-		Size: resp.ObjectSize,
+		Size: resp.ContentLength,
 		URL:  req.URL,
 	}, nil
 }
 
+// isS3NotFound returns true if err signals that an object does not exist. HEAD requests have no body,
+// hence S3 reports a generic NotFound rather than NoSuchKey for them.
+func isS3NotFound(err error) bool {
+	var (
+		nsk *types.NoSuchKey
+		nf  *types.NotFound
+	)
+	return errors.As(err, &nsk) || errors.As(err, &nf)
+}
+
+// s3Annotation reads an annotation from the user metadata of an object. S3 lower-cases metadata keys.
+func s3Annotation(md map[string]string, key string) string {
+	if v, ok := md[key]; ok {
+		return v
+	}
+	for k, v := range md {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
 // SignUpload implements PresignedAccess
 func (rs *PresignedS3Storage) SignUpload(ctx context.Context, bucket string, obj string, options *SignedURLOptions) (info *UploadInfo, err error) {
 	resp, err := rs.PresignedFactory().PresignPutObject(ctx, &s3.PutObjectInput{
@@ -330,6 +364,55 @@ func (s3st *s3Storage) download(ctx context.Context, destination string, obj str
 	return true, nil
 }
 
+// DownloadObject implements DirectAccess
+func (s3st *s3Storage) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	obj := s3st.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		_, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s3st.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s3st.Config.Bucket),
+		Key:    aws.String(obj),
+	})
+	var nsk *types.NoSuchKey
+	if errors.As(err, &nsk) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// ObjectAttributes implements ObjectAttributesReader
+func (s3st *s3Storage) ObjectAttributes(ctx context.Context, name string) (*UploadOptions, error) {
+	obj := s3st.objectName(name)
+	if strings.Contains(name, "@") {
+		var err error
+		_, obj, err = ParseSnapshotName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s3st.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s3st.Config.Bucket),
+		Key:    aws.String(obj),
+	})
+	if isS3NotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &UploadOptions{ContentType: aws.ToString(resp.ContentType), Annotations: resp.Metadata}, nil
+}
+
 // EnsureExists implements DirectAccess
 func (*s3Storage) EnsureExists(ctx context.Context) error {
 	return nil
@@ -448,3 +531,122 @@ func (s3st *s3Storage) UploadInstance(ctx context.Context, source string, name s
 	}
 	return s3st.Upload(ctx, source, InstanceObjectName(s3st.InstanceID, name), opts...)
 }
+
+// NewMultipartUpload implements DirectAccess
+func (s3st *s3Storage) NewMultipartUpload(ctx context.Context, name string, opts ...UploadOption) (MultipartUpload, error) {
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get options: %w", err)
+	}
+	if s3st.client == nil {
+		return nil, xerrors.Errorf("no s3 client available - did you call Init()?")
+	}
+
+	var contentType *string
+	if options.ContentType != "" {
+		contentType = aws.String(options.ContentType)
+	}
+
+	bucket := s3st.Config.Bucket
+	obj := s3st.objectName(name)
+	resp, err := s3st.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:            aws.String(bucket),
+		Key:               aws.String(obj),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		Metadata:          options.Annotations,
+		ContentType:       contentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3MultipartUpload{
+		client:   s3st.client,
+		bucket:   bucket,
+		object:   obj,
+		uploadID: resp.UploadId,
+	}, nil
+}
+
+type s3MultipartUpload struct {
+	client   S3Client
+	bucket   string
+	object   string
+	uploadID *string
+}
+
+// UploadPart implements MultipartUpload. S3 verifies the part's checksum.
+func (u *s3MultipartUpload) UploadPart(ctx context.Context, part *UploadedPart, data io.ReadSeeker) error {
+	checksum, err := hexToBase64(part.Checksum)
+	if err != nil {
+		return err
+	}
+
+	resp, err := u.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:         aws.String(u.bucket),
+		Key:            aws.String(u.object),
+		UploadId:       u.uploadID,
+		PartNumber:     int32(part.Number),
+		Body:           data,
+		ContentLength:  part.Size,
+		ChecksumSHA256: aws.String(checksum),
+	})
+	if err != nil {
+		return err
+	}
+	part.ETag = aws.ToString(resp.ETag)
+	return nil
+}
+
+// Complete implements MultipartUpload
+func (u *s3MultipartUpload) Complete(ctx context.Context, parts []UploadedPart) (size int64, err error) {
+	completed := make([]types.CompletedPart, 0, len(parts))
+	for _, p := range parts {
+		checksum, err := hexToBase64(p.Checksum)
+		if err != nil {
+			return 0, err
+		}
+		completed = append(completed, types.CompletedPart{
+			ETag:           aws.String(p.ETag),
+			PartNumber:     int32(p.Number),
+			ChecksumSHA256: aws.String(checksum),
+		})
+	}
+
+	_, err = u.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.bucket),
+		Key:             aws.String(u.object),
+		UploadId:        u.uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	head, err := u.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(u.bucket),
+		Key:    aws.String(u.object),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return head.ContentLength, nil
+}
+
+// Abort implements MultipartUpload
+func (u *s3MultipartUpload) Abort(ctx context.Context) error {
+	_, err := u.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(u.bucket),
+		Key:      aws.String(u.object),
+		UploadId: u.uploadID,
+	})
+	return err
+}
+
+func hexToBase64(h string) (string, error) {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return "", xerrors.Errorf("invalid checksum %s: %w", h, err)
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
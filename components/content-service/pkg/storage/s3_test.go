@@ -38,6 +38,15 @@ func TestS3PresignedHappyPath(t *testing.T) {
 		ETag:       aws.String("foobar"),
 		ObjectSize: 100,
 	}, nil).AnyTimes()
+	s3c.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(&s3.HeadObjectOutput{
+		ETag:          aws.String("foobar"),
+		ContentLength: 100,
+		ContentType:   aws.String("application/tar"),
+		Metadata: map[string]string{
+			"gitpod-encryption-algorithm": storage.EncryptionAlgorithmAESGCMStream,
+			"gitpod-encryption-keyid":     "k1",
+		},
+	}, nil).AnyTimes()
 	s3c.EXPECT().ListObjectsV2(gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{
 		Contents: []types.Object{
 			{Size: 100},
@@ -61,3 +70,38 @@ func TestS3PresignedHappyPath(t *testing.T) {
 
 	SuiteTestPresignedAccess(t, ps)
 }
+
+func TestS3SignDownloadMeta(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s3c := mock.NewMockS3Client(ctrl)
+	s3c.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(&s3.HeadObjectOutput{
+		ContentLength: 100,
+		ContentType:   aws.String("application/tar"),
+		Metadata: map[string]string{
+			"gitpod-encryption-algorithm": storage.EncryptionAlgorithmAESGCMStream,
+			"gitpod-encryption-keyid":     "k1",
+		},
+	}, nil)
+	ps3c := mock.NewMockPresignedS3Client(ctrl)
+	ps3c.EXPECT().PresignGetObject(gomock.Any(), gomock.Any()).Return(&v4.PresignedHTTPRequest{
+		URL: "some value",
+	}, nil)
+
+	dut := storage.NewPresignedS3Access(s3c, storage.S3Config{Bucket: "test-bucket"})
+	dut.PresignedFactory = func() storage.PresignedS3Client { return ps3c }
+
+	nfo, err := dut.SignDownload(context.Background(), "test-bucket", "foo/bar.txt", &storage.SignedURLOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := storage.ObjectMeta{
+		ContentType:         "application/tar",
+		EncryptionAlgorithm: storage.EncryptionAlgorithmAESGCMStream,
+		EncryptionKeyID:     "k1",
+	}
+	if nfo.Meta != expected {
+		t.Errorf("unexpected object meta: %+v", nfo.Meta)
+	}
+}
@@ -101,6 +101,11 @@ type ObjectMeta struct {
 	OCIMediaType       string
 	Digest             string
 	UncompressedDigest string
+
+	// EncryptionAlgorithm is the algorithm the object was encrypted with, or empty if it isn't encrypted
+	EncryptionAlgorithm string
+	// EncryptionKeyID identifies the key encryption key which wrapped the object's data key
+	EncryptionKeyID string
 }
 
 // DownloadInfo describes an object for download
@@ -108,6 +113,10 @@ type DownloadInfo struct {
 	Meta ObjectMeta
 	URL  string
 	Size int64
+
+	// DataKey is the unwrapped data key of an encrypted object. It is only set when the object
+	// is downloaded by a process which has no access to the key encryption keys.
+	DataKey []byte
 }
 
 // UploadInfo describes an object for upload
@@ -136,6 +145,10 @@ type DirectDownloader interface {
 
 	// Downloads a snapshot. The snapshot name is expected to be one produced by Qualify
 	DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error)
+
+	// DownloadObject streams the raw content of an object without extracting it. The name is either a backup name
+	// or one produced by Qualify. If the object is not found, ErrNotFound is returned.
+	DownloadObject(ctx context.Context, name string) (io.ReadCloser, error)
 }
 
 // DirectAccess represents a remote location where we can store data
@@ -161,6 +174,41 @@ type DirectAccess interface {
 
 	// UploadInstance takes all files from a local location and uploads it to the remote storage
 	UploadInstance(ctx context.Context, source string, name string, options ...UploadOption) (bucket, obj string, err error)
+
+	// NewMultipartUpload starts the upload of an object in parts. Prefer NewStreamUpload over using this directly.
+	NewMultipartUpload(ctx context.Context, name string, options ...UploadOption) (MultipartUpload, error)
+}
+
+// UploadedPart describes a part of a multipart upload
+type UploadedPart struct {
+	// Number is the number of the part, starting at 1
+	Number int
+	Size   int64
+	// Checksum is the hex encoded SHA256 checksum of the part's content
+	Checksum string
+	// ETag is the storage's identifier of the part
+	ETag string
+}
+
+// MultipartUpload uploads an object in parts
+type MultipartUpload interface {
+	// UploadPart uploads a part and has the storage verify its checksum. All parts but the last
+	// one must be of the same size. UploadPart sets the part's ETag.
+	UploadPart(ctx context.Context, part *UploadedPart, data io.ReadSeeker) error
+
+	// Complete assembles the parts into the object and returns the object's size
+	Complete(ctx context.Context, parts []UploadedPart) (size int64, err error)
+
+	// Abort cancels the upload and removes all parts uploaded so far
+	Abort(ctx context.Context) error
+}
+
+// ObjectAttributesReader is implemented by DirectAccess implementations which can read the attributes
+// an object was uploaded with
+type ObjectAttributesReader interface {
+	// ObjectAttributes returns the content type and annotations of an object. The name is either a backup name
+	// or one produced by Qualify. If the object is not found, ErrNotFound is returned.
+	ObjectAttributes(ctx context.Context, name string) (*UploadOptions, error)
 }
 
 // UploadOptions configure remote storage upload
@@ -211,10 +259,32 @@ const (
 
 	// ObjectAnnotationOCIContentType is the OCI media type of the object
 	ObjectAnnotationOCIContentType = "gitpod-oci-contentType"
+
+	// ObjectAnnotationEncryptionAlgorithm is the algorithm the object was encrypted with
+	ObjectAnnotationEncryptionAlgorithm = "gitpod-encryption-algorithm"
+
+	// ObjectAnnotationEncryptionKeyID identifies the key encryption key which wrapped the object's data key
+	ObjectAnnotationEncryptionKeyID = "gitpod-encryption-keyId"
 )
 
 // NewDirectAccess provides direct access to a storage system
 func NewDirectAccess(c *config.StorageConfig) (DirectAccess, error) {
+	rs, err := newDirectAccess(c)
+	if err != nil {
+		return nil, err
+	}
+	if c.Encryption == nil {
+		return rs, nil
+	}
+
+	keys, err := NewKeyWrapper(c.Encryption)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot configure storage encryption: %w", err)
+	}
+	return NewEncryptedDirectAccess(rs, keys), nil
+}
+
+func newDirectAccess(c *config.StorageConfig) (DirectAccess, error) {
 	stage := c.GetStage()
 	if stage == "" {
 		return nil, xerrors.Errorf("missing storage stage")
@@ -234,6 +304,11 @@ func NewDirectAccess(c *config.StorageConfig) (DirectAccess, error) {
 		return newDirectS3Access(s3.NewFromConfig(*cfg), S3Config{
 			Bucket: c.S3Config.Bucket,
 		}), nil
+	case config.LocalStorage:
+		if c.LocalConfig == nil {
+			return nil, xerrors.Errorf("missing local storage config")
+		}
+		return newDirectLocalAccess(*c.LocalConfig)
 	default:
 		return &DirectNoopStorage{}, nil
 	}
@@ -260,6 +335,11 @@ func NewPresignedAccess(c *config.StorageConfig) (PresignedAccess, error) {
 		return NewPresignedS3Access(s3.NewFromConfig(*cfg), S3Config{
 			Bucket: c.S3Config.Bucket,
 		}), nil
+	case config.LocalStorage:
+		if c.LocalConfig == nil {
+			return nil, xerrors.Errorf("missing local storage config")
+		}
+		return newPresignedLocalAccess(*c.LocalConfig)
 	default:
 		log.Warnf("falling back to noop presigned storage access. Is this intentional? (storage kind: %s)", c.Kind)
 		return &PresignedNoopStorage{}, nil
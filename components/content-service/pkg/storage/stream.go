@@ -0,0 +1,235 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/opencontainers/go-digest"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+)
+
+const (
+	// DefaultUploadPartSize is the part size of stream uploads. It bounds the memory a stream upload uses.
+	DefaultUploadPartSize = 16 * 1024 * 1024
+
+	// minUploadPartSize is the smallest part size all storage backends support
+	minUploadPartSize = 5 * 1024 * 1024
+)
+
+// streamTransformer is implemented by remote storage which transforms content before it's uploaded, e.g. to encrypt it
+type streamTransformer interface {
+	// newStreamTransform is called once per stream upload. All attempts of the upload use the same transform,
+	// s.t. the same content is transformed into the same bytes and the upload can be resumed.
+	newStreamTransform(ctx context.Context) (streamTransform, error)
+}
+
+// streamTransform transforms the content of a stream upload
+type streamTransform interface {
+	transform(src io.Reader) io.ReadCloser
+	options() []UploadOption
+}
+
+// StreamUploadOptions configure a stream upload
+type StreamUploadOptions struct {
+	// PartSize is the size of the parts we upload. Defaults to DefaultUploadPartSize.
+	PartSize int
+	// Compress enables zstd compression of the stream
+	Compress bool
+	// Options configure the uploaded object
+	Options []UploadOption
+}
+
+// StreamUploadResult describes a completed stream upload
+type StreamUploadResult struct {
+	Size   int64
+	Parts  int
+	Digest digest.Digest
+}
+
+// NewStreamUpload prepares the upload of a stream to an object of rs without staging it in a local file
+func NewStreamUpload(rs DirectAccess, name string, opts StreamUploadOptions) *StreamUpload {
+	if opts.PartSize == 0 {
+		opts.PartSize = DefaultUploadPartSize
+	}
+	if opts.PartSize < minUploadPartSize {
+		opts.PartSize = minUploadPartSize
+	}
+	return &StreamUpload{
+		rs:   rs,
+		name: name,
+		opts: opts,
+	}
+}
+
+// StreamUpload uploads a stream in parts. If an attempt fails, calling Run again with a stream
+// of the same content resumes the upload: parts which were uploaded already are only verified
+// against their checksum. If the content has changed, the upload starts over.
+type StreamUpload struct {
+	rs   DirectAccess
+	name string
+	opts StreamUploadOptions
+
+	upload    MultipartUpload
+	parts     []UploadedPart
+	transform streamTransform
+}
+
+// Run uploads the content of src. Run can be called repeatedly with the same content until it succeeds.
+func (u *StreamUpload) Run(ctx context.Context, src io.Reader) (res *StreamUploadResult, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "StreamUpload.Run")
+	span.SetTag("name", u.name)
+	span.LogKV("resumeParts", len(u.parts))
+	defer tracing.FinishSpan(span, &err)
+
+	in := src
+	if u.opts.Compress {
+		cr := compressStream(src)
+		defer cr.Close()
+		in = cr
+	}
+
+	options := u.opts.Options
+	if t, ok := u.rs.(streamTransformer); ok {
+		if u.transform == nil {
+			u.transform, err = t.newStreamTransform(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		tr := u.transform.transform(in)
+		defer tr.Close()
+		in = tr
+		options = append(append([]UploadOption{}, options...), u.transform.options()...)
+	}
+
+	var (
+		buf     = make([]byte, u.opts.PartSize)
+		dgst    = sha256.New()
+		size    int64
+		resumed int
+		num     int
+	)
+	for {
+		n, rerr := io.ReadFull(in, buf)
+		if rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF {
+			return nil, xerrors.Errorf("cannot read stream: %w", rerr)
+		}
+		if n == 0 && num > 0 {
+			break
+		}
+
+		num++
+		data := buf[:n]
+		sum := sha256.Sum256(data)
+		_, _ = dgst.Write(data)
+		size += int64(n)
+
+		part := UploadedPart{
+			Number:   num,
+			Size:     int64(n),
+			Checksum: hex.EncodeToString(sum[:]),
+		}
+		if num <= len(u.parts) {
+			prev := u.parts[num-1]
+			if prev.Size == part.Size && prev.Checksum == part.Checksum {
+				resumed++
+				if rerr != nil {
+					break
+				}
+				continue
+			}
+
+			// The content differs from the previous attempt, hence the parts we uploaded before are useless.
+			// We don't have their content anymore, so the next attempt has to start over.
+			u.reset(ctx)
+			return nil, xerrors.Errorf("content of part %d has changed since the previous attempt - restarting upload", num)
+		}
+
+		if u.upload == nil {
+			u.upload, err = u.rs.NewMultipartUpload(ctx, u.name, options...)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot start upload: %w", err)
+			}
+		}
+		err = u.upload.UploadPart(ctx, &part, bytes.NewReader(data))
+		if err != nil {
+			return nil, xerrors.Errorf("cannot upload part %d: %w", num, err)
+		}
+		u.parts = append(u.parts, part)
+
+		if rerr != nil {
+			break
+		}
+	}
+	span.LogKV("parts", num, "resumedParts", resumed)
+
+	objSize, err := u.upload.Complete(ctx, u.parts[:num])
+	if err != nil {
+		return nil, xerrors.Errorf("cannot complete upload: %w", err)
+	}
+	if objSize != size {
+		u.reset(ctx)
+		return nil, xerrors.Errorf("uploaded object has %d bytes, expected %d", objSize, size)
+	}
+
+	return &StreamUploadResult{
+		Size:   size,
+		Parts:  num,
+		Digest: digest.NewDigest(digest.SHA256, dgst),
+	}, nil
+}
+
+// Abort cancels the upload and removes all parts uploaded so far
+func (u *StreamUpload) Abort(ctx context.Context) error {
+	if u.upload == nil {
+		return nil
+	}
+	err := u.upload.Abort(ctx)
+	u.upload = nil
+	u.parts = nil
+	// an upload which starts over must not reuse the transform, e.g. its encryption key and nonces
+	u.transform = nil
+	return err
+}
+
+func (u *StreamUpload) reset(ctx context.Context) {
+	err := u.Abort(ctx)
+	if err != nil {
+		log.WithError(err).WithField("name", u.name).Warn("cannot abort upload")
+	}
+}
+
+// compressStream returns a reader producing the zstd compressed content of src.
+// The encoder is single-threaded so that the same content always compresses to the same bytes,
+// which is required for resuming stream uploads.
+func compressStream(src io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		enc, err := zstd.NewWriter(pw, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		_, err = io.Copy(enc, src)
+		if err != nil {
+			enc.Close()
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(enc.Close())
+	}()
+	return pr
+}
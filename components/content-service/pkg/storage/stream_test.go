@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/xerrors"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+const testPartSize = 1024
+
+func newTestLocalStorage(t *testing.T) *DirectLocalStorage {
+	rs, err := newDirectLocalAccess(config.LocalConfig{
+		Path:       t.TempDir(),
+		BaseURL:    "http://unused",
+		SigningKey: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.Init(context.Background(), "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rs
+}
+
+func readTestObject(t *testing.T, rs DirectAccess, name string) []byte {
+	rc, err := rs.DownloadObject(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	res, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestStreamUpload(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Size     int
+		Compress bool
+	}{
+		{Name: "empty", Size: 0},
+		{Name: "single part", Size: 100},
+		{Name: "exact parts", Size: 3 * testPartSize},
+		{Name: "multiple parts", Size: 5*testPartSize + 17},
+		{Name: "compressed", Size: 5*testPartSize + 17, Compress: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			rs := newTestLocalStorage(t)
+
+			content := make([]byte, test.Size)
+			_, _ = rand.Read(content)
+
+			upload := NewStreamUpload(rs, DefaultBackup, StreamUploadOptions{Compress: test.Compress})
+			upload.opts.PartSize = testPartSize
+			res, err := upload.Run(ctx, bytes.NewReader(content))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act := readTestObject(t, rs, DefaultBackup)
+			if int64(len(act)) != res.Size {
+				t.Errorf("object has %d bytes, but upload reported %d", len(act), res.Size)
+			}
+			if test.Compress {
+				dec, err := zstd.NewReader(bytes.NewReader(act))
+				if err != nil {
+					t.Fatal(err)
+				}
+				act, err = io.ReadAll(dec)
+				dec.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !bytes.Equal(act, content) {
+				t.Errorf("uploaded content does not match")
+			}
+		})
+	}
+}
+
+func TestStreamUploadResume(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Compress         bool
+		Encrypt          bool
+		ChangeContent    bool
+		ExpectedAttempts int
+		ExpectedUploads  int
+	}{
+		{Name: "resume", ExpectedAttempts: 2, ExpectedUploads: 6},
+		{Name: "resume compressed", Compress: true, ExpectedAttempts: 2},
+		{Name: "resume encrypted", Encrypt: true, ExpectedAttempts: 2},
+		{Name: "content changed", ChangeContent: true, ExpectedAttempts: 3, ExpectedUploads: 9},
+		{Name: "content changed encrypted", Encrypt: true, ChangeContent: true, ExpectedAttempts: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			flaky := &flakyStorage{DirectAccess: newTestLocalStorage(t), FailPart: 4}
+			var rs DirectAccess = flaky
+			if test.Encrypt {
+				rs = NewEncryptedDirectAccess(flaky, newTestKeyWrapper(t, "k1"))
+			}
+
+			content := make([]byte, 5*testPartSize+17)
+			_, _ = rand.Read(content)
+
+			upload := NewStreamUpload(rs, DefaultBackup, StreamUploadOptions{Compress: test.Compress})
+			upload.opts.PartSize = testPartSize
+
+			var (
+				attempts int
+				err      error
+			)
+			for attempts = 1; attempts <= 3; attempts++ {
+				src := content
+				if test.ChangeContent && attempts > 1 {
+					src = append([]byte("changed"), content...)
+				}
+				_, err = upload.Run(ctx, bytes.NewReader(src))
+				if err == nil {
+					break
+				}
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if attempts != test.ExpectedAttempts {
+				t.Errorf("expected %d attempts, got %d", test.ExpectedAttempts, attempts)
+			}
+			if test.ExpectedUploads > 0 && flaky.Uploads != test.ExpectedUploads {
+				t.Errorf("expected %d part uploads, got %d", test.ExpectedUploads, flaky.Uploads)
+			}
+
+			expected := content
+			if test.ChangeContent {
+				expected = append([]byte("changed"), content...)
+			}
+			act := readTestObject(t, rs, DefaultBackup)
+			if test.Compress {
+				dec, err := zstd.NewReader(bytes.NewReader(act))
+				if err != nil {
+					t.Fatal(err)
+				}
+				act, _ = io.ReadAll(dec)
+				dec.Close()
+			}
+			if !bytes.Equal(act, expected) {
+				t.Errorf("uploaded content does not match")
+			}
+		})
+	}
+}
+
+func TestStreamUploadEncrypted(t *testing.T) {
+	ctx := context.Background()
+	local := newTestLocalStorage(t)
+	rs := NewEncryptedDirectAccess(local, newTestKeyWrapper(t, "k1"))
+
+	content := make([]byte, 3*encryptionSegmentSize+17)
+	_, _ = rand.Read(content)
+
+	upload := NewStreamUpload(rs, DefaultBackup, StreamUploadOptions{})
+	upload.opts.PartSize = encryptionSegmentSize
+	_, err := upload.Run(ctx, bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if raw := readTestObject(t, local, DefaultBackup); bytes.Contains(raw, content[:1024]) {
+		t.Errorf("stored object is not encrypted")
+	}
+	if act := readTestObject(t, rs, DefaultBackup); !bytes.Equal(act, content) {
+		t.Errorf("unexpected content")
+	}
+}
+
+// flakyStorage fails the upload of a part once
+type flakyStorage struct {
+	DirectAccess
+
+	FailPart int
+	Uploads  int
+	failed   bool
+}
+
+func (rs *flakyStorage) NewMultipartUpload(ctx context.Context, name string, opts ...UploadOption) (MultipartUpload, error) {
+	upload, err := rs.DirectAccess.NewMultipartUpload(ctx, name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &flakyUpload{MultipartUpload: upload, rs: rs}, nil
+}
+
+type flakyUpload struct {
+	MultipartUpload
+	rs *flakyStorage
+}
+
+func (u *flakyUpload) UploadPart(ctx context.Context, part *UploadedPart, data io.ReadSeeker) error {
+	if part.Number == u.rs.FailPart && !u.rs.failed {
+		u.rs.failed = true
+		return xerrors.Errorf("connection reset")
+	}
+	u.rs.Uploads++
+	return u.MultipartUpload.UploadPart(ctx, part, data)
+}
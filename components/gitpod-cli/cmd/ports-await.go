@@ -5,13 +5,16 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"strconv"
 	"time"
 
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
 	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/spf13/cobra"
 	"golang.org/x/xerrors"
 )
@@ -24,6 +27,7 @@ const (
 var awaitPortCmd = &cobra.Command{
 	Use:   "await <port>",
 	Short: "Waits for a process to listen on a port",
+	Long:  "Waits for a process to listen on a port. If the port has a health check configured, waits for it to be healthy as well.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		port, err := strconv.ParseUint(args[0], 10, 16)
@@ -54,6 +58,10 @@ var awaitPortCmd = &cobra.Command{
 				}
 
 				if pattern.MatchString(string(tcp)) {
+					awaitPortHealth(cmd.Context(), uint32(port), t)
+					if cmd.Context().Err() != nil {
+						return nil
+					}
 					fmt.Println("ok")
 					return nil
 				}
@@ -68,6 +76,38 @@ var awaitPortCmd = &cobra.Command{
 	},
 }
 
+// awaitPortHealth waits for a served port to not be unhealthy anymore. Ports without a health check
+// are never unhealthy. If supervisor cannot tell us about the health of the port, we don't wait.
+func awaitPortHealth(ctx context.Context, port uint32, t *time.Ticker) {
+	client, err := supervisor.New(ctx)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	for ctx.Err() == nil {
+		ports, err := client.GetPortsList(ctx)
+		if err != nil {
+			return
+		}
+		unhealthy := false
+		for _, p := range ports {
+			if p.LocalPort == port {
+				unhealthy = p.Health == api.PortHealth_unhealthy
+				break
+			}
+		}
+		if !unhealthy {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-t.C:
+		}
+	}
+}
+
 var awaitPortCmdAlias = &cobra.Command{
 	Hidden:     true,
 	Deprecated: "please use `ports await` instead.",
@@ -56,8 +56,14 @@ var listPortsCmd = &cobra.Command{
 				exposedUrl = port.Exposed.Url
 			}
 
-			if !port.Served {
+			if port.Health == api.PortHealth_unhealthy {
+				status = "unhealthy"
+				statusColor = tablewriter.FgRedColor
+			} else if !port.Served && !port.ServedUdp {
 				status = "not served"
+			} else if !port.Served && port.Tunneled == nil {
+				// ports served over UDP only cannot be exposed, but tunneled
+				status = "not tunneled"
 			} else if !accessible {
 				if port.AutoExposure == api.PortAutoExposure_failed {
 					status = "failed to expose"
@@ -95,13 +101,20 @@ var listPortsCmd = &cobra.Command{
 				}
 			}
 
+			protocol := port.GetExposed().GetProtocol().String()
+			if port.ServedUdp && port.Served {
+				protocol += ", udp"
+			} else if port.ServedUdp {
+				protocol = "udp"
+			}
+
 			colors := []tablewriter.Colors{}
 			if !noColor && utils.ColorsEnabled() {
 				colors = []tablewriter.Colors{{}, {statusColor}, {}, {}}
 			}
 
 			table.Rich(
-				[]string{fmt.Sprint(port.LocalPort), status, port.Exposed.Protocol.String(), exposedUrl, nameAndDescription},
+				[]string{fmt.Sprint(port.LocalPort), status, protocol, exposedUrl, nameAndDescription},
 				colors,
 			)
 		}
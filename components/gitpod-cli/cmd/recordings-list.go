@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+// listRecordingsCmd represents the recordings list command
+var listRecordingsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the terminal recordings of the workspace",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+		defer cancel()
+
+		files, err := os.ReadDir(recordingsLocation)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return xerrors.Errorf("cannot list recordings: %w", err)
+		}
+
+		client, err := supervisor.New(ctx)
+		if err != nil {
+			return xerrors.Errorf("cannot get terminal list: %w", err)
+		}
+		defer client.Close()
+
+		terminals, err := client.Terminal.List(ctx, &api.ListTerminalsRequest{})
+		if err != nil {
+			return xerrors.Errorf("cannot get terminal list: %w", err)
+		}
+		active := make(map[string]bool)
+		for _, term := range terminals.Terminals {
+			if term.Recording != "" {
+				active[filepath.Base(term.Recording)] = true
+			}
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Title", "Started", "Duration", "Recording"})
+		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+		table.SetCenterSeparator("|")
+
+		var count int
+		for _, file := range files {
+			if file.IsDir() || filepath.Ext(file.Name()) != ".cast" {
+				continue
+			}
+
+			var duration float64
+			header, err := readRecording(filepath.Join(recordingsLocation, file.Name()), func(ev recordingEvent) error {
+				duration = ev.Time
+				return nil
+			})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "cannot read recording %s: %v\n", file.Name(), err)
+				continue
+			}
+
+			recording := "no"
+			if active[file.Name()] {
+				recording = "yes"
+			}
+			table.Append([]string{
+				strings.TrimSuffix(file.Name(), ".cast"),
+				header.Title,
+				time.Unix(header.Timestamp, 0).Format(time.RFC3339),
+				(time.Duration(duration * float64(time.Second))).Round(time.Second).String(),
+				recording,
+			})
+			count++
+		}
+
+		if count == 0 {
+			fmt.Println("There are no terminal recordings")
+			return nil
+		}
+		table.Render()
+		return nil
+	},
+}
+
+func init() {
+	recordingsCmd.AddCommand(listRecordingsCmd)
+}
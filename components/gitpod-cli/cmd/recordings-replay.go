@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+var replayRecordingCmdOpts struct {
+	Speed     float64
+	IdleLimit time.Duration
+}
+
+// replayRecordingCmd represents the recordings replay command
+var replayRecordingCmd = &cobra.Command{
+	Use:   "replay <name>",
+	Short: "Replays a terminal recording in the current terminal",
+	Long: `Replays a terminal recording in the current terminal.
+
+Recordings are stored in the asciicast v2 format and can be played with any asciicast player as well.
+Use 'gp recordings list' to obtain the names of the recordings.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if replayRecordingCmdOpts.Speed <= 0 {
+			return GpError{Message: "The speed must be greater than 0", OutCome: utils.Outcome_UserErr}
+		}
+
+		var (
+			ctx  = cmd.Context()
+			path = resolveRecording(args[0])
+			last float64
+		)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			msg := fmt.Sprintf("The recording %s was not found.\nUse 'gp recordings list' to obtain the names of the recordings.\n", args[0])
+			return GpError{Err: err, Message: msg, OutCome: utils.Outcome_UserErr}
+		}
+
+		_, err := readRecording(path, func(ev recordingEvent) error {
+			if ev.Code != "o" {
+				return nil
+			}
+
+			delay := time.Duration((ev.Time - last) / replayRecordingCmdOpts.Speed * float64(time.Second))
+			if replayRecordingCmdOpts.IdleLimit > 0 && delay > replayRecordingCmdOpts.IdleLimit {
+				delay = replayRecordingCmdOpts.IdleLimit
+			}
+			last = ev.Time
+			if delay > 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(delay):
+				}
+			}
+
+			_, err := os.Stdout.WriteString(ev.Data)
+			return err
+		})
+		if err != nil {
+			return xerrors.Errorf("cannot replay recording: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	recordingsCmd.AddCommand(replayRecordingCmd)
+
+	replayRecordingCmd.Flags().Float64VarP(&replayRecordingCmdOpts.Speed, "speed", "s", 1, "playback speed, e.g. 2 replays the recording twice as fast")
+	replayRecordingCmd.Flags().DurationVarP(&replayRecordingCmdOpts.IdleLimit, "idle-limit", "i", 2*time.Second, "limit idle time during the replay to this duration, 0 replays idle time as recorded")
+}
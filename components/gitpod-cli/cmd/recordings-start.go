@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startRecordingCmd represents the recordings start command
+var startRecordingCmd = &cobra.Command{
+	Use:   "start [terminal alias]",
+	Short: "Starts recording a terminal, the current terminal by default",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+		defer cancel()
+
+		client, err := supervisor.New(ctx)
+		if err != nil {
+			return xerrors.Errorf("cannot start recording: %w", err)
+		}
+		defer client.Close()
+
+		alias, err := recordedTerminal(ctx, client, args)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Terminal.SetRecording(ctx, &api.SetTerminalRecordingRequest{Alias: alias, Enabled: true})
+		if status.Code(err) == codes.FailedPrecondition {
+			return GpError{Err: err, Message: "Terminals cannot be recorded in this workspace.\n", OutCome: utils.Outcome_UserErr}
+		}
+		if err != nil {
+			return xerrors.Errorf("cannot start recording: %w", err)
+		}
+
+		fmt.Printf("Recording terminal %s to %s\n", alias, strings.TrimSuffix(filepath.Base(resp.File), ".cast"))
+		return nil
+	},
+}
+
+// recordedTerminal returns the alias of the terminal given as argument, or of the terminal gp runs in
+func recordedTerminal(ctx context.Context, client *supervisor.SupervisorClient, args []string) (string, error) {
+	if len(args) > 0 {
+		_, err := client.Terminal.Get(ctx, &api.GetTerminalRequest{Alias: args[0]})
+		if status.Code(err) == codes.NotFound {
+			msg := fmt.Sprintf("The terminal %s was not found.\nMake sure to use the correct terminal alias.\nUse 'gp tasks list' to obtain the terminal alias of a task.\n", args[0])
+			return "", GpError{Err: err, Message: msg, OutCome: utils.Outcome_UserErr}
+		}
+		if err != nil {
+			return "", xerrors.Errorf("cannot get terminal: %w", err)
+		}
+		return args[0], nil
+	}
+
+	terminals, err := client.Terminal.List(ctx, &api.ListTerminalsRequest{})
+	if err != nil {
+		return "", xerrors.Errorf("cannot get terminal list: %w", err)
+	}
+	ppid := int64(os.Getppid())
+	for _, term := range terminals.Terminals {
+		if term.Pid == ppid {
+			return term.Alias, nil
+		}
+	}
+	return "", GpError{Message: "The current terminal is not a terminal of the workspace.\nPass the alias of the terminal you want to record instead.\n", OutCome: utils.Outcome_UserErr}
+}
+
+func init() {
+	recordingsCmd.AddCommand(startRecordingCmd)
+}
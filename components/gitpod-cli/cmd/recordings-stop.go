@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+// stopRecordingCmd represents the recordings stop command
+var stopRecordingCmd = &cobra.Command{
+	Use:   "stop [terminal alias]",
+	Short: "Stops recording a terminal, the current terminal by default",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+		defer cancel()
+
+		client, err := supervisor.New(ctx)
+		if err != nil {
+			return xerrors.Errorf("cannot stop recording: %w", err)
+		}
+		defer client.Close()
+
+		alias, err := recordedTerminal(ctx, client, args)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Terminal.SetRecording(ctx, &api.SetTerminalRecordingRequest{Alias: alias, Enabled: false})
+		if err != nil {
+			return xerrors.Errorf("cannot stop recording: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	recordingsCmd.AddCommand(stopRecordingCmd)
+}
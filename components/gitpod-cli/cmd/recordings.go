@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+// recordingsLocation is the directory supervisor records terminals to
+const recordingsLocation = "/workspace/.gitpod/recordings"
+
+// recordingsCmd represents the recordings command
+var recordingsCmd = &cobra.Command{
+	Use:   "recordings",
+	Short: "Record terminals of the workspace and replay their recordings",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			_ = cmd.Help()
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(recordingsCmd)
+}
+
+// recordingHeader is the header of a recording in the asciicast v2 format
+type recordingHeader struct {
+	Version   int    `json:"version"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+	Title     string `json:"title"`
+}
+
+// recordingEvent is an event of a recording in the asciicast v2 format
+type recordingEvent struct {
+	// Time is the number of seconds since the start of the recording
+	Time float64
+	Code string
+	Data string
+}
+
+// resolveRecording returns the path of a recording given either its name or path
+func resolveRecording(name string) string {
+	if !strings.HasSuffix(name, ".cast") {
+		name += ".cast"
+	}
+	if strings.Contains(name, "/") {
+		return name
+	}
+	return filepath.Join(recordingsLocation, name)
+}
+
+// readRecording reads the recording at path and calls fn for all of its events
+func readRecording(path string, fn func(ev recordingEvent) error) (*recordingHeader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	line, err := r.ReadBytes('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	var header recordingHeader
+	err = json.Unmarshal(line, &header)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read recording header of %s: %w", path, err)
+	}
+	if header.Version != 2 {
+		return nil, xerrors.Errorf("recording %s has unsupported version %d", path, header.Version)
+	}
+
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(strings.TrimSpace(string(line))) > 0 {
+			var ev []interface{}
+			if jsonErr := json.Unmarshal(line, &ev); jsonErr != nil || len(ev) != 3 {
+				// the last event can be incomplete if the recording is still in progress
+				return &header, nil
+			}
+			t, _ := ev[0].(float64)
+			code, _ := ev[1].(string)
+			data, _ := ev[2].(string)
+			if fnErr := fn(recordingEvent{Time: t, Code: code, Data: data}); fnErr != nil {
+				return &header, fnErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return &header, nil
+		}
+		if err != nil {
+			return &header, err
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// restartTaskCmd represents the restart task command
+var restartTaskCmd = &cobra.Command{
+	Use:   "restart <id>",
+	Short: "Restart the command of a workspace task in its terminal",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+		defer cancel()
+
+		client, err := supervisor.New(ctx)
+		if err != nil {
+			return xerrors.Errorf("cannot get task list: %w", err)
+		}
+		defer client.Close()
+
+		tasks, err := client.GetTasksListByState(ctx, api.TaskState_running)
+		if err != nil {
+			return xerrors.Errorf("cannot get task list: %w", err)
+		}
+		if len(tasks) == 0 {
+			fmt.Println("There are no running tasks")
+			return nil
+		}
+
+		var task *api.TaskStatus
+		if len(args) > 0 {
+			for _, t := range tasks {
+				if t.Terminal == args[0] {
+					task = t
+					break
+				}
+			}
+			if task == nil {
+				msg := fmt.Sprintf("The selected task was not found or is not running: %s.\nMake sure to use the correct task ID.\nUse 'gp tasks list' to obtain the task id or run 'gp tasks restart' to select the desired task\n", args[0])
+				return GpError{Message: msg, OutCome: utils.Outcome_UserErr}
+			}
+		} else if len(tasks) == 1 {
+			task = tasks[0]
+		} else {
+			var taskNames []string
+			for _, t := range tasks {
+				taskNames = append(taskNames, t.Presentation.Name)
+			}
+
+			prompt := promptui.Select{
+				Label:        "What task do you want to restart?",
+				Items:        taskNames,
+				HideSelected: true,
+			}
+
+			selectedIndex, selectedValue, err := prompt.Run()
+
+			if selectedValue == "" {
+				return nil
+			}
+
+			if err != nil {
+				return xerrors.Errorf("error occurred with the input prompt: %w", err)
+			}
+
+			task = tasks[selectedIndex]
+		}
+
+		_, err = client.Control.RestartTask(ctx, &api.RestartTaskRequest{Id: task.Id})
+		if status.Code(err) == codes.FailedPrecondition {
+			msg := fmt.Sprintf("The task %s has no command which could be restarted.\n", task.Presentation.Name)
+			return GpError{Message: msg, OutCome: utils.Outcome_UserErr}
+		}
+		if err != nil {
+			return xerrors.Errorf("cannot restart task: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	tasksCmd.AddCommand(restartTaskCmd)
+}
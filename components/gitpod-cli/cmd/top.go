@@ -33,7 +33,7 @@ type topData struct {
 
 var topCmd = &cobra.Command{
 	Use:   "top",
-	Short: "Display usage of workspace resources (CPU and memory)",
+	Short: "Display usage of workspace resources (CPU, memory and storage)",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 		defer cancel()
@@ -108,6 +108,15 @@ func outputTable(workspaceResources *api.ResourcesStatusResponse, workspaceClass
 	table.Rich([]string{"CPU (millicores)", cpu}, cpuColors)
 	table.Rich([]string{"Memory (bytes)", memory}, memoryColors)
 
+	if storage := workspaceResources.Storage; storage != nil && storage.Limit > 0 {
+		storageFraction := int64((float64(storage.Used) / float64(storage.Limit)) * 100)
+		var storageColors []tablewriter.Colors
+		if !noColor && utils.ColorsEnabled() {
+			storageColors = []tablewriter.Colors{nil, {getColor(storage.Severity)}}
+		}
+		table.Rich([]string{"Storage (bytes)", fmt.Sprintf("%dMi/%dMi (%d%%)", storage.Used/(1024*1024), storage.Limit/(1024*1024), storageFraction)}, storageColors)
+	}
+
 	table.Render()
 }
 
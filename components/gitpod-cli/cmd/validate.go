@@ -20,6 +20,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
 	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"golang.org/x/xerrors"
@@ -83,6 +84,15 @@ func runRebuild(ctx context.Context, supervisorClient *supervisor.SupervisorClie
 		return GpError{Err: err, OutCome: utils.Outcome_UserErr, ErrorCode: utils.RebuildErrorCode_MissingGitpodYaml, Silence: true}
 	}
 
+	err = gitpod.ValidateTaskDependencies(gitpodConfig.Tasks)
+	if err != nil {
+		fmt.Println("The tasks in your .gitpod.yml have invalid dependencies: " + err.Error())
+		fmt.Println("")
+		fmt.Println("For help check out the reference page:")
+		fmt.Println("https://www.gitpod.io/docs/references/gitpod-yml#tasks")
+		return GpError{Err: err, OutCome: utils.Outcome_UserErr, ErrorCode: utils.RebuildErrorCode_InvalidTaskDependencies, Silence: true}
+	}
+
 	var image string
 	var dockerfilePath string
 	var dockerContext string
@@ -536,7 +546,7 @@ func checkAllTasksOpened(ctx context.Context, listener api.StatusService_TasksSt
 
 func areTasksOpened(tasks []*api.TaskStatus) bool {
 	for _, task := range tasks {
-		if task.State == api.TaskState_opening {
+		if task.State == api.TaskState_opening || task.State == api.TaskState_waiting {
 			return false
 		}
 	}
@@ -0,0 +1,67 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func NewSnapshot(t *testing.T, record db.Snapshot) db.Snapshot {
+	t.Helper()
+
+	id := uuid.New()
+	workspaceID := GenerateWorkspaceID()
+	result := db.Snapshot{
+		ID:                  id,
+		CreationTime:        time.Now().UTC().Truncate(time.Millisecond),
+		OriginalWorkspaceID: workspaceID,
+		BucketID:            "workspaces/" + workspaceID + "/snapshot-" + id.String() + ".tar@gitpod-user-" + uuid.NewString(),
+		State:               "available",
+	}
+
+	if record.ID != uuid.Nil {
+		result.ID = record.ID
+	}
+
+	if record.OriginalWorkspaceID != "" {
+		result.OriginalWorkspaceID = record.OriginalWorkspaceID
+	}
+
+	if record.BucketID != "" {
+		result.BucketID = record.BucketID
+	}
+
+	if record.State != "" {
+		result.State = record.State
+	}
+
+	return result
+}
+
+func CreateSnapshots(t *testing.T, conn *gorm.DB, snapshots ...db.Snapshot) []db.Snapshot {
+	t.Helper()
+
+	var records []db.Snapshot
+	var ids []string
+	for _, s := range snapshots {
+		record := NewSnapshot(t, s)
+		records = append(records, record)
+		ids = append(ids, record.ID.String())
+	}
+
+	require.NoError(t, conn.CreateInBatches(&records, 1000).Error)
+
+	t.Cleanup(func() {
+		require.NoError(t, conn.Where(ids).Delete(&db.Snapshot{}).Error)
+	})
+
+	return records
+}
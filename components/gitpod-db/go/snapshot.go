@@ -0,0 +1,52 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Snapshot struct {
+	ID                  uuid.UUID `gorm:"primary_key;column:id;type:char;size:36;" json:"id"`
+	CreationTime        time.Time `gorm:"column:creationTime;type:timestamp;default:CURRENT_TIMESTAMP(6);" json:"creationTime"`
+	AvailableTime       string    `gorm:"column:availableTime;type:varchar;size:255;" json:"availableTime"`
+	OriginalWorkspaceID string    `gorm:"column:originalWorkspaceId;type:char;size:36;" json:"originalWorkspaceId"`
+	// BucketID is the qualified name of the snapshot in the remote storage
+	BucketID string `gorm:"column:bucketId;type:varchar;size:255;" json:"bucketId"`
+	State    string `gorm:"column:state;type:varchar;size:255;" json:"state"`
+	Message  string `gorm:"column:message;type:varchar;size:255;" json:"message"`
+}
+
+// TableName sets the insert table name for this struct type
+func (s *Snapshot) TableName() string {
+	return "d_b_snapshot"
+}
+
+func GetSnapshot(ctx context.Context, conn *gorm.DB, id uuid.UUID) (Snapshot, error) {
+	var snapshot Snapshot
+
+	if id == uuid.Nil {
+		return Snapshot{}, fmt.Errorf("snapshot ID is a required argument")
+	}
+
+	tx := conn.
+		WithContext(ctx).
+		Where("id = ?", id).
+		First(&snapshot)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return Snapshot{}, fmt.Errorf("snapshot with ID %s does not exist: %w", id.String(), ErrorNotFound)
+		}
+		return Snapshot{}, fmt.Errorf("failed to retrieve snapshot: %w", tx.Error)
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	"github.com/gitpod-io/gitpod/components/gitpod-db/go/dbtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSnapshot(t *testing.T) {
+	t.Run("not found when snapshot does not exist", func(t *testing.T) {
+		conn := dbtest.ConnectForTests(t)
+
+		_, err := db.GetSnapshot(context.Background(), conn, uuid.New())
+		require.ErrorIs(t, err, db.ErrorNotFound)
+	})
+
+	t.Run("retrieves existing snapshot", func(t *testing.T) {
+		conn := dbtest.ConnectForTests(t)
+		created := dbtest.CreateSnapshots(t, conn, db.Snapshot{})[0]
+
+		retrieved, err := db.GetSnapshot(context.Background(), conn, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, created.OriginalWorkspaceID, retrieved.OriginalWorkspaceID)
+		require.Equal(t, created.BucketID, retrieved.BucketID)
+	})
+}
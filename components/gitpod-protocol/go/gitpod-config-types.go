@@ -31,6 +31,19 @@ type CoreDump struct {
 type Env struct {
 }
 
+// GitClone Configures how the repository is cloned. Useful for large repositories of which a workspace only needs a part.
+type GitClone struct {
+
+	// Makes a partial clone with the complete history instead of a shallow one. 'blob:none' omits all file content, 'tree:0' omits all trees and file content. Omitted objects are fetched on demand.
+	Filter string `yaml:"filter,omitempty" json:"filter,omitempty"`
+
+	// Set to true to fetch the Git LFS objects of the working copy. Set include and/or exclude to fetch only the LFS objects matching these path patterns.
+	Lfs interface{} `yaml:"lfs,omitempty" json:"lfs,omitempty"`
+
+	// Directories (relative to the repository root) to check out. All other directories are left out of the working copy. Files in the repository root are always checked out.
+	SparseCheckout []string `yaml:"sparseCheckout,omitempty" json:"sparseCheckout,omitempty"`
+}
+
 // Github Configures Gitpod's GitHub app (deprecated)
 type Github struct {
 
@@ -53,6 +66,9 @@ type GitpodConfig struct {
 	// Experimental network configuration in workspaces (deprecated). Enabled by default
 	ExperimentalNetwork bool `yaml:"experimentalNetwork,omitempty" json:"experimentalNetwork,omitempty"`
 
+	// Configures how the repository is cloned. Useful for large repositories of which a workspace only needs a part.
+	GitClone *GitClone `yaml:"gitClone,omitempty" json:"gitClone,omitempty"`
+
 	// Git config values should be provided in pairs. E.g. `core.autocrlf: input`. See https://git-scm.com/docs/git-config#_values.
 	GitConfig map[string]string `yaml:"gitConfig,omitempty" json:"gitConfig,omitempty"`
 
@@ -81,6 +97,32 @@ type GitpodConfig struct {
 	WorkspaceLocation string `yaml:"workspaceLocation,omitempty" json:"workspaceLocation,omitempty"`
 }
 
+// HealthCheck Checks whether the application serving the port is healthy. The 'onOpen' action runs once the port is healthy rather than as soon as it is served.
+type HealthCheck struct {
+
+	// The time between two checks, e.g. '5s' or '1m'. Default is 5 seconds.
+	Interval string `yaml:"interval,omitempty" json:"interval,omitempty"`
+
+	// The path of the HTTP requests. Default is '/'.
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+
+	// The status the HTTP requests must return for the port to be healthy. By default any status below 400 is healthy.
+	Status int `yaml:"status,omitempty" json:"status,omitempty"`
+
+	// 'http' (default) sends HTTP GET requests to the port, 'tcp' only checks whether the port accepts connections.
+	Type string `yaml:"type,omitempty" json:"type,omitempty"`
+}
+
+// Http The task is ready once an HTTP GET request to this port and path returns status 200.
+type Http struct {
+
+	// The path of the request. Default is '/'.
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+
+	// The port to send the request to.
+	Port int `yaml:"port" json:"port"`
+}
+
 // Image_object The Docker image to run your workspace in.
 type Image_object struct {
 
@@ -141,6 +183,9 @@ type PortsItems struct {
 	// A description to identify what is this port used for.
 	Description string `yaml:"description,omitempty" json:"description,omitempty"`
 
+	// Checks whether the application serving the port is healthy. The 'onOpen' action runs once the port is healthy rather than as soon as it is served.
+	HealthCheck *HealthCheck `yaml:"healthCheck,omitempty" json:"healthCheck,omitempty"`
+
 	// Port name.
 	Name string `yaml:"name,omitempty" json:"name,omitempty"`
 
@@ -189,6 +234,25 @@ type Prebuilds_object struct {
 	PullRequestsFromForks bool `yaml:"pullRequestsFromForks,omitempty" json:"pullRequestsFromForks,omitempty"`
 }
 
+// ReadinessProbe Determines when the task is ready, i.e. when the tasks depending on it can start. Without a probe, a task is ready once its commands have terminated successfully.
+type ReadinessProbe struct {
+
+	// The time after which a single attempt of the probe fails, e.g. '30s'. Default is 10 seconds.
+	AttemptTimeout string `yaml:"attemptTimeout,omitempty" json:"attemptTimeout,omitempty"`
+
+	// The task is ready once this shell command exits with 0. The command is run repeatedly until it does.
+	Command string `yaml:"command,omitempty" json:"command,omitempty"`
+
+	// The task is ready once an HTTP GET request to this port and path returns status 200.
+	Http *Http `yaml:"http,omitempty" json:"http,omitempty"`
+
+	// The task is ready once this port accepts TCP connections.
+	Port int `yaml:"port,omitempty" json:"port,omitempty"`
+
+	// The time after which the task fails if it is not ready, e.g. '30s' or '5m'. Default is 5 minutes.
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
 // TasksItems
 type TasksItems struct {
 
@@ -198,12 +262,18 @@ type TasksItems struct {
 	// The main shell command to run after `before` and `init`. This command is executed last on every start and doesn't have to terminate.
 	Command string `yaml:"command,omitempty" json:"command,omitempty"`
 
+	// Names of the tasks this task depends on. The task starts once all of them are ready.
+	DependsOn []string `yaml:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+
 	// Environment variables to set.
 	Env *Env `yaml:"env,omitempty" json:"env,omitempty"`
 
 	// A shell command to run between `before` and the main `command`. This command is executed only on after initializing a workspace with a fresh clone, but not on restarts and snapshots. This command is expected to terminate. If it fails, the `command` property will not be executed.
 	Init string `yaml:"init,omitempty" json:"init,omitempty"`
 
+	// The number of times the command of the task is restarted in a row at most. The count starts over once the command ran for a minute or is restarted manually. Default is 10.
+	MaxRestarts int `yaml:"maxRestarts,omitempty" json:"maxRestarts,omitempty"`
+
 	// Name of the task. Shown on the tab of the opened terminal.
 	Name string `yaml:"name,omitempty" json:"name,omitempty"`
 
@@ -215,6 +285,12 @@ type TasksItems struct {
 
 	// A shell command to run after `before`. This command is executed only on during workspace prebuilds. This command is expected to terminate. If it fails, the workspace build fails.
 	Prebuild string `yaml:"prebuild,omitempty" json:"prebuild,omitempty"`
+
+	// Determines when the task is ready, i.e. when the tasks depending on it can start. Without a probe, a task is ready once its commands have terminated successfully.
+	ReadinessProbe *ReadinessProbe `yaml:"readinessProbe,omitempty" json:"readinessProbe,omitempty"`
+
+	// Whether to run the command of the task again when it terminates. Restarts are delayed by an exponential backoff. Default is 'never'.
+	Restart string `yaml:"restart,omitempty" json:"restart,omitempty"`
 }
 
 // Vscode Configure VS Code integration
@@ -1898,12 +1898,13 @@ type ImageConfigFile struct {
 
 // PortConfig is the PortConfig message type
 type PortConfig struct {
-	OnOpen      string  `json:"onOpen,omitempty"`
-	Port        float64 `json:"port,omitempty"`
-	Visibility  string  `json:"visibility,omitempty"`
-	Description string  `json:"description,omitempty"`
-	Name        string  `json:"name,omitempty"`
-	Protocol    string  `json:"protocol,omitempty"`
+	OnOpen      string       `json:"onOpen,omitempty"`
+	Port        float64      `json:"port,omitempty"`
+	Visibility  string       `json:"visibility,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Protocol    string       `json:"protocol,omitempty"`
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
 }
 
 // TaskConfig is the TaskConfig message type
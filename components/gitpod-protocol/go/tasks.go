@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package protocol
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateTaskDependencies checks that tasks only depend on tasks which exist under a unique name,
+// and that the dependencies between tasks contain no cycle.
+func ValidateTaskDependencies(tasks []*TasksItems) error {
+	indices := make(map[string][]int, len(tasks))
+	for i, t := range tasks {
+		if t != nil && t.Name != "" {
+			indices[t.Name] = append(indices[t.Name], i)
+		}
+	}
+
+	deps := make([][]int, len(tasks))
+	for i, t := range tasks {
+		if t == nil {
+			continue
+		}
+		for _, dep := range t.DependsOn {
+			idx, ok := indices[dep]
+			if !ok {
+				return fmt.Errorf("task %s depends on unknown task %s", taskName(tasks, i), dep)
+			}
+			if len(idx) > 1 {
+				return fmt.Errorf("task %s depends on %s, but there are %d tasks with that name", taskName(tasks, i), dep, len(idx))
+			}
+			deps[i] = append(deps[i], idx[0])
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		state = make([]int, len(tasks))
+		path  []int
+		visit func(i int) error
+	)
+	visit = func(i int) error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			var cycle []string
+			for j := len(path) - 1; j >= 0; j-- {
+				cycle = append([]string{taskName(tasks, path[j])}, cycle...)
+				if path[j] == i {
+					break
+				}
+			}
+			cycle = append(cycle, taskName(tasks, i))
+			return fmt.Errorf("tasks have cyclic dependencies: %s", strings.Join(cycle, " -> "))
+		}
+
+		state[i] = visiting
+		path = append(path, i)
+		for _, dep := range deps[i] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = visited
+		return nil
+	}
+	for i := range tasks {
+		if err := visit(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func taskName(tasks []*TasksItems, i int) string {
+	if tasks[i] != nil && tasks[i].Name != "" {
+		return tasks[i].Name
+	}
+	return fmt.Sprintf("#%d", i+1)
+}
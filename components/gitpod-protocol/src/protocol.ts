@@ -945,6 +945,7 @@ export interface WorkspaceConfig {
     checkoutLocation?: string;
     workspaceLocation?: string;
     gitConfig?: { [config: string]: string };
+    gitClone?: GitCloneConfig;
     github?: GithubAppConfig;
     vscode?: VSCodeConfig;
     jetbrains?: JetBrainsConfig;
@@ -971,6 +972,17 @@ export interface WorkspaceConfig {
     _featureFlags?: NamedWorkspaceFeatureFlag[];
 }
 
+export interface GitCloneConfig {
+    filter?: "blob:none" | "tree:0";
+    sparseCheckout?: string[];
+    lfs?: boolean | GitLFSCloneConfig;
+}
+
+export interface GitLFSCloneConfig {
+    include?: string[];
+    exclude?: string[];
+}
+
 export interface GithubAppConfig {
     prebuilds?: GithubAppPrebuildConfig;
 }
@@ -1086,6 +1098,13 @@ export interface PortConfig {
     protocol?: PortProtocol;
     description?: string;
     name?: string;
+    healthCheck?: PortHealthCheck;
+}
+export interface PortHealthCheck {
+    type?: "http" | "tcp";
+    path?: string;
+    status?: number;
+    interval?: string;
 }
 export namespace PortConfig {
     export function is(config: any): config is PortConfig {
@@ -1112,6 +1131,18 @@ export interface TaskConfig {
     env?: { [env: string]: any };
     openIn?: "bottom" | "main" | "left" | "right";
     openMode?: "split-top" | "split-left" | "split-right" | "split-bottom" | "tab-before" | "tab-after";
+    dependsOn?: string[];
+    readinessProbe?: TaskReadinessProbe;
+    restart?: "never" | "on-failure" | "always";
+    maxRestarts?: number;
+}
+
+export interface TaskReadinessProbe {
+    port?: number;
+    http?: { port: number; path?: string };
+    command?: string;
+    timeout?: string;
+    attemptTimeout?: string;
 }
 
 export namespace TaskConfig {
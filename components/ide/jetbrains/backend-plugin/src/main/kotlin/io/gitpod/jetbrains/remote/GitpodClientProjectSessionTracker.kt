@@ -61,7 +61,8 @@ class GitpodClientProjectSessionTracker(private val project: Project) : Disposab
         if (port === null) {
             return false
         }
-        return port.served && port.hasExposed()
+        // ports with a health check are only opened once they are healthy
+        return port.served && port.health != Status.PortHealth.unhealthy && port.hasExposed()
     }
 
     private fun getForwardedPortUrl(port: PortsStatus): String {
@@ -67,8 +67,15 @@ type TunnelListener struct {
 	Visibility supervisor.TunnelVisiblity
 	Ctx        context.Context
 	Cancel     func()
+
+	// unserved is the pending teardown of a listener whose port is no longer served
+	unserved *time.Timer
 }
 
+// unservedTunnelGracePeriod is how long a TCP listener is kept after its port stopped being served,
+// such that a restarting server keeps its local port
+const unservedTunnelGracePeriod = 30 * time.Second
+
 type Workspace struct {
 	InstanceID  string
 	WorkspaceID string
@@ -79,10 +86,11 @@ type Workspace struct {
 	supervisorListener *TunnelListener
 	supervisorClient   *grpc.ClientConn
 
-	tunnelMu        sync.RWMutex
-	tunnelListeners map[uint32]*TunnelListener
-	tunnelEnabled   bool
-	cancelTunnel    context.CancelFunc
+	tunnelMu           sync.RWMutex
+	tunnelListeners    map[uint32]*TunnelListener
+	udpTunnelListeners map[uint32]*TunnelListener
+	tunnelEnabled      bool
+	cancelTunnel       context.CancelFunc
 
 	localSSHListener *TunnelListener
 	SSHPrivateFN     string
@@ -326,9 +334,10 @@ func (b *Bastion) handleUpdate(ur *WorkspaceUpdateRequest) {
 			ctx:    ctx,
 			cancel: cancel,
 
-			tunnelClient:    make(chan chan *TunnelClient, 1),
-			tunnelListeners: make(map[uint32]*TunnelListener),
-			tunnelEnabled:   true,
+			tunnelClient:       make(chan chan *TunnelClient, 1),
+			tunnelListeners:    make(map[uint32]*TunnelListener),
+			udpTunnelListeners: make(map[uint32]*TunnelListener),
+			tunnelEnabled:      true,
 		}
 	}
 	ws.Phase = u.Status.Phase
@@ -775,6 +784,10 @@ func (b *Bastion) doTunnelPorts(ctx context.Context, ws *Workspace) error {
 			delete(ws.tunnelListeners, port)
 			t.Cancel()
 		}
+		for port, t := range ws.udpTunnelListeners {
+			delete(ws.udpTunnelListeners, port)
+			t.Cancel()
+		}
 	}()
 	for {
 		resp, err := status.Recv()
@@ -792,26 +805,52 @@ func (b *Bastion) doTunnelPorts(ctx context.Context, ws *Workspace) error {
 			if alreadyTunneled && listener.Visibility != visibility {
 				listener.Cancel()
 				delete(ws.tunnelListeners, port.LocalPort)
+			} else if alreadyTunneled && !port.Served {
+				b.teardownUnserved(ws, port.LocalPort, listener)
+			} else if alreadyTunneled && listener.unserved != nil {
+				listener.unserved.Stop()
+				listener.unserved = nil
+			}
+			udpListener, alreadyTunneledUDP := ws.udpTunnelListeners[port.LocalPort]
+			if alreadyTunneledUDP && (udpListener.Visibility != visibility || !port.ServedUdp) {
+				udpListener.Cancel()
+				delete(ws.udpTunnelListeners, port.LocalPort)
 			}
 			if visibility == supervisor.TunnelVisiblity_none {
 				continue
 			}
 			currentTunneled[port.LocalPort] = struct{}{}
+			logprefix := "tunnel[" + supervisor.TunnelVisiblity_name[int32(port.Tunneled.Visibility)] + ":" + strconv.Itoa(int(port.LocalPort)) + "]"
+
 			_, alreadyTunneled = ws.tunnelListeners[port.LocalPort]
-			if alreadyTunneled {
-				continue
+			if !alreadyTunneled {
+				_, alreadyTunneled = port.Tunneled.Clients[b.id]
 			}
-			_, alreadyTunneled = port.Tunneled.Clients[b.id]
-			if alreadyTunneled {
-				continue
+			if port.Served && !alreadyTunneled {
+				listener, err := b.establishTunnel(ws.ctx, ws, logprefix, int(port.LocalPort), int(port.Tunneled.TargetPort), port.Tunneled.Visibility)
+				if err != nil {
+					logrus.WithError(err).WithField("workspace", ws.WorkspaceID).WithField("port", port.LocalPort).Error("cannot establish port tunnel")
+				} else {
+					ws.tunnelListeners[port.LocalPort] = listener
+				}
 			}
 
-			logprefix := "tunnel[" + supervisor.TunnelVisiblity_name[int32(port.Tunneled.Visibility)] + ":" + strconv.Itoa(int(port.LocalPort)) + "]"
-			listener, err := b.establishTunnel(ws.ctx, ws, logprefix, int(port.LocalPort), int(port.Tunneled.TargetPort), port.Tunneled.Visibility)
-			if err != nil {
-				logrus.WithError(err).WithField("workspace", ws.WorkspaceID).WithField("port", port.LocalPort).Error("cannot establish port tunnel")
-			} else {
-				ws.tunnelListeners[port.LocalPort] = listener
+			_, alreadyTunneledUDP = ws.udpTunnelListeners[port.LocalPort]
+			if port.ServedUdp && !alreadyTunneledUDP {
+				// supervisor expects a client to tunnel a port to a single local port
+				targetPort := port.Tunneled.TargetPort
+				if clientPort, ok := port.Tunneled.Clients[b.id]; ok {
+					targetPort = clientPort
+				}
+				if listener, ok := ws.tunnelListeners[port.LocalPort]; ok {
+					targetPort = listener.LocalPort
+				}
+				listener, err := b.establishUDPTunnel(ws.ctx, ws, logprefix, int(port.LocalPort), int(targetPort), port.Tunneled.Visibility)
+				if err != nil {
+					logrus.WithError(err).WithField("workspace", ws.WorkspaceID).WithField("port", port.LocalPort).Error("cannot establish udp port tunnel")
+				} else {
+					ws.udpTunnelListeners[port.LocalPort] = listener
+				}
 			}
 		}
 		for port, listener := range ws.tunnelListeners {
@@ -821,11 +860,40 @@ func (b *Bastion) doTunnelPorts(ctx context.Context, ws *Workspace) error {
 				listener.Cancel()
 			}
 		}
+		for port, listener := range ws.udpTunnelListeners {
+			_, exists := currentTunneled[port]
+			if !exists {
+				delete(ws.udpTunnelListeners, port)
+				listener.Cancel()
+			}
+		}
 		ws.tunnelMu.Unlock()
 		b.notify(ws)
 	}
 }
 
+// teardownUnserved closes the listener of a port which is no longer served unless it is served again within the grace period.
+// Callers must hold ws.tunnelMu.
+func (b *Bastion) teardownUnserved(ws *Workspace, localPort uint32, listener *TunnelListener) {
+	if listener.unserved != nil {
+		return
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(unservedTunnelGracePeriod, func() {
+		ws.tunnelMu.Lock()
+		current, ok := ws.tunnelListeners[localPort]
+		if !ok || current != listener || listener.unserved != timer {
+			ws.tunnelMu.Unlock()
+			return
+		}
+		delete(ws.tunnelListeners, localPort)
+		listener.Cancel()
+		ws.tunnelMu.Unlock()
+		b.notify(ws)
+	})
+	listener.unserved = timer
+}
+
 func (b *Bastion) notify(ws *Workspace) {
 	b.subscriptionsMu.RLock()
 	defer b.subscriptionsMu.RUnlock()
@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package bastion
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// datagramHeaderSize is the size of the length prefix of a datagram framed over a tunnel
+	datagramHeaderSize = 2
+	// maxDatagramSize is the largest UDP payload
+	maxDatagramSize = 0xFFFF
+	// udpFlowTimeout is the time after which the tunnel of an idle UDP client is closed
+	udpFlowTimeout = 2 * time.Minute
+	// udpFlowQueueSize is the number of datagrams of a client queued for its tunnel, excess datagrams are dropped
+	udpFlowQueueSize = 64
+)
+
+// udpFlow tunnels the datagrams of a single UDP client
+type udpFlow struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// datagrams are queued until they can be written to the tunnel
+	datagrams chan []byte
+
+	mu         sync.Mutex
+	lastActive time.Time
+}
+
+func (f *udpFlow) touch() {
+	f.mu.Lock()
+	f.lastActive = time.Now()
+	f.mu.Unlock()
+}
+
+func (f *udpFlow) idle() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return time.Since(f.lastActive) > udpFlowTimeout
+}
+
+// establishUDPTunnel forwards datagrams received on a local UDP port to a port served over UDP in the workspace.
+// Each local client gets its own tunnel, since the workspace replies on the connection it received a datagram from.
+func (b *Bastion) establishUDPTunnel(ctx context.Context, ws *Workspace, logprefix string, remotePort int, targetPort int, visibility supervisor.TunnelVisiblity) (*TunnelListener, error) {
+	if !ws.tunnelClientConnected {
+		return nil, xerrors.Errorf("tunnel client is not connected")
+	}
+	if visibility == supervisor.TunnelVisiblity_none {
+		return nil, xerrors.Errorf("tunnel visibility is none")
+	}
+
+	targetHost := "127.0.0.1"
+	if visibility == supervisor.TunnelVisiblity_network {
+		targetHost = "0.0.0.0"
+	}
+
+	conn, err := net.ListenPacket("udp", targetHost+":"+strconv.Itoa(targetPort))
+	if err != nil {
+		conn, err = net.ListenPacket("udp", targetHost+":0")
+		if err != nil {
+			return nil, err
+		}
+	}
+	localPort := conn.LocalAddr().(*net.UDPAddr).Port
+	logrus.WithField("workspace", ws.WorkspaceID).Info(logprefix + ": listening on udp " + conn.LocalAddr().String() + "...")
+
+	var (
+		listenerCtx, cancel = context.WithCancel(ctx)
+		mu                  sync.Mutex
+		flows               = make(map[string]*udpFlow)
+	)
+	closeFlow := func(addr string, flow *udpFlow) {
+		mu.Lock()
+		if flows[addr] == flow {
+			delete(flows, addr)
+		}
+		mu.Unlock()
+		flow.cancel()
+	}
+	go func() {
+		t := time.NewTicker(udpFlowTimeout / 4)
+		defer t.Stop()
+		for {
+			select {
+			case <-listenerCtx.Done():
+				conn.Close()
+				mu.Lock()
+				for addr, flow := range flows {
+					delete(flows, addr)
+					flow.cancel()
+				}
+				mu.Unlock()
+				logrus.WithField("workspace", ws.WorkspaceID).Info(logprefix + ": closed")
+				return
+			case <-t.C:
+				mu.Lock()
+				for addr, flow := range flows {
+					if flow.idle() {
+						delete(flows, addr)
+						flow.cancel()
+					}
+				}
+				mu.Unlock()
+			}
+		}
+	}()
+
+	openChannel := func(ctx context.Context) (ssh.Channel, error) {
+		clientCh := make(chan *TunnelClient, 1)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case ws.tunnelClient <- clientCh:
+		}
+		var client *TunnelClient
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case client = <-clientCh:
+		}
+
+		payload, err := proto.Marshal(&supervisor.TunnelPortRequest{
+			ClientId:   client.ID,
+			Port:       uint32(remotePort),
+			TargetPort: uint32(localPort),
+			Transport:  supervisor.PortTransport_udp,
+		})
+		if err != nil {
+			return nil, xerrors.Errorf("cannot marshal tunnel payload: %w", err)
+		}
+		sshChan, reqs, err := client.Conn.OpenChannel("tunnel", payload)
+		if err != nil {
+			return nil, err
+		}
+		go ssh.DiscardRequests(reqs)
+		return sshChan, nil
+	}
+
+	// serveFlow opens the tunnel of a client and forwards its datagrams in both directions,
+	// such that a slow tunnel does not hold up the datagrams of other clients.
+	serveFlow := func(addr net.Addr, flow *udpFlow) {
+		defer closeFlow(addr.String(), flow)
+
+		sshChan, err := openChannel(flow.ctx)
+		if err != nil {
+			if flow.ctx.Err() == nil {
+				logrus.WithError(err).WithField("workspace", ws.WorkspaceID).WithField("client", addr.String()).Warn(logprefix + ": failed to establish udp tunnel")
+			}
+			return
+		}
+		defer sshChan.Close()
+		logrus.WithField("workspace", ws.WorkspaceID).WithField("client", addr.String()).Debug(logprefix + ": established udp tunnel")
+
+		go func() {
+			defer closeFlow(addr.String(), flow)
+
+			buf := make([]byte, datagramHeaderSize+maxDatagramSize)
+			for {
+				_, err := io.ReadFull(sshChan, buf[:datagramHeaderSize])
+				if err != nil {
+					return
+				}
+				size := int(binary.BigEndian.Uint16(buf))
+				_, err = io.ReadFull(sshChan, buf[datagramHeaderSize:datagramHeaderSize+size])
+				if err != nil {
+					return
+				}
+				flow.touch()
+				_, err = conn.WriteTo(buf[datagramHeaderSize:datagramHeaderSize+size], addr)
+				if err != nil && listenerCtx.Err() == nil {
+					logrus.WithError(err).WithField("workspace", ws.WorkspaceID).WithField("client", addr.String()).Debug(logprefix + ": failed to send datagram")
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-flow.ctx.Done():
+				return
+			case datagram := <-flow.datagrams:
+				_, err := sshChan.Write(datagram)
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if listenerCtx.Err() != nil {
+				return
+			}
+			if err != nil {
+				logrus.WithError(err).WithField("workspace", ws.WorkspaceID).Warn(logprefix + ": failed to receive datagram")
+				continue
+			}
+
+			mu.Lock()
+			flow, exists := flows[addr.String()]
+			if !exists {
+				flowCtx, flowCancel := context.WithCancel(listenerCtx)
+				flow = &udpFlow{
+					ctx:        flowCtx,
+					cancel:     flowCancel,
+					datagrams:  make(chan []byte, udpFlowQueueSize),
+					lastActive: time.Now(),
+				}
+				flows[addr.String()] = flow
+			}
+			mu.Unlock()
+			if !exists {
+				go serveFlow(addr, flow)
+			}
+
+			flow.touch()
+			datagram := make([]byte, datagramHeaderSize+n)
+			binary.BigEndian.PutUint16(datagram, uint16(n))
+			copy(datagram[datagramHeaderSize:], buf[:n])
+			select {
+			case flow.datagrams <- datagram:
+			default:
+				logrus.WithField("workspace", ws.WorkspaceID).WithField("client", addr.String()).Debug(logprefix + ": dropped datagram, udp tunnel is busy")
+			}
+		}
+	}()
+
+	return &TunnelListener{
+		RemotePort: uint32(remotePort),
+		LocalAddr:  conn.LocalAddr().String(),
+		LocalPort:  uint32(localPort),
+		Visibility: visibility,
+		Ctx:        listenerCtx,
+		Cancel:     cancel,
+	}, nil
+}
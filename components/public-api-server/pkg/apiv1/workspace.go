@@ -6,6 +6,7 @@ package apiv1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"path/filepath"
@@ -13,23 +14,33 @@ import (
 	connect "github.com/bufbuild/connect-go"
 	"github.com/gitpod-io/gitpod/common-go/experiments"
 	"github.com/gitpod-io/gitpod/common-go/log"
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
 	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
 	"github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1/v1connect"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	protocol "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/proxy"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
-func NewWorkspaceService(serverConnPool proxy.ServerConnectionPool, expClient experiments.Client) *WorkspaceService {
+// NewWorkspaceService creates a new workspace service. contentService may be nil, in which case workspace content export is unavailable.
+func NewWorkspaceService(serverConnPool proxy.ServerConnectionPool, expClient experiments.Client, contentService csapi.WorkspaceServiceClient, dbConn *gorm.DB) *WorkspaceService {
 	return &WorkspaceService{
 		connectionPool: serverConnPool,
 		expClient:      expClient,
+		contentService: contentService,
+		dbConn:         dbConn,
 	}
 }
 
 type WorkspaceService struct {
 	connectionPool proxy.ServerConnectionPool
 	expClient      experiments.Client
+	contentService csapi.WorkspaceServiceClient
+	dbConn         *gorm.DB
 
 	v1connect.UnimplementedWorkspacesServiceHandler
 }
@@ -387,6 +398,110 @@ func (s *WorkspaceService) GetDefaultWorkspaceImage(ctx context.Context, req *co
 	}), nil
 }
 
+func (s *WorkspaceService) ExportWorkspaceContent(ctx context.Context, req *connect.Request[v1.ExportWorkspaceContentRequest]) (*connect.Response[v1.ExportWorkspaceContentResponse], error) {
+	workspaceID, err := validateWorkspaceID(ctx, req.Msg.GetWorkspaceId())
+	if err != nil {
+		return nil, err
+	}
+	if s.contentService == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("workspace content export is not available"))
+	}
+	if req.Msg.GetBackupId() != "" && req.Msg.GetSnapshotId() != "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("backup_id and snapshot_id are mutually exclusive"))
+	}
+	var snapshotID uuid.UUID
+	if req.Msg.GetSnapshotId() != "" {
+		snapshotID, err = uuid.Parse(req.Msg.GetSnapshotId())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("snapshot_id must be a valid UUID"))
+		}
+	}
+
+	var format csapi.WorkspaceExportFormat
+	switch req.Msg.GetFormat() {
+	case v1.ExportWorkspaceContentRequest_FORMAT_UNSPECIFIED, v1.ExportWorkspaceContentRequest_FORMAT_TAR:
+		format = csapi.WorkspaceExportFormat_EXPORT_FORMAT_TAR
+	case v1.ExportWorkspaceContentRequest_FORMAT_ZIP:
+		format = csapi.WorkspaceExportFormat_EXPORT_FORMAT_ZIP
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported format: %s", req.Msg.GetFormat()))
+	}
+
+	conn, err := getConnection(ctx, s.connectionPool)
+	if err != nil {
+		return nil, err
+	}
+
+	// Getting the workspace through server makes sure the user has access to it
+	ws, err := conn.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to get workspace.")
+		return nil, proxy.ConvertError(err)
+	}
+	if ws.Workspace == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace %s not found", workspaceID))
+	}
+	if ws.Workspace.ContentDeletedTime != "" {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("the content of workspace %s has been deleted", workspaceID))
+	}
+	if ws.LatestInstance != nil && ws.LatestInstance.Status != nil && ws.LatestInstance.Status.Phase != "stopped" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("workspace %s must be stopped to export its content", workspaceID))
+	}
+
+	var snapshot string
+	if snapshotID != uuid.Nil {
+		snapshot, err = s.getSnapshotName(ctx, workspaceID, snapshotID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.contentService.ExportWorkspaceContent(ctx, &csapi.ExportWorkspaceContentRequest{
+		OwnerId:          ws.Workspace.OwnerID,
+		WorkspaceId:      workspaceID,
+		BackupId:         req.Msg.GetBackupId(),
+		Snapshot:         snapshot,
+		Format:           format,
+		ExcludeGit:       req.Msg.GetExcludeGit(),
+		RespectGitignore: req.Msg.GetRespectGitignore(),
+	})
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to export workspace content.")
+		// gRPC and connect share their status codes
+		st := status.Convert(err)
+		return nil, connect.NewError(connect.Code(st.Code()), fmt.Errorf("%s", st.Message()))
+	}
+
+	return connect.NewResponse(&v1.ExportWorkspaceContentResponse{
+		Url:     resp.GetUrl(),
+		Expires: resp.GetExpires(),
+	}), nil
+}
+
+// getSnapshotName returns the name under which a snapshot of the workspace is stored
+func (s *WorkspaceService) getSnapshotName(ctx context.Context, workspaceID string, snapshotID uuid.UUID) (string, error) {
+	if s.dbConn == nil {
+		return "", connect.NewError(connect.CodeUnimplemented, fmt.Errorf("exporting snapshots is not available"))
+	}
+
+	snapshot, err := db.GetSnapshot(ctx, s.dbConn, snapshotID)
+	if errors.Is(err, db.ErrorNotFound) {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("snapshot %s not found", snapshotID.String()))
+	}
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to get snapshot.")
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get snapshot %s", snapshotID.String()))
+	}
+	// the user has access to the workspace, but the snapshot must be one of that workspace as well
+	if snapshot.OriginalWorkspaceID != workspaceID {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("snapshot %s not found", snapshotID.String()))
+	}
+	if snapshot.State != "available" {
+		return "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot %s is not available", snapshotID.String()))
+	}
+	return snapshot.BucketID, nil
+}
+
 func getLimitFromPagination(pagination *v1.Pagination) (int, error) {
 	const (
 		defaultLimit = 20
@@ -15,19 +15,27 @@ import (
 
 	fuzz "github.com/AdaLogics/go-fuzz-headers"
 	connect "github.com/bufbuild/connect-go"
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	"github.com/gitpod-io/gitpod/components/gitpod-db/go/dbtest"
 	"github.com/gitpod-io/gitpod/components/public-api/go/config"
 	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
 	"github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1/v1connect"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	protocol "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/auth"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws/jwstest"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/sourcegraph/jsonrpc2"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 func TestWorkspaceService_GetWorkspace(t *testing.T) {
@@ -531,6 +539,154 @@ func TestWorkspaceService_StreamWorkspaceStatus(t *testing.T) {
 	})
 }
 
+func TestWorkspaceService_ExportWorkspaceContent(t *testing.T) {
+	workspaceID := workspaceTestData[0].Protocol.Workspace.ID
+	ownerID := workspaceTestData[0].Protocol.Workspace.OwnerID
+	expires := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	stopped := func() *protocol.WorkspaceInfo {
+		ws := *workspaceTestData[0].Protocol.Workspace
+		instance := *workspaceTestData[0].Protocol.LatestInstance
+		status := *instance.Status
+		status.Phase = "stopped"
+		instance.Status = &status
+		return &protocol.WorkspaceInfo{Workspace: &ws, LatestInstance: &instance}
+	}
+
+	setup := func(t *testing.T, contentService csapi.WorkspaceServiceClient, dbConn *gorm.DB) (*protocol.MockAPIInterface, v1connect.WorkspacesServiceClient) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		serverMock := protocol.NewMockAPIInterface(ctrl)
+		svc := NewWorkspaceService(&FakeServerConnPool{api: serverMock}, nil, contentService, dbConn)
+		return serverMock, newWorkspacesServiceClient(t, svc)
+	}
+
+	t.Run("invalid argument when workspace ID does not validate", func(t *testing.T) {
+		_, client := setup(t, &fakeContentService{}, nil)
+
+		_, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId: "some-random-not-valid-workspace-id",
+		}))
+		requireErrorCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("unimplemented without content service", func(t *testing.T) {
+		_, client := setup(t, nil, nil)
+
+		_, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId: workspaceID,
+		}))
+		requireErrorCode(t, connect.CodeUnimplemented, err)
+	})
+
+	t.Run("failed precondition when workspace is running", func(t *testing.T) {
+		serverMock, client := setup(t, &fakeContentService{}, nil)
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(&workspaceTestData[0].Protocol, nil)
+
+		_, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId: workspaceID,
+		}))
+		requireErrorCode(t, connect.CodeFailedPrecondition, err)
+	})
+
+	t.Run("not found when backup does not exist", func(t *testing.T) {
+		serverMock, client := setup(t, &fakeContentService{Err: grpcstatus.Error(codes.NotFound, "no such backup")}, nil)
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(stopped(), nil)
+
+		_, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId: workspaceID,
+			BackupId:    "20230501T120000Z",
+		}))
+		requireErrorCode(t, connect.CodeNotFound, err)
+	})
+
+	t.Run("invalid argument when backup and snapshot are selected", func(t *testing.T) {
+		_, client := setup(t, &fakeContentService{}, nil)
+
+		_, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId: workspaceID,
+			BackupId:    "20230501T120000Z",
+			SnapshotId:  uuid.New().String(),
+		}))
+		requireErrorCode(t, connect.CodeInvalidArgument, err)
+	})
+
+	t.Run("not found when snapshot belongs to another workspace", func(t *testing.T) {
+		dbConn := dbtest.ConnectForTests(t)
+		snapshot := dbtest.CreateSnapshots(t, dbConn, db.Snapshot{})[0]
+
+		serverMock, client := setup(t, &fakeContentService{}, dbConn)
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(stopped(), nil)
+
+		_, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId: workspaceID,
+			SnapshotId:  snapshot.ID.String(),
+		}))
+		requireErrorCode(t, connect.CodeNotFound, err)
+	})
+
+	t.Run("exports snapshot of the workspace", func(t *testing.T) {
+		dbConn := dbtest.ConnectForTests(t)
+		snapshot := dbtest.CreateSnapshots(t, dbConn, db.Snapshot{OriginalWorkspaceID: workspaceID})[0]
+
+		contentService := &fakeContentService{Resp: &csapi.ExportWorkspaceContentResponse{}}
+		serverMock, client := setup(t, contentService, dbConn)
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(stopped(), nil)
+
+		_, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId: workspaceID,
+			SnapshotId:  snapshot.ID.String(),
+		}))
+		require.NoError(t, err)
+		require.Equal(t, snapshot.BucketID, contentService.Req.GetSnapshot())
+	})
+
+	t.Run("delegates to content service", func(t *testing.T) {
+		contentService := &fakeContentService{Resp: &csapi.ExportWorkspaceContentResponse{
+			Url:     "https://content-service/export/owner/workspace",
+			Expires: timestamppb.New(expires),
+		}}
+		serverMock, client := setup(t, contentService, nil)
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(stopped(), nil)
+
+		resp, err := client.ExportWorkspaceContent(context.Background(), connect.NewRequest(&v1.ExportWorkspaceContentRequest{
+			WorkspaceId:      workspaceID,
+			BackupId:         "20230501T120000Z",
+			Format:           v1.ExportWorkspaceContentRequest_FORMAT_ZIP,
+			ExcludeGit:       true,
+			RespectGitignore: true,
+		}))
+		require.NoError(t, err)
+
+		requireEqualProto(t, &v1.ExportWorkspaceContentResponse{
+			Url:     "https://content-service/export/owner/workspace",
+			Expires: timestamppb.New(expires),
+		}, resp.Msg)
+		requireEqualProto(t, &csapi.ExportWorkspaceContentRequest{
+			OwnerId:          ownerID,
+			WorkspaceId:      workspaceID,
+			BackupId:         "20230501T120000Z",
+			Format:           csapi.WorkspaceExportFormat_EXPORT_FORMAT_ZIP,
+			ExcludeGit:       true,
+			RespectGitignore: true,
+		}, contentService.Req)
+	})
+}
+
+type fakeContentService struct {
+	csapi.WorkspaceServiceClient
+
+	Req  *csapi.ExportWorkspaceContentRequest
+	Resp *csapi.ExportWorkspaceContentResponse
+	Err  error
+}
+
+func (f *fakeContentService) ExportWorkspaceContent(ctx context.Context, in *csapi.ExportWorkspaceContentRequest, opts ...grpc.CallOption) (*csapi.ExportWorkspaceContentResponse, error) {
+	f.Req = in
+	return f.Resp, f.Err
+}
+
 func TestClientServerStreamInterceptor(t *testing.T) {
 	testInterceptor := &TestInterceptor{
 		expectedToken: "auth-token",
@@ -544,7 +700,7 @@ func TestClientServerStreamInterceptor(t *testing.T) {
 
 	svc := NewWorkspaceService(&FakeServerConnPool{
 		api: serverMock,
-	}, nil)
+	}, nil, nil, nil)
 
 	keyset := jwstest.GenerateKeySet(t)
 	rsa256, err := jws.NewRSA256(keyset)
@@ -813,7 +969,13 @@ func setupWorkspacesService(t *testing.T) (*protocol.MockAPIInterface, v1connect
 
 	svc := NewWorkspaceService(&FakeServerConnPool{
 		api: serverMock,
-	}, nil)
+	}, nil, nil, nil)
+
+	return serverMock, newWorkspacesServiceClient(t, svc)
+}
+
+func newWorkspacesServiceClient(t *testing.T, svc *WorkspaceService) v1connect.WorkspacesServiceClient {
+	t.Helper()
 
 	keyset := jwstest.GenerateKeySet(t)
 	rsa256, err := jws.NewRSA256(keyset)
@@ -829,11 +991,9 @@ func setupWorkspacesService(t *testing.T) (*protocol.MockAPIInterface, v1connect
 	srv := httptest.NewServer(handler)
 	t.Cleanup(srv.Close)
 
-	client := v1connect.NewWorkspacesServiceClient(http.DefaultClient, srv.URL, connect.WithInterceptors(
+	return v1connect.NewWorkspacesServiceClient(http.DefaultClient, srv.URL, connect.WithInterceptors(
 		auth.NewClientInterceptor("auth-token"),
 	))
-
-	return serverMock, client
 }
 
 type FakeServerConnPool struct {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/public-api-server/middleware"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/apiv1"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/auth"
@@ -39,6 +40,8 @@ import (
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/proxy"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/webhooks"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func Start(logger *logrus.Entry, version string, cfg *config.Configuration) error {
@@ -93,6 +96,17 @@ func Start(logger *logrus.Entry, version string, cfg *config.Configuration) erro
 		}
 	}
 
+	var contentService csapi.WorkspaceServiceClient
+	if cfg.ContentServiceAddress != "" {
+		conn, err := grpc.Dial(cfg.ContentServiceAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("failed to dial content service gRPC server: %w", err)
+		}
+		contentService = csapi.NewWorkspaceServiceClient(conn)
+	} else {
+		log.Info("No content service address is configured, workspace content export will be disabled.")
+	}
+
 	keyset, err := jws.NewKeySetFromAuthPKI(cfg.Auth.PKI)
 	if err != nil {
 		return fmt.Errorf("failed to setup JWS Keyset: %w", err)
@@ -141,6 +155,7 @@ func Start(logger *logrus.Entry, version string, cfg *config.Configuration) erro
 	if registerErr := register(srv, &registerDependencies{
 		connPool:        connPool,
 		expClient:       expClient,
+		contentService:  contentService,
 		dbConn:          dbConn,
 		signer:          signer,
 		cipher:          cipherSet,
@@ -160,13 +175,14 @@ func Start(logger *logrus.Entry, version string, cfg *config.Configuration) erro
 }
 
 type registerDependencies struct {
-	connPool    proxy.ServerConnectionPool
-	expClient   experiments.Client
-	dbConn      *gorm.DB
-	signer      auth.Signer
-	cipher      db.Cipher
-	oidcService *oidc.Service
-	idpService  *identityprovider.Service
+	connPool       proxy.ServerConnectionPool
+	expClient      experiments.Client
+	contentService csapi.WorkspaceServiceClient
+	dbConn         *gorm.DB
+	signer         auth.Signer
+	cipher         db.Cipher
+	oidcService    *oidc.Service
+	idpService     *identityprovider.Service
 
 	sessionVerifier jws.SignerVerifier
 	authCfg         config.AuthConfiguration
@@ -195,7 +211,7 @@ func register(srv *baseserver.Server, deps *registerDependencies) error {
 		),
 	}
 
-	rootHandler.Mount(v1connect.NewWorkspacesServiceHandler(apiv1.NewWorkspaceService(deps.connPool, deps.expClient), handlerOptions...))
+	rootHandler.Mount(v1connect.NewWorkspacesServiceHandler(apiv1.NewWorkspaceService(deps.connPool, deps.expClient, deps.contentService, deps.dbConn), handlerOptions...))
 	rootHandler.Mount(v1connect.NewTeamsServiceHandler(apiv1.NewTeamsService(deps.connPool), handlerOptions...))
 	rootHandler.Mount(v1connect.NewUserServiceHandler(apiv1.NewUserService(deps.connPool), handlerOptions...))
 	rootHandler.Mount(v1connect.NewSCMServiceHandler(apiv1.NewSCMService(deps.connPool), handlerOptions...))
@@ -16,6 +16,9 @@ type Configuration struct {
 
 	BillingServiceAddress string `json:"billingServiceAddress,omitempty"`
 
+	// ContentServiceAddress is the gRPC address of content-service. If empty, workspace content export is unavailable.
+	ContentServiceAddress string `json:"contentServiceAddress,omitempty"`
+
 	// Address to use for creating new sessions
 	SessionServiceAddress string `json:"sessionServiceAddress"`
 
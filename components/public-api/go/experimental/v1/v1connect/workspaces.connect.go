@@ -58,6 +58,12 @@ type WorkspacesServiceClient interface {
 	ListWorkspaceClasses(context.Context, *connect_go.Request[v1.ListWorkspaceClassesRequest]) (*connect_go.Response[v1.ListWorkspaceClassesResponse], error)
 	// GetDefaultWorkspaceImage returns the default workspace image from different sources.
 	GetDefaultWorkspaceImage(context.Context, *connect_go.Request[v1.GetDefaultWorkspaceImageRequest]) (*connect_go.Response[v1.GetDefaultWorkspaceImageResponse], error)
+	// ExportWorkspaceContent returns a time-limited URL from where the content of a stopped workspace can be downloaded as an archive.
+	// Errors:
+	//
+	//	NOT_FOUND:           the workspace_id is unkown, or the workspace has no such backup
+	//	FAILED_PRECONDITION: if the workspace is running
+	ExportWorkspaceContent(context.Context, *connect_go.Request[v1.ExportWorkspaceContentRequest]) (*connect_go.Response[v1.ExportWorkspaceContentResponse], error)
 }
 
 // NewWorkspacesServiceClient constructs a client for the gitpod.experimental.v1.WorkspacesService
@@ -125,6 +131,11 @@ func NewWorkspacesServiceClient(httpClient connect_go.HTTPClient, baseURL string
 			baseURL+"/gitpod.experimental.v1.WorkspacesService/GetDefaultWorkspaceImage",
 			opts...,
 		),
+		exportWorkspaceContent: connect_go.NewClient[v1.ExportWorkspaceContentRequest, v1.ExportWorkspaceContentResponse](
+			httpClient,
+			baseURL+"/gitpod.experimental.v1.WorkspacesService/ExportWorkspaceContent",
+			opts...,
+		),
 	}
 }
 
@@ -141,6 +152,7 @@ type workspacesServiceClient struct {
 	updatePort               *connect_go.Client[v1.UpdatePortRequest, v1.UpdatePortResponse]
 	listWorkspaceClasses     *connect_go.Client[v1.ListWorkspaceClassesRequest, v1.ListWorkspaceClassesResponse]
 	getDefaultWorkspaceImage *connect_go.Client[v1.GetDefaultWorkspaceImageRequest, v1.GetDefaultWorkspaceImageResponse]
+	exportWorkspaceContent   *connect_go.Client[v1.ExportWorkspaceContentRequest, v1.ExportWorkspaceContentResponse]
 }
 
 // ListWorkspaces calls gitpod.experimental.v1.WorkspacesService.ListWorkspaces.
@@ -198,6 +210,11 @@ func (c *workspacesServiceClient) GetDefaultWorkspaceImage(ctx context.Context,
 	return c.getDefaultWorkspaceImage.CallUnary(ctx, req)
 }
 
+// ExportWorkspaceContent calls gitpod.experimental.v1.WorkspacesService.ExportWorkspaceContent.
+func (c *workspacesServiceClient) ExportWorkspaceContent(ctx context.Context, req *connect_go.Request[v1.ExportWorkspaceContentRequest]) (*connect_go.Response[v1.ExportWorkspaceContentResponse], error) {
+	return c.exportWorkspaceContent.CallUnary(ctx, req)
+}
+
 // WorkspacesServiceHandler is an implementation of the gitpod.experimental.v1.WorkspacesService
 // service.
 type WorkspacesServiceHandler interface {
@@ -228,6 +245,12 @@ type WorkspacesServiceHandler interface {
 	ListWorkspaceClasses(context.Context, *connect_go.Request[v1.ListWorkspaceClassesRequest]) (*connect_go.Response[v1.ListWorkspaceClassesResponse], error)
 	// GetDefaultWorkspaceImage returns the default workspace image from different sources.
 	GetDefaultWorkspaceImage(context.Context, *connect_go.Request[v1.GetDefaultWorkspaceImageRequest]) (*connect_go.Response[v1.GetDefaultWorkspaceImageResponse], error)
+	// ExportWorkspaceContent returns a time-limited URL from where the content of a stopped workspace can be downloaded as an archive.
+	// Errors:
+	//
+	//	NOT_FOUND:           the workspace_id is unkown, or the workspace has no such backup
+	//	FAILED_PRECONDITION: if the workspace is running
+	ExportWorkspaceContent(context.Context, *connect_go.Request[v1.ExportWorkspaceContentRequest]) (*connect_go.Response[v1.ExportWorkspaceContentResponse], error)
 }
 
 // NewWorkspacesServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -292,6 +315,11 @@ func NewWorkspacesServiceHandler(svc WorkspacesServiceHandler, opts ...connect_g
 		svc.GetDefaultWorkspaceImage,
 		opts...,
 	))
+	mux.Handle("/gitpod.experimental.v1.WorkspacesService/ExportWorkspaceContent", connect_go.NewUnaryHandler(
+		"/gitpod.experimental.v1.WorkspacesService/ExportWorkspaceContent",
+		svc.ExportWorkspaceContent,
+		opts...,
+	))
 	return "/gitpod.experimental.v1.WorkspacesService/", mux
 }
 
@@ -341,3 +369,7 @@ func (UnimplementedWorkspacesServiceHandler) ListWorkspaceClasses(context.Contex
 func (UnimplementedWorkspacesServiceHandler) GetDefaultWorkspaceImage(context.Context, *connect_go.Request[v1.GetDefaultWorkspaceImageRequest]) (*connect_go.Response[v1.GetDefaultWorkspaceImageResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("gitpod.experimental.v1.WorkspacesService.GetDefaultWorkspaceImage is not implemented"))
 }
+
+func (UnimplementedWorkspacesServiceHandler) ExportWorkspaceContent(context.Context, *connect_go.Request[v1.ExportWorkspaceContentRequest]) (*connect_go.Response[v1.ExportWorkspaceContentResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("gitpod.experimental.v1.WorkspacesService.ExportWorkspaceContent is not implemented"))
+}
@@ -118,3 +118,13 @@ func (s *ProxyWorkspacesServiceHandler) GetDefaultWorkspaceImage(ctx context.Con
 
 	return connect_go.NewResponse(resp), nil
 }
+
+func (s *ProxyWorkspacesServiceHandler) ExportWorkspaceContent(ctx context.Context, req *connect_go.Request[v1.ExportWorkspaceContentRequest]) (*connect_go.Response[v1.ExportWorkspaceContentResponse], error) {
+	resp, err := s.Client.ExportWorkspaceContent(ctx, req.Msg)
+	if err != nil {
+		// TODO(milan): Convert to correct status code
+		return nil, err
+	}
+
+	return connect_go.NewResponse(resp), nil
+}
@@ -53,6 +53,12 @@ type WorkspacesServiceClient interface {
 	ListWorkspaceClasses(ctx context.Context, in *ListWorkspaceClassesRequest, opts ...grpc.CallOption) (*ListWorkspaceClassesResponse, error)
 	// GetDefaultWorkspaceImage returns the default workspace image from different sources.
 	GetDefaultWorkspaceImage(ctx context.Context, in *GetDefaultWorkspaceImageRequest, opts ...grpc.CallOption) (*GetDefaultWorkspaceImageResponse, error)
+	// ExportWorkspaceContent returns a time-limited URL from where the content of a stopped workspace can be downloaded as an archive.
+	// Errors:
+	//
+	//	NOT_FOUND:           the workspace_id is unkown, or the workspace has no such backup
+	//	FAILED_PRECONDITION: if the workspace is running
+	ExportWorkspaceContent(ctx context.Context, in *ExportWorkspaceContentRequest, opts ...grpc.CallOption) (*ExportWorkspaceContentResponse, error)
 }
 
 type workspacesServiceClient struct {
@@ -185,6 +191,15 @@ func (c *workspacesServiceClient) GetDefaultWorkspaceImage(ctx context.Context,
 	return out, nil
 }
 
+func (c *workspacesServiceClient) ExportWorkspaceContent(ctx context.Context, in *ExportWorkspaceContentRequest, opts ...grpc.CallOption) (*ExportWorkspaceContentResponse, error) {
+	out := new(ExportWorkspaceContentResponse)
+	err := c.cc.Invoke(ctx, "/gitpod.experimental.v1.WorkspacesService/ExportWorkspaceContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspacesServiceServer is the server API for WorkspacesService service.
 // All implementations must embed UnimplementedWorkspacesServiceServer
 // for forward compatibility
@@ -216,6 +231,12 @@ type WorkspacesServiceServer interface {
 	ListWorkspaceClasses(context.Context, *ListWorkspaceClassesRequest) (*ListWorkspaceClassesResponse, error)
 	// GetDefaultWorkspaceImage returns the default workspace image from different sources.
 	GetDefaultWorkspaceImage(context.Context, *GetDefaultWorkspaceImageRequest) (*GetDefaultWorkspaceImageResponse, error)
+	// ExportWorkspaceContent returns a time-limited URL from where the content of a stopped workspace can be downloaded as an archive.
+	// Errors:
+	//
+	//	NOT_FOUND:           the workspace_id is unkown, or the workspace has no such backup
+	//	FAILED_PRECONDITION: if the workspace is running
+	ExportWorkspaceContent(context.Context, *ExportWorkspaceContentRequest) (*ExportWorkspaceContentResponse, error)
 	mustEmbedUnimplementedWorkspacesServiceServer()
 }
 
@@ -256,6 +277,9 @@ func (UnimplementedWorkspacesServiceServer) ListWorkspaceClasses(context.Context
 func (UnimplementedWorkspacesServiceServer) GetDefaultWorkspaceImage(context.Context, *GetDefaultWorkspaceImageRequest) (*GetDefaultWorkspaceImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultWorkspaceImage not implemented")
 }
+func (UnimplementedWorkspacesServiceServer) ExportWorkspaceContent(context.Context, *ExportWorkspaceContentRequest) (*ExportWorkspaceContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkspaceContent not implemented")
+}
 func (UnimplementedWorkspacesServiceServer) mustEmbedUnimplementedWorkspacesServiceServer() {}
 
 // UnsafeWorkspacesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -470,6 +494,24 @@ func _WorkspacesService_GetDefaultWorkspaceImage_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspacesService_ExportWorkspaceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkspaceContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspacesServiceServer).ExportWorkspaceContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitpod.experimental.v1.WorkspacesService/ExportWorkspaceContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspacesServiceServer).ExportWorkspaceContent(ctx, req.(*ExportWorkspaceContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspacesService_ServiceDesc is the grpc.ServiceDesc for WorkspacesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +559,10 @@ var WorkspacesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDefaultWorkspaceImage",
 			Handler:    _WorkspacesService_GetDefaultWorkspaceImage_Handler,
 		},
+		{
+			MethodName: "ExportWorkspaceContent",
+			Handler:    _WorkspacesService_ExportWorkspaceContent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
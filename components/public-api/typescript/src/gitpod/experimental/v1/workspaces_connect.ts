@@ -9,7 +9,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateAndStartWorkspaceRequest, CreateAndStartWorkspaceResponse, DeleteWorkspaceRequest, DeleteWorkspaceResponse, GetDefaultWorkspaceImageRequest, GetDefaultWorkspaceImageResponse, GetOwnerTokenRequest, GetOwnerTokenResponse, GetWorkspaceRequest, GetWorkspaceResponse, ListWorkspaceClassesRequest, ListWorkspaceClassesResponse, ListWorkspacesRequest, ListWorkspacesResponse, StartWorkspaceRequest, StartWorkspaceResponse, StopWorkspaceRequest, StopWorkspaceResponse, StreamWorkspaceStatusRequest, StreamWorkspaceStatusResponse, UpdatePortRequest, UpdatePortResponse } from "./workspaces_pb.js";
+import { CreateAndStartWorkspaceRequest, CreateAndStartWorkspaceResponse, DeleteWorkspaceRequest, DeleteWorkspaceResponse, ExportWorkspaceContentRequest, ExportWorkspaceContentResponse, GetDefaultWorkspaceImageRequest, GetDefaultWorkspaceImageResponse, GetOwnerTokenRequest, GetOwnerTokenResponse, GetWorkspaceRequest, GetWorkspaceResponse, ListWorkspaceClassesRequest, ListWorkspaceClassesResponse, ListWorkspacesRequest, ListWorkspacesResponse, StartWorkspaceRequest, StartWorkspaceResponse, StopWorkspaceRequest, StopWorkspaceResponse, StreamWorkspaceStatusRequest, StreamWorkspaceStatusResponse, UpdatePortRequest, UpdatePortResponse } from "./workspaces_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -142,5 +142,19 @@ export const WorkspacesService = {
       O: GetDefaultWorkspaceImageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ExportWorkspaceContent returns a time-limited URL from where the content of a stopped workspace can be downloaded as an archive.
+     * Errors:
+     *   NOT_FOUND:           the workspace_id is unkown, or the workspace has no such backup
+     *   FAILED_PRECONDITION: if the workspace is running
+     *
+     * @generated from rpc gitpod.experimental.v1.WorkspacesService.ExportWorkspaceContent
+     */
+    exportWorkspaceContent: {
+      name: "ExportWorkspaceContent",
+      I: ExportWorkspaceContentRequest,
+      O: ExportWorkspaceContentResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
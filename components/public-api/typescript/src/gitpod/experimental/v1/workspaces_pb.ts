@@ -2162,3 +2162,157 @@ proto3.util.setEnumType(GetDefaultWorkspaceImageResponse_ImageSource, "gitpod.ex
   { no: 1, name: "IMAGE_SOURCE_INSTALLATION" },
   { no: 2, name: "IMAGE_SOURCE_ORGANIZATION" },
 ]);
+
+/**
+ * @generated from message gitpod.experimental.v1.ExportWorkspaceContentRequest
+ */
+export class ExportWorkspaceContentRequest extends Message<ExportWorkspaceContentRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * backup_id selects an older backup of the workspace. If neither backup_id nor snapshot_id are set, the latest backup is exported.
+   *
+   * @generated from field: string backup_id = 2;
+   */
+  backupId = "";
+
+  /**
+   * format is the archive format. Defaults to FORMAT_TAR.
+   *
+   * @generated from field: gitpod.experimental.v1.ExportWorkspaceContentRequest.Format format = 3;
+   */
+  format = ExportWorkspaceContentRequest_Format.UNSPECIFIED;
+
+  /**
+   * exclude_git leaves out all .git directories and files
+   *
+   * @generated from field: bool exclude_git = 4;
+   */
+  excludeGit = false;
+
+  /**
+   * respect_gitignore leaves out all paths ignored by the workspace's .gitignore files
+   *
+   * @generated from field: bool respect_gitignore = 5;
+   */
+  respectGitignore = false;
+
+  /**
+   * snapshot_id selects a snapshot of the workspace instead of a backup. Mutually exclusive with backup_id.
+   *
+   * @generated from field: string snapshot_id = 6;
+   */
+  snapshotId = "";
+
+  constructor(data?: PartialMessage<ExportWorkspaceContentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.experimental.v1.ExportWorkspaceContentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "backup_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "format", kind: "enum", T: proto3.getEnumType(ExportWorkspaceContentRequest_Format) },
+    { no: 4, name: "exclude_git", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "respect_gitignore", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportWorkspaceContentRequest {
+    return new ExportWorkspaceContentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportWorkspaceContentRequest {
+    return new ExportWorkspaceContentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportWorkspaceContentRequest {
+    return new ExportWorkspaceContentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportWorkspaceContentRequest | PlainMessage<ExportWorkspaceContentRequest> | undefined, b: ExportWorkspaceContentRequest | PlainMessage<ExportWorkspaceContentRequest> | undefined): boolean {
+    return proto3.util.equals(ExportWorkspaceContentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from enum gitpod.experimental.v1.ExportWorkspaceContentRequest.Format
+ */
+export enum ExportWorkspaceContentRequest_Format {
+  /**
+   * @generated from enum value: FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * FORMAT_TAR produces an uncompressed tar archive
+   *
+   * @generated from enum value: FORMAT_TAR = 1;
+   */
+  TAR = 1,
+
+  /**
+   * FORMAT_ZIP produces a zip archive
+   *
+   * @generated from enum value: FORMAT_ZIP = 2;
+   */
+  ZIP = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ExportWorkspaceContentRequest_Format)
+proto3.util.setEnumType(ExportWorkspaceContentRequest_Format, "gitpod.experimental.v1.ExportWorkspaceContentRequest.Format", [
+  { no: 0, name: "FORMAT_UNSPECIFIED" },
+  { no: 1, name: "FORMAT_TAR" },
+  { no: 2, name: "FORMAT_ZIP" },
+]);
+
+/**
+ * @generated from message gitpod.experimental.v1.ExportWorkspaceContentResponse
+ */
+export class ExportWorkspaceContentResponse extends Message<ExportWorkspaceContentResponse> {
+  /**
+   * url is the URL from where the archive can be downloaded
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * expires is the time until which the url is valid
+   *
+   * @generated from field: google.protobuf.Timestamp expires = 2;
+   */
+  expires?: Timestamp;
+
+  constructor(data?: PartialMessage<ExportWorkspaceContentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.experimental.v1.ExportWorkspaceContentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "expires", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportWorkspaceContentResponse {
+    return new ExportWorkspaceContentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportWorkspaceContentResponse {
+    return new ExportWorkspaceContentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportWorkspaceContentResponse {
+    return new ExportWorkspaceContentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportWorkspaceContentResponse | PlainMessage<ExportWorkspaceContentResponse> | undefined, b: ExportWorkspaceContentResponse | PlainMessage<ExportWorkspaceContentResponse> | undefined): boolean {
+    return proto3.util.equals(ExportWorkspaceContentResponse, a, b);
+  }
+}
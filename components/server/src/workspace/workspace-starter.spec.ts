@@ -8,7 +8,8 @@ import "reflect-metadata";
 
 import { suite, test } from "@testdeck/mocha";
 import * as chai from "chai";
-import { isClusterMaintenanceError, isResourceExhaustedError } from "./workspace-starter";
+import { Workspace } from "@gitpod/gitpod-protocol";
+import { createWorkspaceMetadata, isClusterMaintenanceError, isResourceExhaustedError } from "./workspace-starter";
 const expect = chai.expect;
 
 @suite
@@ -44,6 +45,24 @@ class TestWorkspaceStarter {
         const result = isResourceExhaustedError(err);
         expect(result).to.be.true;
     }
+
+    @test
+    public testMetadataTenant() {
+        const workspace = {
+            id: "ws-123",
+            ownerId: "user-123",
+            organizationId: "org-123",
+        } as Workspace;
+
+        const metadata = createWorkspaceMetadata(workspace);
+        expect(metadata.getTenant()).to.equal("org-123");
+        expect(metadata.hasProject()).to.be.false;
+
+        const projectMetadata = createWorkspaceMetadata({ ...workspace, projectId: "project-123" });
+        expect(projectMetadata.getTenant()).to.equal("org-123");
+        expect(projectMetadata.getProject()).to.equal("project-123");
+        expect(projectMetadata.getTeam()).to.equal("org-123");
+    }
 }
 
 module.exports = new TestWorkspaceStarter();
@@ -10,6 +10,8 @@ import {
     GitAuthMethod,
     GitConfig,
     GitInitializer,
+    GitLFSConfig,
+    PartialCloneFilter,
     PrebuildInitializer,
     SnapshotInitializer,
     WorkspaceInitializer,
@@ -32,6 +34,7 @@ import {
     Disposable,
     DisposableCollection,
     GitCheckoutInfo,
+    GitCloneConfig,
     GitpodServer,
     GitpodToken,
     GitpodTokenType,
@@ -198,6 +201,22 @@ export function isClusterMaintenanceError(err: any): boolean {
     );
 }
 
+export function createWorkspaceMetadata(workspace: Workspace): WorkspaceMetadata {
+    const metadata = new WorkspaceMetadata();
+    metadata.setOwner(workspace.ownerId);
+    metadata.setMetaId(workspace.id);
+    if (workspace.organizationId) {
+        // the organization is the tenant of the workspace, regardless of whether it belongs to a project
+        metadata.setTenant(workspace.organizationId);
+    }
+    if (workspace.projectId) {
+        metadata.setProject(workspace.projectId);
+        metadata.setTeam(workspace.organizationId);
+    }
+
+    return metadata;
+}
+
 @injectable()
 export class WorkspaceStarter {
     static readonly STARTING_PHASES: WorkspaceInstancePhase[] = ["preparing", "building", "pending"];
@@ -555,7 +574,7 @@ export class WorkspaceStarter {
             const spec = await this.createSpec({ span }, user, workspace, instance, envVars);
 
             // create start workspace request
-            const metadata = await this.createMetadata(workspace);
+            const metadata = createWorkspaceMetadata(workspace);
             const startRequest = new StartWorkspaceRequest();
             startRequest.setId(instance.id);
             startRequest.setMetadata(metadata);
@@ -675,18 +694,6 @@ export class WorkspaceStarter {
         ctx.span?.setTag("failedInstanceStartReason", reason);
     }
 
-    private async createMetadata(workspace: Workspace): Promise<WorkspaceMetadata> {
-        const metadata = new WorkspaceMetadata();
-        metadata.setOwner(workspace.ownerId);
-        metadata.setMetaId(workspace.id);
-        if (workspace.projectId) {
-            metadata.setProject(workspace.projectId);
-            metadata.setTeam(workspace.organizationId);
-        }
-
-        return metadata;
-    }
-
     private async tryStartOnCluster(
         ctx: TraceContext,
         startRequest: StartWorkspaceRequest,
@@ -1757,7 +1764,8 @@ export class WorkspaceStarter {
     ): Promise<{ initializer: GitInitializer | CompositeInitializer }> {
         const span = TraceContext.startSpan("createInitializerForCommit", ctx);
         try {
-            const mainGit = this.createGitInitializer({ span }, workspace, context, user);
+            // the clone config of .gitpod.yml only applies to the repository it was read from
+            const mainGit = this.createGitInitializer({ span }, workspace, context, user, workspace.config.gitClone);
             if (!context.additionalRepositoryCheckoutInfo || context.additionalRepositoryCheckoutInfo.length === 0) {
                 return mainGit;
             }
@@ -1788,6 +1796,7 @@ export class WorkspaceStarter {
         workspace: Workspace,
         context: GitCheckoutInfo,
         user: User,
+        cloneConfig?: GitCloneConfig,
     ): Promise<{ initializer: GitInitializer }> {
         const host = context.repository.host;
         const hostContext = this.hostContextProvider.get(host);
@@ -1853,6 +1862,25 @@ export class WorkspaceStarter {
         if (!!context.upstreamRemoteURI) {
             result.setUpstreamRemoteUri(context.upstreamRemoteURI);
         }
+        switch (cloneConfig?.filter) {
+            case "blob:none":
+                result.setCloneFilter(PartialCloneFilter.BLOBLESS);
+                break;
+            case "tree:0":
+                result.setCloneFilter(PartialCloneFilter.TREELESS);
+                break;
+        }
+        if (!!cloneConfig?.sparseCheckout && cloneConfig.sparseCheckout.length > 0) {
+            result.setSparseCheckoutList(cloneConfig.sparseCheckout);
+        }
+        if (!!cloneConfig?.lfs) {
+            const lfs = new GitLFSConfig();
+            if (typeof cloneConfig.lfs === "object") {
+                lfs.setIncludeList(cloneConfig.lfs.include || []);
+                lfs.setExcludeList(cloneConfig.lfs.exclude || []);
+            }
+            result.setLfs(lfs);
+        }
 
         return {
             initializer: result,
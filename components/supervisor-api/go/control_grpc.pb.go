@@ -32,6 +32,8 @@ type ControlServiceClient interface {
 	CreateSSHKeyPair(ctx context.Context, in *CreateSSHKeyPairRequest, opts ...grpc.CallOption) (*CreateSSHKeyPairResponse, error)
 	// CreateDebugEnv creates a debug workspace envs
 	CreateDebugEnv(ctx context.Context, in *CreateDebugEnvRequest, opts ...grpc.CallOption) (*CreateDebugEnvResponse, error)
+	// RestartTask terminates the command of a task if it is still running and runs it again in the same terminal
+	RestartTask(ctx context.Context, in *RestartTaskRequest, opts ...grpc.CallOption) (*RestartTaskResponse, error)
 }
 
 type controlServiceClient struct {
@@ -69,6 +71,15 @@ func (c *controlServiceClient) CreateDebugEnv(ctx context.Context, in *CreateDeb
 	return out, nil
 }
 
+func (c *controlServiceClient) RestartTask(ctx context.Context, in *RestartTaskRequest, opts ...grpc.CallOption) (*RestartTaskResponse, error) {
+	out := new(RestartTaskResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.ControlService/RestartTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControlServiceServer is the server API for ControlService service.
 // All implementations must embed UnimplementedControlServiceServer
 // for forward compatibility
@@ -79,6 +90,8 @@ type ControlServiceServer interface {
 	CreateSSHKeyPair(context.Context, *CreateSSHKeyPairRequest) (*CreateSSHKeyPairResponse, error)
 	// CreateDebugEnv creates a debug workspace envs
 	CreateDebugEnv(context.Context, *CreateDebugEnvRequest) (*CreateDebugEnvResponse, error)
+	// RestartTask terminates the command of a task if it is still running and runs it again in the same terminal
+	RestartTask(context.Context, *RestartTaskRequest) (*RestartTaskResponse, error)
 	mustEmbedUnimplementedControlServiceServer()
 }
 
@@ -95,6 +108,9 @@ func (UnimplementedControlServiceServer) CreateSSHKeyPair(context.Context, *Crea
 func (UnimplementedControlServiceServer) CreateDebugEnv(context.Context, *CreateDebugEnvRequest) (*CreateDebugEnvResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDebugEnv not implemented")
 }
+func (UnimplementedControlServiceServer) RestartTask(context.Context, *RestartTaskRequest) (*RestartTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartTask not implemented")
+}
 func (UnimplementedControlServiceServer) mustEmbedUnimplementedControlServiceServer() {}
 
 // UnsafeControlServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +178,24 @@ func _ControlService_CreateDebugEnv_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlService_RestartTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).RestartTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.ControlService/RestartTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).RestartTask(ctx, req.(*RestartTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ControlService_ServiceDesc is the grpc.ServiceDesc for ControlService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +215,10 @@ var ControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDebugEnv",
 			Handler:    _ControlService_CreateDebugEnv_Handler,
 		},
+		{
+			MethodName: "RestartTask",
+			Handler:    _ControlService_RestartTask_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "control.proto",
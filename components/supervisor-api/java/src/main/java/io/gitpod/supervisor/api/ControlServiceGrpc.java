@@ -115,6 +115,37 @@ public final class ControlServiceGrpc {
     return getCreateDebugEnvMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Control.RestartTaskRequest,
+      io.gitpod.supervisor.api.Control.RestartTaskResponse> getRestartTaskMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "RestartTask",
+      requestType = io.gitpod.supervisor.api.Control.RestartTaskRequest.class,
+      responseType = io.gitpod.supervisor.api.Control.RestartTaskResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Control.RestartTaskRequest,
+      io.gitpod.supervisor.api.Control.RestartTaskResponse> getRestartTaskMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Control.RestartTaskRequest, io.gitpod.supervisor.api.Control.RestartTaskResponse> getRestartTaskMethod;
+    if ((getRestartTaskMethod = ControlServiceGrpc.getRestartTaskMethod) == null) {
+      synchronized (ControlServiceGrpc.class) {
+        if ((getRestartTaskMethod = ControlServiceGrpc.getRestartTaskMethod) == null) {
+          ControlServiceGrpc.getRestartTaskMethod = getRestartTaskMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Control.RestartTaskRequest, io.gitpod.supervisor.api.Control.RestartTaskResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "RestartTask"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Control.RestartTaskRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Control.RestartTaskResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ControlServiceMethodDescriptorSupplier("RestartTask"))
+              .build();
+        }
+      }
+    }
+    return getRestartTaskMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -196,6 +227,16 @@ public final class ControlServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getCreateDebugEnvMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * RestartTask terminates the command of a task if it is still running and runs it again in the same terminal
+     * </pre>
+     */
+    public void restartTask(io.gitpod.supervisor.api.Control.RestartTaskRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.RestartTaskResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getRestartTaskMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -219,6 +260,13 @@ public final class ControlServiceGrpc {
                 io.gitpod.supervisor.api.Control.CreateDebugEnvRequest,
                 io.gitpod.supervisor.api.Control.CreateDebugEnvResponse>(
                   this, METHODID_CREATE_DEBUG_ENV)))
+          .addMethod(
+            getRestartTaskMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Control.RestartTaskRequest,
+                io.gitpod.supervisor.api.Control.RestartTaskResponse>(
+                  this, METHODID_RESTART_TASK)))
           .build();
     }
   }
@@ -272,6 +320,17 @@ public final class ControlServiceGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getCreateDebugEnvMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     * <pre>
+     * RestartTask terminates the command of a task if it is still running and runs it again in the same terminal
+     * </pre>
+     */
+    public void restartTask(io.gitpod.supervisor.api.Control.RestartTaskRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.RestartTaskResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getRestartTaskMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -320,6 +379,16 @@ public final class ControlServiceGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getCreateDebugEnvMethod(), getCallOptions(), request);
     }
+
+    /**
+     * <pre>
+     * RestartTask terminates the command of a task if it is still running and runs it again in the same terminal
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Control.RestartTaskResponse restartTask(io.gitpod.supervisor.api.Control.RestartTaskRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getRestartTaskMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -371,11 +440,23 @@ public final class ControlServiceGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getCreateDebugEnvMethod(), getCallOptions()), request);
     }
+
+    /**
+     * <pre>
+     * RestartTask terminates the command of a task if it is still running and runs it again in the same terminal
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Control.RestartTaskResponse> restartTask(
+        io.gitpod.supervisor.api.Control.RestartTaskRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getRestartTaskMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_EXPOSE_PORT = 0;
   private static final int METHODID_CREATE_SSHKEY_PAIR = 1;
   private static final int METHODID_CREATE_DEBUG_ENV = 2;
+  private static final int METHODID_RESTART_TASK = 3;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -406,6 +487,10 @@ public final class ControlServiceGrpc {
           serviceImpl.createDebugEnv((io.gitpod.supervisor.api.Control.CreateDebugEnvRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.CreateDebugEnvResponse>) responseObserver);
           break;
+        case METHODID_RESTART_TASK:
+          serviceImpl.restartTask((io.gitpod.supervisor.api.Control.RestartTaskRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.RestartTaskResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -470,6 +555,7 @@ public final class ControlServiceGrpc {
               .addMethod(getExposePortMethod())
               .addMethod(getCreateSSHKeyPairMethod())
               .addMethod(getCreateDebugEnvMethod())
+              .addMethod(getRestartTaskMethod())
               .build();
         }
       }
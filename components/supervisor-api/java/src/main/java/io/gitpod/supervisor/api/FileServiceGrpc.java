@@ -0,0 +1,732 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package io.gitpod.supervisor.api;
+
+import static io.grpc.MethodDescriptor.generateFullMethodName;
+
+/**
+ * <pre>
+ * FileService provides access to the files of the workspace.
+ * Paths are either absolute or relative to the workspace location, and cannot point outside of it.
+ * </pre>
+ */
+@javax.annotation.Generated(
+    value = "by gRPC proto compiler (version 1.49.0)",
+    comments = "Source: file.proto")
+@io.grpc.stub.annotations.GrpcGenerated
+public final class FileServiceGrpc {
+
+  private FileServiceGrpc() {}
+
+  public static final String SERVICE_NAME = "supervisor.FileService";
+
+  // Static method descriptors that strictly reflect the proto.
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.StatFileRequest,
+      io.gitpod.supervisor.api.File.StatFileResponse> getStatMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Stat",
+      requestType = io.gitpod.supervisor.api.File.StatFileRequest.class,
+      responseType = io.gitpod.supervisor.api.File.StatFileResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.StatFileRequest,
+      io.gitpod.supervisor.api.File.StatFileResponse> getStatMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.StatFileRequest, io.gitpod.supervisor.api.File.StatFileResponse> getStatMethod;
+    if ((getStatMethod = FileServiceGrpc.getStatMethod) == null) {
+      synchronized (FileServiceGrpc.class) {
+        if ((getStatMethod = FileServiceGrpc.getStatMethod) == null) {
+          FileServiceGrpc.getStatMethod = getStatMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.File.StatFileRequest, io.gitpod.supervisor.api.File.StatFileResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Stat"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.StatFileRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.StatFileResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new FileServiceMethodDescriptorSupplier("Stat"))
+              .build();
+        }
+      }
+    }
+    return getStatMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.ReadFileRequest,
+      io.gitpod.supervisor.api.File.ReadFileResponse> getReadMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Read",
+      requestType = io.gitpod.supervisor.api.File.ReadFileRequest.class,
+      responseType = io.gitpod.supervisor.api.File.ReadFileResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.ReadFileRequest,
+      io.gitpod.supervisor.api.File.ReadFileResponse> getReadMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.ReadFileRequest, io.gitpod.supervisor.api.File.ReadFileResponse> getReadMethod;
+    if ((getReadMethod = FileServiceGrpc.getReadMethod) == null) {
+      synchronized (FileServiceGrpc.class) {
+        if ((getReadMethod = FileServiceGrpc.getReadMethod) == null) {
+          FileServiceGrpc.getReadMethod = getReadMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.File.ReadFileRequest, io.gitpod.supervisor.api.File.ReadFileResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Read"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.ReadFileRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.ReadFileResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new FileServiceMethodDescriptorSupplier("Read"))
+              .build();
+        }
+      }
+    }
+    return getReadMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.WriteFileRequest,
+      io.gitpod.supervisor.api.File.WriteFileResponse> getWriteMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Write",
+      requestType = io.gitpod.supervisor.api.File.WriteFileRequest.class,
+      responseType = io.gitpod.supervisor.api.File.WriteFileResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.WriteFileRequest,
+      io.gitpod.supervisor.api.File.WriteFileResponse> getWriteMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.WriteFileRequest, io.gitpod.supervisor.api.File.WriteFileResponse> getWriteMethod;
+    if ((getWriteMethod = FileServiceGrpc.getWriteMethod) == null) {
+      synchronized (FileServiceGrpc.class) {
+        if ((getWriteMethod = FileServiceGrpc.getWriteMethod) == null) {
+          FileServiceGrpc.getWriteMethod = getWriteMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.File.WriteFileRequest, io.gitpod.supervisor.api.File.WriteFileResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Write"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.WriteFileRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.WriteFileResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new FileServiceMethodDescriptorSupplier("Write"))
+              .build();
+        }
+      }
+    }
+    return getWriteMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.ListFilesRequest,
+      io.gitpod.supervisor.api.File.ListFilesResponse> getListMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "List",
+      requestType = io.gitpod.supervisor.api.File.ListFilesRequest.class,
+      responseType = io.gitpod.supervisor.api.File.ListFilesResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.ListFilesRequest,
+      io.gitpod.supervisor.api.File.ListFilesResponse> getListMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.ListFilesRequest, io.gitpod.supervisor.api.File.ListFilesResponse> getListMethod;
+    if ((getListMethod = FileServiceGrpc.getListMethod) == null) {
+      synchronized (FileServiceGrpc.class) {
+        if ((getListMethod = FileServiceGrpc.getListMethod) == null) {
+          FileServiceGrpc.getListMethod = getListMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.File.ListFilesRequest, io.gitpod.supervisor.api.File.ListFilesResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "List"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.ListFilesRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.ListFilesResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new FileServiceMethodDescriptorSupplier("List"))
+              .build();
+        }
+      }
+    }
+    return getListMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.DeleteFileRequest,
+      io.gitpod.supervisor.api.File.DeleteFileResponse> getDeleteMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Delete",
+      requestType = io.gitpod.supervisor.api.File.DeleteFileRequest.class,
+      responseType = io.gitpod.supervisor.api.File.DeleteFileResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.DeleteFileRequest,
+      io.gitpod.supervisor.api.File.DeleteFileResponse> getDeleteMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.DeleteFileRequest, io.gitpod.supervisor.api.File.DeleteFileResponse> getDeleteMethod;
+    if ((getDeleteMethod = FileServiceGrpc.getDeleteMethod) == null) {
+      synchronized (FileServiceGrpc.class) {
+        if ((getDeleteMethod = FileServiceGrpc.getDeleteMethod) == null) {
+          FileServiceGrpc.getDeleteMethod = getDeleteMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.File.DeleteFileRequest, io.gitpod.supervisor.api.File.DeleteFileResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Delete"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.DeleteFileRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.DeleteFileResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new FileServiceMethodDescriptorSupplier("Delete"))
+              .build();
+        }
+      }
+    }
+    return getDeleteMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.WatchFilesRequest,
+      io.gitpod.supervisor.api.File.WatchFilesResponse> getWatchMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Watch",
+      requestType = io.gitpod.supervisor.api.File.WatchFilesRequest.class,
+      responseType = io.gitpod.supervisor.api.File.WatchFilesResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.WatchFilesRequest,
+      io.gitpod.supervisor.api.File.WatchFilesResponse> getWatchMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.File.WatchFilesRequest, io.gitpod.supervisor.api.File.WatchFilesResponse> getWatchMethod;
+    if ((getWatchMethod = FileServiceGrpc.getWatchMethod) == null) {
+      synchronized (FileServiceGrpc.class) {
+        if ((getWatchMethod = FileServiceGrpc.getWatchMethod) == null) {
+          FileServiceGrpc.getWatchMethod = getWatchMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.File.WatchFilesRequest, io.gitpod.supervisor.api.File.WatchFilesResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Watch"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.WatchFilesRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.File.WatchFilesResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new FileServiceMethodDescriptorSupplier("Watch"))
+              .build();
+        }
+      }
+    }
+    return getWatchMethod;
+  }
+
+  /**
+   * Creates a new async stub that supports all call types for the service
+   */
+  public static FileServiceStub newStub(io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<FileServiceStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<FileServiceStub>() {
+        @java.lang.Override
+        public FileServiceStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new FileServiceStub(channel, callOptions);
+        }
+      };
+    return FileServiceStub.newStub(factory, channel);
+  }
+
+  /**
+   * Creates a new blocking-style stub that supports unary and streaming output calls on the service
+   */
+  public static FileServiceBlockingStub newBlockingStub(
+      io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<FileServiceBlockingStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<FileServiceBlockingStub>() {
+        @java.lang.Override
+        public FileServiceBlockingStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new FileServiceBlockingStub(channel, callOptions);
+        }
+      };
+    return FileServiceBlockingStub.newStub(factory, channel);
+  }
+
+  /**
+   * Creates a new ListenableFuture-style stub that supports unary calls on the service
+   */
+  public static FileServiceFutureStub newFutureStub(
+      io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<FileServiceFutureStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<FileServiceFutureStub>() {
+        @java.lang.Override
+        public FileServiceFutureStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new FileServiceFutureStub(channel, callOptions);
+        }
+      };
+    return FileServiceFutureStub.newStub(factory, channel);
+  }
+
+  /**
+   * <pre>
+   * FileService provides access to the files of the workspace.
+   * Paths are either absolute or relative to the workspace location, and cannot point outside of it.
+   * </pre>
+   */
+  public static abstract class FileServiceImplBase implements io.grpc.BindableService {
+
+    /**
+     * <pre>
+     * Stat returns information about a file or directory
+     * </pre>
+     */
+    public void stat(io.gitpod.supervisor.api.File.StatFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.StatFileResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getStatMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Read reads the content of a file, or a range of it
+     * </pre>
+     */
+    public void read(io.gitpod.supervisor.api.File.ReadFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.ReadFileResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getReadMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Write replaces the content of a file atomically, i.e. readers never observe partially written content
+     * </pre>
+     */
+    public void write(io.gitpod.supervisor.api.File.WriteFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.WriteFileResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getWriteMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * List lists the entries of a directory
+     * </pre>
+     */
+    public void list(io.gitpod.supervisor.api.File.ListFilesRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.ListFilesResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Delete deletes a file or directory
+     * </pre>
+     */
+    public void delete(io.gitpod.supervisor.api.File.DeleteFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.DeleteFileResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getDeleteMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Watch streams the changes of the entries of a directory
+     * </pre>
+     */
+    public void watch(io.gitpod.supervisor.api.File.WatchFilesRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.WatchFilesResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getWatchMethod(), responseObserver);
+    }
+
+    @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
+      return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
+          .addMethod(
+            getStatMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.File.StatFileRequest,
+                io.gitpod.supervisor.api.File.StatFileResponse>(
+                  this, METHODID_STAT)))
+          .addMethod(
+            getReadMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.File.ReadFileRequest,
+                io.gitpod.supervisor.api.File.ReadFileResponse>(
+                  this, METHODID_READ)))
+          .addMethod(
+            getWriteMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.File.WriteFileRequest,
+                io.gitpod.supervisor.api.File.WriteFileResponse>(
+                  this, METHODID_WRITE)))
+          .addMethod(
+            getListMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.File.ListFilesRequest,
+                io.gitpod.supervisor.api.File.ListFilesResponse>(
+                  this, METHODID_LIST)))
+          .addMethod(
+            getDeleteMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.File.DeleteFileRequest,
+                io.gitpod.supervisor.api.File.DeleteFileResponse>(
+                  this, METHODID_DELETE)))
+          .addMethod(
+            getWatchMethod(),
+            io.grpc.stub.ServerCalls.asyncServerStreamingCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.File.WatchFilesRequest,
+                io.gitpod.supervisor.api.File.WatchFilesResponse>(
+                  this, METHODID_WATCH)))
+          .build();
+    }
+  }
+
+  /**
+   * <pre>
+   * FileService provides access to the files of the workspace.
+   * Paths are either absolute or relative to the workspace location, and cannot point outside of it.
+   * </pre>
+   */
+  public static final class FileServiceStub extends io.grpc.stub.AbstractAsyncStub<FileServiceStub> {
+    private FileServiceStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected FileServiceStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new FileServiceStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * Stat returns information about a file or directory
+     * </pre>
+     */
+    public void stat(io.gitpod.supervisor.api.File.StatFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.StatFileResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getStatMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Read reads the content of a file, or a range of it
+     * </pre>
+     */
+    public void read(io.gitpod.supervisor.api.File.ReadFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.ReadFileResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getReadMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Write replaces the content of a file atomically, i.e. readers never observe partially written content
+     * </pre>
+     */
+    public void write(io.gitpod.supervisor.api.File.WriteFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.WriteFileResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getWriteMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * List lists the entries of a directory
+     * </pre>
+     */
+    public void list(io.gitpod.supervisor.api.File.ListFilesRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.ListFilesResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getListMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Delete deletes a file or directory
+     * </pre>
+     */
+    public void delete(io.gitpod.supervisor.api.File.DeleteFileRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.DeleteFileResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getDeleteMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Watch streams the changes of the entries of a directory
+     * </pre>
+     */
+    public void watch(io.gitpod.supervisor.api.File.WatchFilesRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.WatchFilesResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncServerStreamingCall(
+          getChannel().newCall(getWatchMethod(), getCallOptions()), request, responseObserver);
+    }
+  }
+
+  /**
+   * <pre>
+   * FileService provides access to the files of the workspace.
+   * Paths are either absolute or relative to the workspace location, and cannot point outside of it.
+   * </pre>
+   */
+  public static final class FileServiceBlockingStub extends io.grpc.stub.AbstractBlockingStub<FileServiceBlockingStub> {
+    private FileServiceBlockingStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected FileServiceBlockingStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new FileServiceBlockingStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * Stat returns information about a file or directory
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.File.StatFileResponse stat(io.gitpod.supervisor.api.File.StatFileRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getStatMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * Read reads the content of a file, or a range of it
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.File.ReadFileResponse read(io.gitpod.supervisor.api.File.ReadFileRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getReadMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * Write replaces the content of a file atomically, i.e. readers never observe partially written content
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.File.WriteFileResponse write(io.gitpod.supervisor.api.File.WriteFileRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getWriteMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * List lists the entries of a directory
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.File.ListFilesResponse list(io.gitpod.supervisor.api.File.ListFilesRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getListMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * Delete deletes a file or directory
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.File.DeleteFileResponse delete(io.gitpod.supervisor.api.File.DeleteFileRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getDeleteMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * Watch streams the changes of the entries of a directory
+     * </pre>
+     */
+    public java.util.Iterator<io.gitpod.supervisor.api.File.WatchFilesResponse> watch(
+        io.gitpod.supervisor.api.File.WatchFilesRequest request) {
+      return io.grpc.stub.ClientCalls.blockingServerStreamingCall(
+          getChannel(), getWatchMethod(), getCallOptions(), request);
+    }
+  }
+
+  /**
+   * <pre>
+   * FileService provides access to the files of the workspace.
+   * Paths are either absolute or relative to the workspace location, and cannot point outside of it.
+   * </pre>
+   */
+  public static final class FileServiceFutureStub extends io.grpc.stub.AbstractFutureStub<FileServiceFutureStub> {
+    private FileServiceFutureStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected FileServiceFutureStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new FileServiceFutureStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * Stat returns information about a file or directory
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.File.StatFileResponse> stat(
+        io.gitpod.supervisor.api.File.StatFileRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getStatMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * Read reads the content of a file, or a range of it
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.File.ReadFileResponse> read(
+        io.gitpod.supervisor.api.File.ReadFileRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getReadMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * Write replaces the content of a file atomically, i.e. readers never observe partially written content
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.File.WriteFileResponse> write(
+        io.gitpod.supervisor.api.File.WriteFileRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getWriteMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * List lists the entries of a directory
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.File.ListFilesResponse> list(
+        io.gitpod.supervisor.api.File.ListFilesRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getListMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * Delete deletes a file or directory
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.File.DeleteFileResponse> delete(
+        io.gitpod.supervisor.api.File.DeleteFileRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getDeleteMethod(), getCallOptions()), request);
+    }
+  }
+
+  private static final int METHODID_STAT = 0;
+  private static final int METHODID_READ = 1;
+  private static final int METHODID_WRITE = 2;
+  private static final int METHODID_LIST = 3;
+  private static final int METHODID_DELETE = 4;
+  private static final int METHODID_WATCH = 5;
+
+  private static final class MethodHandlers<Req, Resp> implements
+      io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.ServerStreamingMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.ClientStreamingMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.BidiStreamingMethod<Req, Resp> {
+    private final FileServiceImplBase serviceImpl;
+    private final int methodId;
+
+    MethodHandlers(FileServiceImplBase serviceImpl, int methodId) {
+      this.serviceImpl = serviceImpl;
+      this.methodId = methodId;
+    }
+
+    @java.lang.Override
+    @java.lang.SuppressWarnings("unchecked")
+    public void invoke(Req request, io.grpc.stub.StreamObserver<Resp> responseObserver) {
+      switch (methodId) {
+        case METHODID_STAT:
+          serviceImpl.stat((io.gitpod.supervisor.api.File.StatFileRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.StatFileResponse>) responseObserver);
+          break;
+        case METHODID_READ:
+          serviceImpl.read((io.gitpod.supervisor.api.File.ReadFileRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.ReadFileResponse>) responseObserver);
+          break;
+        case METHODID_WRITE:
+          serviceImpl.write((io.gitpod.supervisor.api.File.WriteFileRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.WriteFileResponse>) responseObserver);
+          break;
+        case METHODID_LIST:
+          serviceImpl.list((io.gitpod.supervisor.api.File.ListFilesRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.ListFilesResponse>) responseObserver);
+          break;
+        case METHODID_DELETE:
+          serviceImpl.delete((io.gitpod.supervisor.api.File.DeleteFileRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.DeleteFileResponse>) responseObserver);
+          break;
+        case METHODID_WATCH:
+          serviceImpl.watch((io.gitpod.supervisor.api.File.WatchFilesRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.File.WatchFilesResponse>) responseObserver);
+          break;
+        default:
+          throw new AssertionError();
+      }
+    }
+
+    @java.lang.Override
+    @java.lang.SuppressWarnings("unchecked")
+    public io.grpc.stub.StreamObserver<Req> invoke(
+        io.grpc.stub.StreamObserver<Resp> responseObserver) {
+      switch (methodId) {
+        default:
+          throw new AssertionError();
+      }
+    }
+  }
+
+  private static abstract class FileServiceBaseDescriptorSupplier
+      implements io.grpc.protobuf.ProtoFileDescriptorSupplier, io.grpc.protobuf.ProtoServiceDescriptorSupplier {
+    FileServiceBaseDescriptorSupplier() {}
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.FileDescriptor getFileDescriptor() {
+      return io.gitpod.supervisor.api.File.getDescriptor();
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.ServiceDescriptor getServiceDescriptor() {
+      return getFileDescriptor().findServiceByName("FileService");
+    }
+  }
+
+  private static final class FileServiceFileDescriptorSupplier
+      extends FileServiceBaseDescriptorSupplier {
+    FileServiceFileDescriptorSupplier() {}
+  }
+
+  private static final class FileServiceMethodDescriptorSupplier
+      extends FileServiceBaseDescriptorSupplier
+      implements io.grpc.protobuf.ProtoMethodDescriptorSupplier {
+    private final String methodName;
+
+    FileServiceMethodDescriptorSupplier(String methodName) {
+      this.methodName = methodName;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.MethodDescriptor getMethodDescriptor() {
+      return getServiceDescriptor().findMethodByName(methodName);
+    }
+  }
+
+  private static volatile io.grpc.ServiceDescriptor serviceDescriptor;
+
+  public static io.grpc.ServiceDescriptor getServiceDescriptor() {
+    io.grpc.ServiceDescriptor result = serviceDescriptor;
+    if (result == null) {
+      synchronized (FileServiceGrpc.class) {
+        result = serviceDescriptor;
+        if (result == null) {
+          serviceDescriptor = result = io.grpc.ServiceDescriptor.newBuilder(SERVICE_NAME)
+              .setSchemaDescriptor(new FileServiceFileDescriptorSupplier())
+              .addMethod(getStatMethod())
+              .addMethod(getReadMethod())
+              .addMethod(getWriteMethod())
+              .addMethod(getListMethod())
+              .addMethod(getDeleteMethod())
+              .addMethod(getWatchMethod())
+              .build();
+        }
+      }
+    }
+    return result;
+  }
+}
@@ -135,6 +135,114 @@ public final class Port {
     // @@protoc_insertion_point(enum_scope:supervisor.TunnelVisiblity)
   }
 
+  /**
+   * Protobuf enum {@code supervisor.PortTransport}
+   */
+  public enum PortTransport
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <code>tcp = 0;</code>
+     */
+    tcp(0),
+    /**
+     * <code>udp = 1;</code>
+     */
+    udp(1),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <code>tcp = 0;</code>
+     */
+    public static final int tcp_VALUE = 0;
+    /**
+     * <code>udp = 1;</code>
+     */
+    public static final int udp_VALUE = 1;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static PortTransport valueOf(int value) {
+      return forNumber(value);
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     */
+    public static PortTransport forNumber(int value) {
+      switch (value) {
+        case 0: return tcp;
+        case 1: return udp;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<PortTransport>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        PortTransport> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<PortTransport>() {
+            public PortTransport findValueByNumber(int number) {
+              return PortTransport.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalStateException(
+            "Can't get the descriptor of an unrecognized enum value.");
+      }
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.getDescriptor().getEnumTypes().get(1);
+    }
+
+    private static final PortTransport[] VALUES = values();
+
+    public static PortTransport valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private PortTransport(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:supervisor.PortTransport)
+  }
+
   public interface TunnelPortRequestOrBuilder extends
       // @@protoc_insertion_point(interface_extends:supervisor.TunnelPortRequest)
       com.google.protobuf.MessageOrBuilder {
@@ -173,6 +281,27 @@ public final class Port {
      */
     com.google.protobuf.ByteString
         getClientIdBytes();
+
+    /**
+     * <pre>
+     * transport is the transport protocol of the tunneled connection.
+     * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+     * </pre>
+     *
+     * <code>.supervisor.PortTransport transport = 5;</code>
+     * @return The enum numeric value on the wire for transport.
+     */
+    int getTransportValue();
+    /**
+     * <pre>
+     * transport is the transport protocol of the tunneled connection.
+     * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+     * </pre>
+     *
+     * <code>.supervisor.PortTransport transport = 5;</code>
+     * @return The transport.
+     */
+    io.gitpod.supervisor.api.Port.PortTransport getTransport();
   }
   /**
    * Protobuf type {@code supervisor.TunnelPortRequest}
@@ -189,6 +318,7 @@ public final class Port {
     private TunnelPortRequest() {
       visibility_ = 0;
       clientId_ = "";
+      transport_ = 0;
     }
 
     @java.lang.Override
@@ -243,6 +373,12 @@ public final class Port {
               clientId_ = s;
               break;
             }
+            case 40: {
+              int rawValue = input.readEnum();
+
+              transport_ = rawValue;
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -356,6 +492,35 @@ public final class Port {
       }
     }
 
+    public static final int TRANSPORT_FIELD_NUMBER = 5;
+    private int transport_;
+    /**
+     * <pre>
+     * transport is the transport protocol of the tunneled connection.
+     * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+     * </pre>
+     *
+     * <code>.supervisor.PortTransport transport = 5;</code>
+     * @return The enum numeric value on the wire for transport.
+     */
+    @java.lang.Override public int getTransportValue() {
+      return transport_;
+    }
+    /**
+     * <pre>
+     * transport is the transport protocol of the tunneled connection.
+     * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+     * </pre>
+     *
+     * <code>.supervisor.PortTransport transport = 5;</code>
+     * @return The transport.
+     */
+    @java.lang.Override public io.gitpod.supervisor.api.Port.PortTransport getTransport() {
+      @SuppressWarnings("deprecation")
+      io.gitpod.supervisor.api.Port.PortTransport result = io.gitpod.supervisor.api.Port.PortTransport.valueOf(transport_);
+      return result == null ? io.gitpod.supervisor.api.Port.PortTransport.UNRECOGNIZED : result;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -382,6 +547,9 @@ public final class Port {
       if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(clientId_)) {
         com.google.protobuf.GeneratedMessageV3.writeString(output, 4, clientId_);
       }
+      if (transport_ != io.gitpod.supervisor.api.Port.PortTransport.tcp.getNumber()) {
+        output.writeEnum(5, transport_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -406,6 +574,10 @@ public final class Port {
       if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(clientId_)) {
         size += com.google.protobuf.GeneratedMessageV3.computeStringSize(4, clientId_);
       }
+      if (transport_ != io.gitpod.supervisor.api.Port.PortTransport.tcp.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(5, transport_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -428,6 +600,7 @@ public final class Port {
       if (visibility_ != other.visibility_) return false;
       if (!getClientId()
           .equals(other.getClientId())) return false;
+      if (transport_ != other.transport_) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -447,6 +620,8 @@ public final class Port {
       hash = (53 * hash) + visibility_;
       hash = (37 * hash) + CLIENT_ID_FIELD_NUMBER;
       hash = (53 * hash) + getClientId().hashCode();
+      hash = (37 * hash) + TRANSPORT_FIELD_NUMBER;
+      hash = (53 * hash) + transport_;
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -588,6 +763,8 @@ public final class Port {
 
         clientId_ = "";
 
+        transport_ = 0;
+
         return this;
       }
 
@@ -618,6 +795,7 @@ public final class Port {
         result.targetPort_ = targetPort_;
         result.visibility_ = visibility_;
         result.clientId_ = clientId_;
+        result.transport_ = transport_;
         onBuilt();
         return result;
       }
@@ -679,6 +857,9 @@ public final class Port {
           clientId_ = other.clientId_;
           onChanged();
         }
+        if (other.transport_ != 0) {
+          setTransportValue(other.getTransportValue());
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -899,6 +1080,85 @@ public final class Port {
         onChanged();
         return this;
       }
+
+      private int transport_ = 0;
+      /**
+       * <pre>
+       * transport is the transport protocol of the tunneled connection.
+       * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+       * </pre>
+       *
+       * <code>.supervisor.PortTransport transport = 5;</code>
+       * @return The enum numeric value on the wire for transport.
+       */
+      @java.lang.Override public int getTransportValue() {
+        return transport_;
+      }
+      /**
+       * <pre>
+       * transport is the transport protocol of the tunneled connection.
+       * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+       * </pre>
+       *
+       * <code>.supervisor.PortTransport transport = 5;</code>
+       * @param value The enum numeric value on the wire for transport to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTransportValue(int value) {
+
+        transport_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * transport is the transport protocol of the tunneled connection.
+       * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+       * </pre>
+       *
+       * <code>.supervisor.PortTransport transport = 5;</code>
+       * @return The transport.
+       */
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.PortTransport getTransport() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Port.PortTransport result = io.gitpod.supervisor.api.Port.PortTransport.valueOf(transport_);
+        return result == null ? io.gitpod.supervisor.api.Port.PortTransport.UNRECOGNIZED : result;
+      }
+      /**
+       * <pre>
+       * transport is the transport protocol of the tunneled connection.
+       * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+       * </pre>
+       *
+       * <code>.supervisor.PortTransport transport = 5;</code>
+       * @param value The transport to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTransport(io.gitpod.supervisor.api.Port.PortTransport value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+
+        transport_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * transport is the transport protocol of the tunneled connection.
+       * UDP datagrams are framed over the tunnel stream, each prefixed by its length as a big endian uint16.
+       * </pre>
+       *
+       * <code>.supervisor.PortTransport transport = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTransport() {
+
+        transport_ = 0;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -5485,37 +5745,39 @@ public final class Port {
   static {
     java.lang.String[] descriptorData = {
       "\n\nport.proto\022\nsupervisor\032\034google/api/ann" +
-      "otations.proto\"z\n\021TunnelPortRequest\022\014\n\004p" +
-      "ort\030\001 \001(\r\022\023\n\013target_port\030\002 \001(\r\022/\n\nvisibi" +
-      "lity\030\003 \001(\0162\033.supervisor.TunnelVisiblity\022" +
-      "\021\n\tclient_id\030\004 \001(\t\"\024\n\022TunnelPortResponse" +
-      "\"\"\n\022CloseTunnelRequest\022\014\n\004port\030\001 \001(\r\"\025\n\023" +
-      "CloseTunnelResponse\"a\n\026EstablishTunnelRe" +
-      "quest\022-\n\004desc\030\001 \001(\0132\035.supervisor.TunnelP" +
-      "ortRequestH\000\022\016\n\004data\030\002 \001(\014H\000B\010\n\006output\"\'" +
-      "\n\027EstablishTunnelResponse\022\014\n\004data\030\001 \001(\014\"" +
-      "$\n\021AutoTunnelRequest\022\017\n\007enabled\030\001 \001(\010\"\024\n" +
-      "\022AutoTunnelResponse\"&\n\026RetryAutoExposeRe" +
-      "quest\022\014\n\004port\030\001 \001(\r\"\031\n\027RetryAutoExposeRe" +
-      "sponse*2\n\017TunnelVisiblity\022\010\n\004none\020\000\022\010\n\004h" +
-      "ost\020\001\022\013\n\007network\020\0022\310\004\n\013PortService\022j\n\006Tu" +
-      "nnel\022\035.supervisor.TunnelPortRequest\032\036.su" +
-      "pervisor.TunnelPortResponse\"!\202\323\344\223\002\033\"\026/v1" +
-      "/port/tunnel/{port}:\001*\022n\n\013CloseTunnel\022\036." +
-      "supervisor.CloseTunnelRequest\032\037.supervis" +
-      "or.CloseTunnelResponse\"\036\202\323\344\223\002\030*\026/v1/port" +
-      "/tunnel/{port}\022^\n\017EstablishTunnel\022\".supe" +
-      "rvisor.EstablishTunnelRequest\032#.supervis" +
-      "or.EstablishTunnelResponse(\0010\001\022s\n\nAutoTu" +
-      "nnel\022\035.supervisor.AutoTunnelRequest\032\036.su" +
-      "pervisor.AutoTunnelResponse\"&\202\323\344\223\002 \"\036/v1" +
-      "/port/tunnel/auto/{enabled}\022\207\001\n\017RetryAut" +
-      "oExpose\022\".supervisor.RetryAutoExposeRequ" +
-      "est\032#.supervisor.RetryAutoExposeResponse" +
-      "\"+\202\323\344\223\002%\"#/v1/port/ports/exposed/retry/{" +
-      "port}BF\n\030io.gitpod.supervisor.apiZ*githu" +
-      "b.com/gitpod-io/gitpod/supervisor/apib\006p" +
-      "roto3"
+      "otations.proto\"\250\001\n\021TunnelPortRequest\022\014\n\004" +
+      "port\030\001 \001(\r\022\023\n\013target_port\030\002 \001(\r\022/\n\nvisib" +
+      "ility\030\003 \001(\0162\033.supervisor.TunnelVisiblity" +
+      "\022\021\n\tclient_id\030\004 \001(\t\022,\n\ttransport\030\005 \001(\0162\031" +
+      ".supervisor.PortTransport\"\024\n\022TunnelPortR" +
+      "esponse\"\"\n\022CloseTunnelRequest\022\014\n\004port\030\001 " +
+      "\001(\r\"\025\n\023CloseTunnelResponse\"a\n\026EstablishT" +
+      "unnelRequest\022-\n\004desc\030\001 \001(\0132\035.supervisor." +
+      "TunnelPortRequestH\000\022\016\n\004data\030\002 \001(\014H\000B\010\n\006o" +
+      "utput\"\'\n\027EstablishTunnelResponse\022\014\n\004data" +
+      "\030\001 \001(\014\"$\n\021AutoTunnelRequest\022\017\n\007enabled\030\001" +
+      " \001(\010\"\024\n\022AutoTunnelResponse\"&\n\026RetryAutoE" +
+      "xposeRequest\022\014\n\004port\030\001 \001(\r\"\031\n\027RetryAutoE" +
+      "xposeResponse*2\n\017TunnelVisiblity\022\010\n\004none" +
+      "\020\000\022\010\n\004host\020\001\022\013\n\007network\020\002*!\n\rPortTranspo" +
+      "rt\022\007\n\003tcp\020\000\022\007\n\003udp\020\0012\310\004\n\013PortService\022j\n\006" +
+      "Tunnel\022\035.supervisor.TunnelPortRequest\032\036." +
+      "supervisor.TunnelPortResponse\"!\202\323\344\223\002\033\"\026/" +
+      "v1/port/tunnel/{port}:\001*\022n\n\013CloseTunnel\022" +
+      "\036.supervisor.CloseTunnelRequest\032\037.superv" +
+      "isor.CloseTunnelResponse\"\036\202\323\344\223\002\030*\026/v1/po" +
+      "rt/tunnel/{port}\022^\n\017EstablishTunnel\022\".su" +
+      "pervisor.EstablishTunnelRequest\032#.superv" +
+      "isor.EstablishTunnelResponse(\0010\001\022s\n\nAuto" +
+      "Tunnel\022\035.supervisor.AutoTunnelRequest\032\036." +
+      "supervisor.AutoTunnelResponse\"&\202\323\344\223\002 \"\036/" +
+      "v1/port/tunnel/auto/{enabled}\022\207\001\n\017RetryA" +
+      "utoExpose\022\".supervisor.RetryAutoExposeRe" +
+      "quest\032#.supervisor.RetryAutoExposeRespon" +
+      "se\"+\202\323\344\223\002%\"#/v1/port/ports/exposed/retry" +
+      "/{port}BF\n\030io.gitpod.supervisor.apiZ*git" +
+      "hub.com/gitpod-io/gitpod/supervisor/apib" +
+      "\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -5527,7 +5789,7 @@ public final class Port {
     internal_static_supervisor_TunnelPortRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_TunnelPortRequest_descriptor,
-        new java.lang.String[] { "Port", "TargetPort", "Visibility", "ClientId", });
+        new java.lang.String[] { "Port", "TargetPort", "Visibility", "ClientId", "Transport", });
     internal_static_supervisor_TunnelPortResponse_descriptor =
       getDescriptor().getMessageTypes().get(1);
     internal_static_supervisor_TunnelPortResponse_fieldAccessorTable = new
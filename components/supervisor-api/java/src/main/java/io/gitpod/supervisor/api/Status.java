@@ -607,6 +607,131 @@ public final class Status {
     // @@protoc_insertion_point(enum_scope:supervisor.PortAutoExposure)
   }
 
+  /**
+   * Protobuf enum {@code supervisor.PortHealth}
+   */
+  public enum PortHealth
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <pre>
+     * unchecked means that no health check is configured for the port, or that the port isn't served
+     * </pre>
+     *
+     * <code>unchecked = 0;</code>
+     */
+    unchecked(0),
+    /**
+     * <code>healthy = 1;</code>
+     */
+    healthy(1),
+    /**
+     * <code>unhealthy = 2;</code>
+     */
+    unhealthy(2),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <pre>
+     * unchecked means that no health check is configured for the port, or that the port isn't served
+     * </pre>
+     *
+     * <code>unchecked = 0;</code>
+     */
+    public static final int unchecked_VALUE = 0;
+    /**
+     * <code>healthy = 1;</code>
+     */
+    public static final int healthy_VALUE = 1;
+    /**
+     * <code>unhealthy = 2;</code>
+     */
+    public static final int unhealthy_VALUE = 2;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static PortHealth valueOf(int value) {
+      return forNumber(value);
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     */
+    public static PortHealth forNumber(int value) {
+      switch (value) {
+        case 0: return unchecked;
+        case 1: return healthy;
+        case 2: return unhealthy;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<PortHealth>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        PortHealth> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<PortHealth>() {
+            public PortHealth findValueByNumber(int number) {
+              return PortHealth.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalStateException(
+            "Can't get the descriptor of an unrecognized enum value.");
+      }
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(5);
+    }
+
+    private static final PortHealth[] VALUES = values();
+
+    public static PortHealth valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private PortHealth(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:supervisor.PortHealth)
+  }
+
   /**
    * Protobuf enum {@code supervisor.TaskState}
    */
@@ -624,6 +749,14 @@ public final class Status {
      * <code>closed = 2;</code>
      */
     closed(2),
+    /**
+     * <pre>
+     * waiting means the task waits for the tasks it depends on to become ready
+     * </pre>
+     *
+     * <code>waiting = 3;</code>
+     */
+    waiting(3),
     UNRECOGNIZED(-1),
     ;
 
@@ -639,6 +772,14 @@ public final class Status {
      * <code>closed = 2;</code>
      */
     public static final int closed_VALUE = 2;
+    /**
+     * <pre>
+     * waiting means the task waits for the tasks it depends on to become ready
+     * </pre>
+     *
+     * <code>waiting = 3;</code>
+     */
+    public static final int waiting_VALUE = 3;
 
 
     public final int getNumber() {
@@ -668,6 +809,7 @@ public final class Status {
         case 0: return opening;
         case 1: return running;
         case 2: return closed;
+        case 3: return waiting;
         default: return null;
       }
     }
@@ -698,7 +840,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(5);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(6);
     }
 
     private static final TaskState[] VALUES = values();
@@ -815,7 +957,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(6);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(7);
     }
 
     private static final ResourceStatusSeverity[] VALUES = values();
@@ -9693,6 +9835,38 @@ public final class Status {
      * @return The onOpen.
      */
     io.gitpod.supervisor.api.Status.PortsStatus.OnOpenAction getOnOpen();
+
+    /**
+     * <pre>
+     * Health is the result of the health check configured for the port.
+     * Clients should not run the on_open action of a port while it is unhealthy.
+     * </pre>
+     *
+     * <code>.supervisor.PortHealth health = 11;</code>
+     * @return The enum numeric value on the wire for health.
+     */
+    int getHealthValue();
+    /**
+     * <pre>
+     * Health is the result of the health check configured for the port.
+     * Clients should not run the on_open action of a port while it is unhealthy.
+     * </pre>
+     *
+     * <code>.supervisor.PortHealth health = 11;</code>
+     * @return The health.
+     */
+    io.gitpod.supervisor.api.Status.PortHealth getHealth();
+
+    /**
+     * <pre>
+     * served_udp is true if there is a process in the workspace that serves this port over UDP.
+     * Served only ever refers to TCP, s.t. clients don't try to open ports which are served over UDP only.
+     * </pre>
+     *
+     * <code>bool served_udp = 12;</code>
+     * @return The servedUdp.
+     */
+    boolean getServedUdp();
   }
   /**
    * Protobuf type {@code supervisor.PortsStatus}
@@ -9711,6 +9885,7 @@ public final class Status {
       description_ = "";
       name_ = "";
       onOpen_ = 0;
+      health_ = 0;
     }
 
     @java.lang.Override
@@ -9803,6 +9978,17 @@ public final class Status {
               onOpen_ = rawValue;
               break;
             }
+            case 88: {
+              int rawValue = input.readEnum();
+
+              health_ = rawValue;
+              break;
+            }
+            case 96: {
+
+              servedUdp_ = input.readBool();
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -10234,6 +10420,51 @@ public final class Status {
       return result == null ? io.gitpod.supervisor.api.Status.PortsStatus.OnOpenAction.UNRECOGNIZED : result;
     }
 
+    public static final int HEALTH_FIELD_NUMBER = 11;
+    private int health_;
+    /**
+     * <pre>
+     * Health is the result of the health check configured for the port.
+     * Clients should not run the on_open action of a port while it is unhealthy.
+     * </pre>
+     *
+     * <code>.supervisor.PortHealth health = 11;</code>
+     * @return The enum numeric value on the wire for health.
+     */
+    @java.lang.Override public int getHealthValue() {
+      return health_;
+    }
+    /**
+     * <pre>
+     * Health is the result of the health check configured for the port.
+     * Clients should not run the on_open action of a port while it is unhealthy.
+     * </pre>
+     *
+     * <code>.supervisor.PortHealth health = 11;</code>
+     * @return The health.
+     */
+    @java.lang.Override public io.gitpod.supervisor.api.Status.PortHealth getHealth() {
+      @SuppressWarnings("deprecation")
+      io.gitpod.supervisor.api.Status.PortHealth result = io.gitpod.supervisor.api.Status.PortHealth.valueOf(health_);
+      return result == null ? io.gitpod.supervisor.api.Status.PortHealth.UNRECOGNIZED : result;
+    }
+
+    public static final int SERVED_UDP_FIELD_NUMBER = 12;
+    private boolean servedUdp_;
+    /**
+     * <pre>
+     * served_udp is true if there is a process in the workspace that serves this port over UDP.
+     * Served only ever refers to TCP, s.t. clients don't try to open ports which are served over UDP only.
+     * </pre>
+     *
+     * <code>bool served_udp = 12;</code>
+     * @return The servedUdp.
+     */
+    @java.lang.Override
+    public boolean getServedUdp() {
+      return servedUdp_;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -10272,6 +10503,12 @@ public final class Status {
       if (onOpen_ != io.gitpod.supervisor.api.Status.PortsStatus.OnOpenAction.ignore.getNumber()) {
         output.writeEnum(10, onOpen_);
       }
+      if (health_ != io.gitpod.supervisor.api.Status.PortHealth.unchecked.getNumber()) {
+        output.writeEnum(11, health_);
+      }
+      if (servedUdp_ != false) {
+        output.writeBool(12, servedUdp_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -10311,6 +10548,14 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeEnumSize(10, onOpen_);
       }
+      if (health_ != io.gitpod.supervisor.api.Status.PortHealth.unchecked.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(11, health_);
+      }
+      if (servedUdp_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(12, servedUdp_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -10346,6 +10591,9 @@ public final class Status {
       if (!getName()
           .equals(other.getName())) return false;
       if (onOpen_ != other.onOpen_) return false;
+      if (health_ != other.health_) return false;
+      if (getServedUdp()
+          != other.getServedUdp()) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -10378,6 +10626,11 @@ public final class Status {
       hash = (53 * hash) + getName().hashCode();
       hash = (37 * hash) + ON_OPEN_FIELD_NUMBER;
       hash = (53 * hash) + onOpen_;
+      hash = (37 * hash) + HEALTH_FIELD_NUMBER;
+      hash = (53 * hash) + health_;
+      hash = (37 * hash) + SERVED_UDP_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getServedUdp());
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -10535,6 +10788,10 @@ public final class Status {
 
         onOpen_ = 0;
 
+        health_ = 0;
+
+        servedUdp_ = false;
+
         return this;
       }
 
@@ -10577,6 +10834,8 @@ public final class Status {
         result.description_ = description_;
         result.name_ = name_;
         result.onOpen_ = onOpen_;
+        result.health_ = health_;
+        result.servedUdp_ = servedUdp_;
         onBuilt();
         return result;
       }
@@ -10651,6 +10910,12 @@ public final class Status {
         if (other.onOpen_ != 0) {
           setOnOpenValue(other.getOnOpenValue());
         }
+        if (other.health_ != 0) {
+          setHealthValue(other.getHealthValue());
+        }
+        if (other.getServedUdp() != false) {
+          setServedUdp(other.getServedUdp());
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -11445,6 +11710,131 @@ public final class Status {
         onChanged();
         return this;
       }
+
+      private int health_ = 0;
+      /**
+       * <pre>
+       * Health is the result of the health check configured for the port.
+       * Clients should not run the on_open action of a port while it is unhealthy.
+       * </pre>
+       *
+       * <code>.supervisor.PortHealth health = 11;</code>
+       * @return The enum numeric value on the wire for health.
+       */
+      @java.lang.Override public int getHealthValue() {
+        return health_;
+      }
+      /**
+       * <pre>
+       * Health is the result of the health check configured for the port.
+       * Clients should not run the on_open action of a port while it is unhealthy.
+       * </pre>
+       *
+       * <code>.supervisor.PortHealth health = 11;</code>
+       * @param value The enum numeric value on the wire for health to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHealthValue(int value) {
+
+        health_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Health is the result of the health check configured for the port.
+       * Clients should not run the on_open action of a port while it is unhealthy.
+       * </pre>
+       *
+       * <code>.supervisor.PortHealth health = 11;</code>
+       * @return The health.
+       */
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.PortHealth getHealth() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Status.PortHealth result = io.gitpod.supervisor.api.Status.PortHealth.valueOf(health_);
+        return result == null ? io.gitpod.supervisor.api.Status.PortHealth.UNRECOGNIZED : result;
+      }
+      /**
+       * <pre>
+       * Health is the result of the health check configured for the port.
+       * Clients should not run the on_open action of a port while it is unhealthy.
+       * </pre>
+       *
+       * <code>.supervisor.PortHealth health = 11;</code>
+       * @param value The health to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHealth(io.gitpod.supervisor.api.Status.PortHealth value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+
+        health_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Health is the result of the health check configured for the port.
+       * Clients should not run the on_open action of a port while it is unhealthy.
+       * </pre>
+       *
+       * <code>.supervisor.PortHealth health = 11;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearHealth() {
+
+        health_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private boolean servedUdp_ ;
+      /**
+       * <pre>
+       * served_udp is true if there is a process in the workspace that serves this port over UDP.
+       * Served only ever refers to TCP, s.t. clients don't try to open ports which are served over UDP only.
+       * </pre>
+       *
+       * <code>bool served_udp = 12;</code>
+       * @return The servedUdp.
+       */
+      @java.lang.Override
+      public boolean getServedUdp() {
+        return servedUdp_;
+      }
+      /**
+       * <pre>
+       * served_udp is true if there is a process in the workspace that serves this port over UDP.
+       * Served only ever refers to TCP, s.t. clients don't try to open ports which are served over UDP only.
+       * </pre>
+       *
+       * <code>bool served_udp = 12;</code>
+       * @param value The servedUdp to set.
+       * @return This builder for chaining.
+       */
+      public Builder setServedUdp(boolean value) {
+
+        servedUdp_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * served_udp is true if there is a process in the workspace that serves this port over UDP.
+       * Served only ever refers to TCP, s.t. clients don't try to open ports which are served over UDP only.
+       * </pre>
+       *
+       * <code>bool served_udp = 12;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearServedUdp() {
+
+        servedUdp_ = false;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -12862,6 +13252,26 @@ public final class Status {
      * <code>.supervisor.TaskPresentation presentation = 4;</code>
      */
     io.gitpod.supervisor.api.Status.TaskPresentationOrBuilder getPresentationOrBuilder();
+
+    /**
+     * <pre>
+     * restart_count is the number of times the command of the task has been restarted
+     * </pre>
+     *
+     * <code>uint32 restart_count = 5;</code>
+     * @return The restartCount.
+     */
+    int getRestartCount();
+
+    /**
+     * <pre>
+     * last_exit_code is the exit code of the last run of the task's command, 0 if it has not terminated yet
+     * </pre>
+     *
+     * <code>int32 last_exit_code = 6;</code>
+     * @return The lastExitCode.
+     */
+    int getLastExitCode();
   }
   /**
    * Protobuf type {@code supervisor.TaskStatus}
@@ -12942,6 +13352,16 @@ public final class Status {
 
               break;
             }
+            case 40: {
+
+              restartCount_ = input.readUInt32();
+              break;
+            }
+            case 48: {
+
+              lastExitCode_ = input.readInt32();
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -13097,6 +13517,36 @@ public final class Status {
       return getPresentation();
     }
 
+    public static final int RESTART_COUNT_FIELD_NUMBER = 5;
+    private int restartCount_;
+    /**
+     * <pre>
+     * restart_count is the number of times the command of the task has been restarted
+     * </pre>
+     *
+     * <code>uint32 restart_count = 5;</code>
+     * @return The restartCount.
+     */
+    @java.lang.Override
+    public int getRestartCount() {
+      return restartCount_;
+    }
+
+    public static final int LAST_EXIT_CODE_FIELD_NUMBER = 6;
+    private int lastExitCode_;
+    /**
+     * <pre>
+     * last_exit_code is the exit code of the last run of the task's command, 0 if it has not terminated yet
+     * </pre>
+     *
+     * <code>int32 last_exit_code = 6;</code>
+     * @return The lastExitCode.
+     */
+    @java.lang.Override
+    public int getLastExitCode() {
+      return lastExitCode_;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -13123,6 +13573,12 @@ public final class Status {
       if (presentation_ != null) {
         output.writeMessage(4, getPresentation());
       }
+      if (restartCount_ != 0) {
+        output.writeUInt32(5, restartCount_);
+      }
+      if (lastExitCode_ != 0) {
+        output.writeInt32(6, lastExitCode_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -13146,6 +13602,14 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeMessageSize(4, getPresentation());
       }
+      if (restartCount_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(5, restartCount_);
+      }
+      if (lastExitCode_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(6, lastExitCode_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -13171,6 +13635,10 @@ public final class Status {
         if (!getPresentation()
             .equals(other.getPresentation())) return false;
       }
+      if (getRestartCount()
+          != other.getRestartCount()) return false;
+      if (getLastExitCode()
+          != other.getLastExitCode()) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -13192,6 +13660,10 @@ public final class Status {
         hash = (37 * hash) + PRESENTATION_FIELD_NUMBER;
         hash = (53 * hash) + getPresentation().hashCode();
       }
+      hash = (37 * hash) + RESTART_COUNT_FIELD_NUMBER;
+      hash = (53 * hash) + getRestartCount();
+      hash = (37 * hash) + LAST_EXIT_CODE_FIELD_NUMBER;
+      hash = (53 * hash) + getLastExitCode();
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -13337,6 +13809,10 @@ public final class Status {
           presentation_ = null;
           presentationBuilder_ = null;
         }
+        restartCount_ = 0;
+
+        lastExitCode_ = 0;
+
         return this;
       }
 
@@ -13371,6 +13847,8 @@ public final class Status {
         } else {
           result.presentation_ = presentationBuilder_.build();
         }
+        result.restartCount_ = restartCount_;
+        result.lastExitCode_ = lastExitCode_;
         onBuilt();
         return result;
       }
@@ -13433,6 +13911,12 @@ public final class Status {
         if (other.hasPresentation()) {
           mergePresentation(other.getPresentation());
         }
+        if (other.getRestartCount() != 0) {
+          setRestartCount(other.getRestartCount());
+        }
+        if (other.getLastExitCode() != 0) {
+          setLastExitCode(other.getLastExitCode());
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -13786,6 +14270,92 @@ public final class Status {
         }
         return presentationBuilder_;
       }
+
+      private int restartCount_ ;
+      /**
+       * <pre>
+       * restart_count is the number of times the command of the task has been restarted
+       * </pre>
+       *
+       * <code>uint32 restart_count = 5;</code>
+       * @return The restartCount.
+       */
+      @java.lang.Override
+      public int getRestartCount() {
+        return restartCount_;
+      }
+      /**
+       * <pre>
+       * restart_count is the number of times the command of the task has been restarted
+       * </pre>
+       *
+       * <code>uint32 restart_count = 5;</code>
+       * @param value The restartCount to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRestartCount(int value) {
+
+        restartCount_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * restart_count is the number of times the command of the task has been restarted
+       * </pre>
+       *
+       * <code>uint32 restart_count = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRestartCount() {
+
+        restartCount_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int lastExitCode_ ;
+      /**
+       * <pre>
+       * last_exit_code is the exit code of the last run of the task's command, 0 if it has not terminated yet
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 6;</code>
+       * @return The lastExitCode.
+       */
+      @java.lang.Override
+      public int getLastExitCode() {
+        return lastExitCode_;
+      }
+      /**
+       * <pre>
+       * last_exit_code is the exit code of the last run of the task's command, 0 if it has not terminated yet
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 6;</code>
+       * @param value The lastExitCode to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLastExitCode(int value) {
+
+        lastExitCode_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * last_exit_code is the exit code of the last run of the task's command, 0 if it has not terminated yet
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLastExitCode() {
+
+        lastExitCode_ = 0;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -15186,6 +15756,33 @@ public final class Status {
      * <code>.supervisor.ResourceStatus cpu = 2;</code>
      */
     io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getCpuOrBuilder();
+
+    /**
+     * <pre>
+     * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus storage = 3;</code>
+     * @return Whether the storage field is set.
+     */
+    boolean hasStorage();
+    /**
+     * <pre>
+     * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus storage = 3;</code>
+     * @return The storage.
+     */
+    io.gitpod.supervisor.api.Status.ResourceStatus getStorage();
+    /**
+     * <pre>
+     * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus storage = 3;</code>
+     */
+    io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getStorageOrBuilder();
   }
   /**
    * Protobuf type {@code supervisor.ResourcesStatusResponse}
@@ -15258,6 +15855,19 @@ public final class Status {
 
               break;
             }
+            case 26: {
+              io.gitpod.supervisor.api.Status.ResourceStatus.Builder subBuilder = null;
+              if (storage_ != null) {
+                subBuilder = storage_.toBuilder();
+              }
+              storage_ = input.readMessage(io.gitpod.supervisor.api.Status.ResourceStatus.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(storage_);
+                storage_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -15368,6 +15978,44 @@ public final class Status {
       return getCpu();
     }
 
+    public static final int STORAGE_FIELD_NUMBER = 3;
+    private io.gitpod.supervisor.api.Status.ResourceStatus storage_;
+    /**
+     * <pre>
+     * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus storage = 3;</code>
+     * @return Whether the storage field is set.
+     */
+    @java.lang.Override
+    public boolean hasStorage() {
+      return storage_ != null;
+    }
+    /**
+     * <pre>
+     * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus storage = 3;</code>
+     * @return The storage.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ResourceStatus getStorage() {
+      return storage_ == null ? io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance() : storage_;
+    }
+    /**
+     * <pre>
+     * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus storage = 3;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getStorageOrBuilder() {
+      return getStorage();
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -15388,6 +16036,9 @@ public final class Status {
       if (cpu_ != null) {
         output.writeMessage(2, getCpu());
       }
+      if (storage_ != null) {
+        output.writeMessage(3, getStorage());
+      }
       unknownFields.writeTo(output);
     }
 
@@ -15405,6 +16056,10 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeMessageSize(2, getCpu());
       }
+      if (storage_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(3, getStorage());
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -15430,6 +16085,11 @@ public final class Status {
         if (!getCpu()
             .equals(other.getCpu())) return false;
       }
+      if (hasStorage() != other.hasStorage()) return false;
+      if (hasStorage()) {
+        if (!getStorage()
+            .equals(other.getStorage())) return false;
+      }
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -15449,6 +16109,10 @@ public final class Status {
         hash = (37 * hash) + CPU_FIELD_NUMBER;
         hash = (53 * hash) + getCpu().hashCode();
       }
+      if (hasStorage()) {
+        hash = (37 * hash) + STORAGE_FIELD_NUMBER;
+        hash = (53 * hash) + getStorage().hashCode();
+      }
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -15594,6 +16258,12 @@ public final class Status {
           cpu_ = null;
           cpuBuilder_ = null;
         }
+        if (storageBuilder_ == null) {
+          storage_ = null;
+        } else {
+          storage_ = null;
+          storageBuilder_ = null;
+        }
         return this;
       }
 
@@ -15630,6 +16300,11 @@ public final class Status {
         } else {
           result.cpu_ = cpuBuilder_.build();
         }
+        if (storageBuilder_ == null) {
+          result.storage_ = storage_;
+        } else {
+          result.storage_ = storageBuilder_.build();
+        }
         onBuilt();
         return result;
       }
@@ -15684,6 +16359,9 @@ public final class Status {
         if (other.hasCpu()) {
           mergeCpu(other.getCpu());
         }
+        if (other.hasStorage()) {
+          mergeStorage(other.getStorage());
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -16022,6 +16700,161 @@ public final class Status {
         }
         return cpuBuilder_;
       }
+
+      private io.gitpod.supervisor.api.Status.ResourceStatus storage_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ResourceStatus, io.gitpod.supervisor.api.Status.ResourceStatus.Builder, io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder> storageBuilder_;
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       * @return Whether the storage field is set.
+       */
+      public boolean hasStorage() {
+        return storageBuilder_ != null || storage_ != null;
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       * @return The storage.
+       */
+      public io.gitpod.supervisor.api.Status.ResourceStatus getStorage() {
+        if (storageBuilder_ == null) {
+          return storage_ == null ? io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance() : storage_;
+        } else {
+          return storageBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       */
+      public Builder setStorage(io.gitpod.supervisor.api.Status.ResourceStatus value) {
+        if (storageBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          storage_ = value;
+          onChanged();
+        } else {
+          storageBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       */
+      public Builder setStorage(
+          io.gitpod.supervisor.api.Status.ResourceStatus.Builder builderForValue) {
+        if (storageBuilder_ == null) {
+          storage_ = builderForValue.build();
+          onChanged();
+        } else {
+          storageBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       */
+      public Builder mergeStorage(io.gitpod.supervisor.api.Status.ResourceStatus value) {
+        if (storageBuilder_ == null) {
+          if (storage_ != null) {
+            storage_ =
+              io.gitpod.supervisor.api.Status.ResourceStatus.newBuilder(storage_).mergeFrom(value).buildPartial();
+          } else {
+            storage_ = value;
+          }
+          onChanged();
+        } else {
+          storageBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       */
+      public Builder clearStorage() {
+        if (storageBuilder_ == null) {
+          storage_ = null;
+          onChanged();
+        } else {
+          storage_ = null;
+          storageBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ResourceStatus.Builder getStorageBuilder() {
+
+        onChanged();
+        return getStorageFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getStorageOrBuilder() {
+        if (storageBuilder_ != null) {
+          return storageBuilder_.getMessageOrBuilder();
+        } else {
+          return storage_ == null ?
+              io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance() : storage_;
+        }
+      }
+      /**
+       * <pre>
+       * Used storage and limit of the workspace content in bytes. Absent if the workspace has no storage quota.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus storage = 3;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ResourceStatus, io.gitpod.supervisor.api.Status.ResourceStatus.Builder, io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder>
+          getStorageFieldBuilder() {
+        if (storageBuilder_ == null) {
+          storageBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Status.ResourceStatus, io.gitpod.supervisor.api.Status.ResourceStatus.Builder, io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder>(
+                  getStorage(),
+                  getParentForChildren(),
+                  isClean());
+          storage_ = null;
+        }
+        return storageBuilder_;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -16889,69 +17722,74 @@ public final class Status {
       "or.TunnelVisiblity\022:\n\007clients\030\003 \003(\0132).su" +
       "pervisor.TunneledPortInfo.ClientsEntry\032." +
       "\n\014ClientsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001" +
-      "(\r:\0028\001\"\204\003\n\013PortsStatus\022\022\n\nlocal_port\030\001 \001" +
+      "(\r:\0028\001\"\300\003\n\013PortsStatus\022\022\n\nlocal_port\030\001 \001" +
       "(\r\022\016\n\006served\030\004 \001(\010\022,\n\007exposed\030\005 \001(\0132\033.su" +
       "pervisor.ExposedPortInfo\0223\n\rauto_exposur" +
       "e\030\007 \001(\0162\034.supervisor.PortAutoExposure\022.\n" +
       "\010tunneled\030\006 \001(\0132\034.supervisor.TunneledPor" +
       "tInfo\022\023\n\013description\030\010 \001(\t\022\014\n\004name\030\t \001(\t" +
       "\0225\n\007on_open\030\n \001(\0162$.supervisor.PortsStat" +
-      "us.OnOpenAction\"^\n\014OnOpenAction\022\n\n\006ignor" +
-      "e\020\000\022\020\n\014open_browser\020\001\022\020\n\014open_preview\020\002\022" +
-      "\n\n\006notify\020\003\022\022\n\016notify_private\020\004J\004\010\002\020\003\"%\n" +
-      "\022TasksStatusRequest\022\017\n\007observe\030\001 \001(\010\"<\n\023" +
-      "TasksStatusResponse\022%\n\005tasks\030\001 \003(\0132\026.sup" +
-      "ervisor.TaskStatus\"\204\001\n\nTaskStatus\022\n\n\002id\030" +
-      "\001 \001(\t\022$\n\005state\030\002 \001(\0162\025.supervisor.TaskSt" +
-      "ate\022\020\n\010terminal\030\003 \001(\t\0222\n\014presentation\030\004 " +
-      "\001(\0132\034.supervisor.TaskPresentation\"D\n\020Tas" +
-      "kPresentation\022\014\n\004name\030\001 \001(\t\022\017\n\007open_in\030\002" +
-      " \001(\t\022\021\n\topen_mode\030\003 \001(\t\"\027\n\025ResourcesStat" +
-      "uRequest\"n\n\027ResourcesStatusResponse\022*\n\006m" +
-      "emory\030\001 \001(\0132\032.supervisor.ResourceStatus\022" +
-      "\'\n\003cpu\030\002 \001(\0132\032.supervisor.ResourceStatus" +
-      "\"c\n\016ResourceStatus\022\014\n\004used\030\001 \001(\003\022\r\n\005limi" +
-      "t\030\002 \001(\003\0224\n\010severity\030\003 \001(\0162\".supervisor.R" +
-      "esourceStatusSeverity*C\n\rContentSource\022\016" +
-      "\n\nfrom_other\020\000\022\017\n\013from_backup\020\001\022\021\n\rfrom_" +
-      "prebuild\020\002*?\n\016PortVisibility\022\026\n\022private_" +
-      "visibility\020\000\022\025\n\021public_visibility\020\001*#\n\014P" +
-      "ortProtocol\022\010\n\004http\020\000\022\t\n\005https\020\001*e\n\023OnPo" +
-      "rtExposedAction\022\n\n\006ignore\020\000\022\020\n\014open_brow" +
-      "ser\020\001\022\020\n\014open_preview\020\002\022\n\n\006notify\020\003\022\022\n\016n" +
-      "otify_private\020\004*9\n\020PortAutoExposure\022\n\n\006t" +
-      "rying\020\000\022\r\n\tsucceeded\020\001\022\n\n\006failed\020\002*1\n\tTa" +
-      "skState\022\013\n\007opening\020\000\022\013\n\007running\020\001\022\n\n\006clo" +
-      "sed\020\002*=\n\026ResourceStatusSeverity\022\n\n\006norma" +
-      "l\020\000\022\013\n\007warning\020\001\022\n\n\006danger\020\0022\377\007\n\rStatusS" +
-      "ervice\022\266\001\n\020SupervisorStatus\022#.supervisor" +
-      ".SupervisorStatusRequest\032$.supervisor.Su" +
-      "pervisorStatusResponse\"W\202\323\344\223\002Q\022\025/v1/stat" +
-      "us/supervisorZ8\0226/v1/status/supervisor/w" +
-      "illShutdown/{willShutdown=true}\022\203\001\n\tIDES" +
-      "tatus\022\034.supervisor.IDEStatusRequest\032\035.su" +
-      "pervisor.IDEStatusResponse\"9\202\323\344\223\0023\022\016/v1/" +
-      "status/ideZ!\022\037/v1/status/ide/wait/{wait=" +
-      "true}\022\227\001\n\rContentStatus\022 .supervisor.Con" +
-      "tentStatusRequest\032!.supervisor.ContentSt" +
-      "atusResponse\"A\202\323\344\223\002;\022\022/v1/status/content" +
-      "Z%\022#/v1/status/content/wait/{wait=true}\022" +
-      "l\n\014BackupStatus\022\037.supervisor.BackupStatu" +
-      "sRequest\032 .supervisor.BackupStatusRespon" +
-      "se\"\031\202\323\344\223\002\023\022\021/v1/status/backup\022\225\001\n\013PortsS" +
-      "tatus\022\036.supervisor.PortsStatusRequest\032\037." +
-      "supervisor.PortsStatusResponse\"C\202\323\344\223\002=\022\020" +
-      "/v1/status/portsZ)\022\'/v1/status/ports/obs" +
-      "erve/{observe=true}0\001\022\225\001\n\013TasksStatus\022\036." +
-      "supervisor.TasksStatusRequest\032\037.supervis" +
-      "or.TasksStatusResponse\"C\202\323\344\223\002=\022\020/v1/stat" +
-      "us/tasksZ)\022\'/v1/status/tasks/observe/{ob" +
-      "serve=true}0\001\022w\n\017ResourcesStatus\022!.super" +
-      "visor.ResourcesStatuRequest\032#.supervisor" +
-      ".ResourcesStatusResponse\"\034\202\323\344\223\002\026\022\024/v1/st" +
-      "atus/resourcesBF\n\030io.gitpod.supervisor.a" +
-      "piZ*github.com/gitpod-io/gitpod/supervis" +
-      "or/apib\006proto3"
+      "us.OnOpenAction\022&\n\006health\030\013 \001(\0162\026.superv" +
+      "isor.PortHealth\022\022\n\nserved_udp\030\014 \001(\010\"^\n\014O" +
+      "nOpenAction\022\n\n\006ignore\020\000\022\020\n\014open_browser\020" +
+      "\001\022\020\n\014open_preview\020\002\022\n\n\006notify\020\003\022\022\n\016notif" +
+      "y_private\020\004J\004\010\002\020\003\"%\n\022TasksStatusRequest\022" +
+      "\017\n\007observe\030\001 \001(\010\"<\n\023TasksStatusResponse\022" +
+      "%\n\005tasks\030\001 \003(\0132\026.supervisor.TaskStatus\"\263" +
+      "\001\n\nTaskStatus\022\n\n\002id\030\001 \001(\t\022$\n\005state\030\002 \001(\016" +
+      "2\025.supervisor.TaskState\022\020\n\010terminal\030\003 \001(" +
+      "\t\0222\n\014presentation\030\004 \001(\0132\034.supervisor.Tas" +
+      "kPresentation\022\025\n\rrestart_count\030\005 \001(\r\022\026\n\016" +
+      "last_exit_code\030\006 \001(\005\"D\n\020TaskPresentation" +
+      "\022\014\n\004name\030\001 \001(\t\022\017\n\007open_in\030\002 \001(\t\022\021\n\topen_" +
+      "mode\030\003 \001(\t\"\027\n\025ResourcesStatuRequest\"\233\001\n\027" +
+      "ResourcesStatusResponse\022*\n\006memory\030\001 \001(\0132" +
+      "\032.supervisor.ResourceStatus\022\'\n\003cpu\030\002 \001(\013" +
+      "2\032.supervisor.ResourceStatus\022+\n\007storage\030" +
+      "\003 \001(\0132\032.supervisor.ResourceStatus\"c\n\016Res" +
+      "ourceStatus\022\014\n\004used\030\001 \001(\003\022\r\n\005limit\030\002 \001(\003" +
+      "\0224\n\010severity\030\003 \001(\0162\".supervisor.Resource" +
+      "StatusSeverity*C\n\rContentSource\022\016\n\nfrom_" +
+      "other\020\000\022\017\n\013from_backup\020\001\022\021\n\rfrom_prebuil" +
+      "d\020\002*?\n\016PortVisibility\022\026\n\022private_visibil" +
+      "ity\020\000\022\025\n\021public_visibility\020\001*#\n\014PortProt" +
+      "ocol\022\010\n\004http\020\000\022\t\n\005https\020\001*e\n\023OnPortExpos" +
+      "edAction\022\n\n\006ignore\020\000\022\020\n\014open_browser\020\001\022\020" +
+      "\n\014open_preview\020\002\022\n\n\006notify\020\003\022\022\n\016notify_p" +
+      "rivate\020\004*9\n\020PortAutoExposure\022\n\n\006trying\020\000" +
+      "\022\r\n\tsucceeded\020\001\022\n\n\006failed\020\002*7\n\nPortHealt" +
+      "h\022\r\n\tunchecked\020\000\022\013\n\007healthy\020\001\022\r\n\tunhealt" +
+      "hy\020\002*>\n\tTaskState\022\013\n\007opening\020\000\022\013\n\007runnin" +
+      "g\020\001\022\n\n\006closed\020\002\022\013\n\007waiting\020\003*=\n\026Resource" +
+      "StatusSeverity\022\n\n\006normal\020\000\022\013\n\007warning\020\001\022" +
+      "\n\n\006danger\020\0022\377\007\n\rStatusService\022\266\001\n\020Superv" +
+      "isorStatus\022#.supervisor.SupervisorStatus" +
+      "Request\032$.supervisor.SupervisorStatusRes" +
+      "ponse\"W\202\323\344\223\002Q\022\025/v1/status/supervisorZ8\0226" +
+      "/v1/status/supervisor/willShutdown/{will" +
+      "Shutdown=true}\022\203\001\n\tIDEStatus\022\034.superviso" +
+      "r.IDEStatusRequest\032\035.supervisor.IDEStatu" +
+      "sResponse\"9\202\323\344\223\0023\022\016/v1/status/ideZ!\022\037/v1" +
+      "/status/ide/wait/{wait=true}\022\227\001\n\rContent" +
+      "Status\022 .supervisor.ContentStatusRequest" +
+      "\032!.supervisor.ContentStatusResponse\"A\202\323\344" +
+      "\223\002;\022\022/v1/status/contentZ%\022#/v1/status/co" +
+      "ntent/wait/{wait=true}\022l\n\014BackupStatus\022\037" +
+      ".supervisor.BackupStatusRequest\032 .superv" +
+      "isor.BackupStatusResponse\"\031\202\323\344\223\002\023\022\021/v1/s" +
+      "tatus/backup\022\225\001\n\013PortsStatus\022\036.superviso" +
+      "r.PortsStatusRequest\032\037.supervisor.PortsS" +
+      "tatusResponse\"C\202\323\344\223\002=\022\020/v1/status/portsZ" +
+      ")\022\'/v1/status/ports/observe/{observe=tru" +
+      "e}0\001\022\225\001\n\013TasksStatus\022\036.supervisor.TasksS" +
+      "tatusRequest\032\037.supervisor.TasksStatusRes" +
+      "ponse\"C\202\323\344\223\002=\022\020/v1/status/tasksZ)\022\'/v1/s" +
+      "tatus/tasks/observe/{observe=true}0\001\022w\n\017" +
+      "ResourcesStatus\022!.supervisor.ResourcesSt" +
+      "atuRequest\032#.supervisor.ResourcesStatusR" +
+      "esponse\"\034\202\323\344\223\002\026\022\024/v1/status/resourcesBF\n" +
+      "\030io.gitpod.supervisor.apiZ*github.com/gi" +
+      "tpod-io/gitpod/supervisor/apib\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -17048,7 +17886,7 @@ public final class Status {
     internal_static_supervisor_PortsStatus_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_PortsStatus_descriptor,
-        new java.lang.String[] { "LocalPort", "Served", "Exposed", "AutoExposure", "Tunneled", "Description", "Name", "OnOpen", });
+        new java.lang.String[] { "LocalPort", "Served", "Exposed", "AutoExposure", "Tunneled", "Description", "Name", "OnOpen", "Health", "ServedUdp", });
     internal_static_supervisor_TasksStatusRequest_descriptor =
       getDescriptor().getMessageTypes().get(13);
     internal_static_supervisor_TasksStatusRequest_fieldAccessorTable = new
@@ -17066,7 +17904,7 @@ public final class Status {
     internal_static_supervisor_TaskStatus_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_TaskStatus_descriptor,
-        new java.lang.String[] { "Id", "State", "Terminal", "Presentation", });
+        new java.lang.String[] { "Id", "State", "Terminal", "Presentation", "RestartCount", "LastExitCode", });
     internal_static_supervisor_TaskPresentation_descriptor =
       getDescriptor().getMessageTypes().get(16);
     internal_static_supervisor_TaskPresentation_fieldAccessorTable = new
@@ -17084,7 +17922,7 @@ public final class Status {
     internal_static_supervisor_ResourcesStatusResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_ResourcesStatusResponse_descriptor,
-        new java.lang.String[] { "Memory", "Cpu", });
+        new java.lang.String[] { "Memory", "Cpu", "Storage", });
     internal_static_supervisor_ResourceStatus_descriptor =
       getDescriptor().getMessageTypes().get(19);
     internal_static_supervisor_ResourceStatus_fieldAccessorTable = new
@@ -4538,6 +4538,26 @@ java.lang.String defaultValue);
      * @return The titleSource.
      */
     io.gitpod.supervisor.api.TerminalOuterClass.TerminalTitleSource getTitleSource();
+
+    /**
+     * <pre>
+     * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The recording.
+     */
+    java.lang.String getRecording();
+    /**
+     * <pre>
+     * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The bytes for recording.
+     */
+    com.google.protobuf.ByteString
+        getRecordingBytes();
   }
   /**
    * Protobuf type {@code supervisor.Terminal}
@@ -4558,6 +4578,7 @@ java.lang.String defaultValue);
       initialWorkdir_ = "";
       currentWorkdir_ = "";
       titleSource_ = 0;
+      recording_ = "";
     }
 
     @java.lang.Override
@@ -4648,6 +4669,12 @@ java.lang.String defaultValue);
               titleSource_ = rawValue;
               break;
             }
+            case 74: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              recording_ = s;
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -4995,6 +5022,52 @@ java.lang.String defaultValue);
       return result == null ? io.gitpod.supervisor.api.TerminalOuterClass.TerminalTitleSource.UNRECOGNIZED : result;
     }
 
+    public static final int RECORDING_FIELD_NUMBER = 9;
+    private volatile java.lang.Object recording_;
+    /**
+     * <pre>
+     * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The recording.
+     */
+    @java.lang.Override
+    public java.lang.String getRecording() {
+      java.lang.Object ref = recording_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        recording_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The bytes for recording.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getRecordingBytes() {
+      java.lang.Object ref = recording_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        recording_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -5036,6 +5109,9 @@ java.lang.String defaultValue);
       if (titleSource_ != io.gitpod.supervisor.api.TerminalOuterClass.TerminalTitleSource.process.getNumber()) {
         output.writeEnum(8, titleSource_);
       }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(recording_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 9, recording_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -5083,6 +5159,9 @@ java.lang.String defaultValue);
         size += com.google.protobuf.CodedOutputStream
           .computeEnumSize(8, titleSource_);
       }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(recording_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(9, recording_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -5113,6 +5192,8 @@ java.lang.String defaultValue);
       if (!internalGetAnnotations().equals(
           other.internalGetAnnotations())) return false;
       if (titleSource_ != other.titleSource_) return false;
+      if (!getRecording()
+          .equals(other.getRecording())) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -5145,6 +5226,8 @@ java.lang.String defaultValue);
       }
       hash = (37 * hash) + TITLE_SOURCE_FIELD_NUMBER;
       hash = (53 * hash) + titleSource_;
+      hash = (37 * hash) + RECORDING_FIELD_NUMBER;
+      hash = (53 * hash) + getRecording().hashCode();
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -5315,6 +5398,8 @@ java.lang.String defaultValue);
         internalGetMutableAnnotations().clear();
         titleSource_ = 0;
 
+        recording_ = "";
+
         return this;
       }
 
@@ -5355,6 +5440,7 @@ java.lang.String defaultValue);
         result.annotations_ = internalGetAnnotations();
         result.annotations_.makeImmutable();
         result.titleSource_ = titleSource_;
+        result.recording_ = recording_;
         onBuilt();
         return result;
       }
@@ -5437,6 +5523,10 @@ java.lang.String defaultValue);
         if (other.titleSource_ != 0) {
           setTitleSourceValue(other.getTitleSourceValue());
         }
+        if (!other.getRecording().isEmpty()) {
+          recording_ = other.recording_;
+          onChanged();
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -6096,6 +6186,102 @@ java.lang.String defaultValue);
         onChanged();
         return this;
       }
+
+      private java.lang.Object recording_ = "";
+      /**
+       * <pre>
+       * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @return The recording.
+       */
+      public java.lang.String getRecording() {
+        java.lang.Object ref = recording_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          recording_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @return The bytes for recording.
+       */
+      public com.google.protobuf.ByteString
+          getRecordingBytes() {
+        java.lang.Object ref = recording_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          recording_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @param value The recording to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRecording(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        recording_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRecording() {
+
+        recording_ = getDefaultInstance().getRecording();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * recording is the path of the file the terminal is currently recorded to, empty if it isn't recorded
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @param value The bytes for recording to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRecordingBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        recording_ = value;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -13422,8 +13608,8 @@ java.lang.String defaultValue);
 
   }
 
-  public interface UpdateTerminalAnnotationsRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:supervisor.UpdateTerminalAnnotationsRequest)
+  public interface SetTerminalRecordingRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SetTerminalRecordingRequest)
       com.google.protobuf.MessageOrBuilder {
 
     /**
@@ -13439,124 +13625,32 @@ java.lang.String defaultValue);
         getAliasBytes();
 
     /**
-     * <pre>
-     * annotations to create or update
-     * </pre>
-     *
-     * <code>map&lt;string, string&gt; changed = 2;</code>
-     */
-    int getChangedCount();
-    /**
-     * <pre>
-     * annotations to create or update
-     * </pre>
-     *
-     * <code>map&lt;string, string&gt; changed = 2;</code>
-     */
-    boolean containsChanged(
-        java.lang.String key);
-    /**
-     * Use {@link #getChangedMap()} instead.
-     */
-    @java.lang.Deprecated
-    java.util.Map<java.lang.String, java.lang.String>
-    getChanged();
-    /**
-     * <pre>
-     * annotations to create or update
-     * </pre>
-     *
-     * <code>map&lt;string, string&gt; changed = 2;</code>
-     */
-    java.util.Map<java.lang.String, java.lang.String>
-    getChangedMap();
-    /**
-     * <pre>
-     * annotations to create or update
-     * </pre>
-     *
-     * <code>map&lt;string, string&gt; changed = 2;</code>
-     */
-
-    /* nullable */
-java.lang.String getChangedOrDefault(
-        java.lang.String key,
-        /* nullable */
-java.lang.String defaultValue);
-    /**
-     * <pre>
-     * annotations to create or update
-     * </pre>
-     *
-     * <code>map&lt;string, string&gt; changed = 2;</code>
-     */
-
-    java.lang.String getChangedOrThrow(
-        java.lang.String key);
-
-    /**
-     * <pre>
-     * annotations to remove
-     * </pre>
-     *
-     * <code>repeated string deleted = 3;</code>
-     * @return A list containing the deleted.
-     */
-    java.util.List<java.lang.String>
-        getDeletedList();
-    /**
-     * <pre>
-     * annotations to remove
-     * </pre>
-     *
-     * <code>repeated string deleted = 3;</code>
-     * @return The count of deleted.
-     */
-    int getDeletedCount();
-    /**
-     * <pre>
-     * annotations to remove
-     * </pre>
-     *
-     * <code>repeated string deleted = 3;</code>
-     * @param index The index of the element to return.
-     * @return The deleted at the given index.
-     */
-    java.lang.String getDeleted(int index);
-    /**
-     * <pre>
-     * annotations to remove
-     * </pre>
-     *
-     * <code>repeated string deleted = 3;</code>
-     * @param index The index of the value to return.
-     * @return The bytes of the deleted at the given index.
+     * <code>bool enabled = 2;</code>
+     * @return The enabled.
      */
-    com.google.protobuf.ByteString
-        getDeletedBytes(int index);
+    boolean getEnabled();
   }
   /**
-   * Protobuf type {@code supervisor.UpdateTerminalAnnotationsRequest}
+   * Protobuf type {@code supervisor.SetTerminalRecordingRequest}
    */
-  public static final class UpdateTerminalAnnotationsRequest extends
+  public static final class SetTerminalRecordingRequest extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:supervisor.UpdateTerminalAnnotationsRequest)
-      UpdateTerminalAnnotationsRequestOrBuilder {
+      // @@protoc_insertion_point(message_implements:supervisor.SetTerminalRecordingRequest)
+      SetTerminalRecordingRequestOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use UpdateTerminalAnnotationsRequest.newBuilder() to construct.
-    private UpdateTerminalAnnotationsRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use SetTerminalRecordingRequest.newBuilder() to construct.
+    private SetTerminalRecordingRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private UpdateTerminalAnnotationsRequest() {
+    private SetTerminalRecordingRequest() {
       alias_ = "";
-      deleted_ = com.google.protobuf.LazyStringArrayList.EMPTY;
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new UpdateTerminalAnnotationsRequest();
+      return new SetTerminalRecordingRequest();
     }
 
     @java.lang.Override
@@ -13564,7 +13658,7 @@ java.lang.String defaultValue);
     getUnknownFields() {
       return this.unknownFields;
     }
-    private UpdateTerminalAnnotationsRequest(
+    private SetTerminalRecordingRequest(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
@@ -13572,7 +13666,6 @@ java.lang.String defaultValue);
       if (extensionRegistry == null) {
         throw new java.lang.NullPointerException();
       }
-      int mutable_bitField0_ = 0;
       com.google.protobuf.UnknownFieldSet.Builder unknownFields =
           com.google.protobuf.UnknownFieldSet.newBuilder();
       try {
@@ -13589,26 +13682,9 @@ java.lang.String defaultValue);
               alias_ = s;
               break;
             }
-            case 18: {
-              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
-                changed_ = com.google.protobuf.MapField.newMapField(
-                    ChangedDefaultEntryHolder.defaultEntry);
-                mutable_bitField0_ |= 0x00000001;
-              }
-              com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
-              changed__ = input.readMessage(
-                  ChangedDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
-              changed_.getMutableMap().put(
-                  changed__.getKey(), changed__.getValue());
-              break;
-            }
-            case 26: {
-              java.lang.String s = input.readStringRequireUtf8();
-              if (!((mutable_bitField0_ & 0x00000002) != 0)) {
-                deleted_ = new com.google.protobuf.LazyStringArrayList();
-                mutable_bitField0_ |= 0x00000002;
-              }
-              deleted_.add(s);
+            case 16: {
+
+              enabled_ = input.readBool();
               break;
             }
             default: {
@@ -13628,19 +13704,1376 @@ java.lang.String defaultValue);
         throw new com.google.protobuf.InvalidProtocolBufferException(
             e).setUnfinishedMessage(this);
       } finally {
-        if (((mutable_bitField0_ & 0x00000002) != 0)) {
-          deleted_ = deleted_.getUnmodifiableView();
-        }
         this.unknownFields = unknownFields.build();
         makeExtensionsImmutable();
       }
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UpdateTerminalAnnotationsRequest_descriptor;
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingRequest_descriptor;
     }
 
-    @SuppressWarnings({"rawtypes"})
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.Builder.class);
+    }
+
+    public static final int ALIAS_FIELD_NUMBER = 1;
+    private volatile java.lang.Object alias_;
+    /**
+     * <code>string alias = 1;</code>
+     * @return The alias.
+     */
+    @java.lang.Override
+    public java.lang.String getAlias() {
+      java.lang.Object ref = alias_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        alias_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string alias = 1;</code>
+     * @return The bytes for alias.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getAliasBytes() {
+      java.lang.Object ref = alias_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        alias_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int ENABLED_FIELD_NUMBER = 2;
+    private boolean enabled_;
+    /**
+     * <code>bool enabled = 2;</code>
+     * @return The enabled.
+     */
+    @java.lang.Override
+    public boolean getEnabled() {
+      return enabled_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(alias_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, alias_);
+      }
+      if (enabled_ != false) {
+        output.writeBool(2, enabled_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(alias_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, alias_);
+      }
+      if (enabled_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(2, enabled_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest other = (io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest) obj;
+
+      if (!getAlias()
+          .equals(other.getAlias())) return false;
+      if (getEnabled()
+          != other.getEnabled()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + ALIAS_FIELD_NUMBER;
+      hash = (53 * hash) + getAlias().hashCode();
+      hash = (37 * hash) + ENABLED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getEnabled());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SetTerminalRecordingRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SetTerminalRecordingRequest)
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        alias_ = "";
+
+        enabled_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest result = new io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest(this);
+        result.alias_ = alias_;
+        result.enabled_ = enabled_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.getDefaultInstance()) return this;
+        if (!other.getAlias().isEmpty()) {
+          alias_ = other.alias_;
+          onChanged();
+        }
+        if (other.getEnabled() != false) {
+          setEnabled(other.getEnabled());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object alias_ = "";
+      /**
+       * <code>string alias = 1;</code>
+       * @return The alias.
+       */
+      public java.lang.String getAlias() {
+        java.lang.Object ref = alias_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          alias_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @return The bytes for alias.
+       */
+      public com.google.protobuf.ByteString
+          getAliasBytes() {
+        java.lang.Object ref = alias_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          alias_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @param value The alias to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAlias(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        alias_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAlias() {
+
+        alias_ = getDefaultInstance().getAlias();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @param value The bytes for alias to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAliasBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        alias_ = value;
+        onChanged();
+        return this;
+      }
+
+      private boolean enabled_ ;
+      /**
+       * <code>bool enabled = 2;</code>
+       * @return The enabled.
+       */
+      @java.lang.Override
+      public boolean getEnabled() {
+        return enabled_;
+      }
+      /**
+       * <code>bool enabled = 2;</code>
+       * @param value The enabled to set.
+       * @return This builder for chaining.
+       */
+      public Builder setEnabled(boolean value) {
+
+        enabled_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bool enabled = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearEnabled() {
+
+        enabled_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SetTerminalRecordingRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SetTerminalRecordingRequest)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SetTerminalRecordingRequest>
+        PARSER = new com.google.protobuf.AbstractParser<SetTerminalRecordingRequest>() {
+      @java.lang.Override
+      public SetTerminalRecordingRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SetTerminalRecordingRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SetTerminalRecordingRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SetTerminalRecordingRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SetTerminalRecordingResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SetTerminalRecordingResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * file is the path of the recording, empty if recording was stopped
+     * </pre>
+     *
+     * <code>string file = 1;</code>
+     * @return The file.
+     */
+    java.lang.String getFile();
+    /**
+     * <pre>
+     * file is the path of the recording, empty if recording was stopped
+     * </pre>
+     *
+     * <code>string file = 1;</code>
+     * @return The bytes for file.
+     */
+    com.google.protobuf.ByteString
+        getFileBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.SetTerminalRecordingResponse}
+   */
+  public static final class SetTerminalRecordingResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SetTerminalRecordingResponse)
+      SetTerminalRecordingResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SetTerminalRecordingResponse.newBuilder() to construct.
+    private SetTerminalRecordingResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SetTerminalRecordingResponse() {
+      file_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SetTerminalRecordingResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SetTerminalRecordingResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              file_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.Builder.class);
+    }
+
+    public static final int FILE_FIELD_NUMBER = 1;
+    private volatile java.lang.Object file_;
+    /**
+     * <pre>
+     * file is the path of the recording, empty if recording was stopped
+     * </pre>
+     *
+     * <code>string file = 1;</code>
+     * @return The file.
+     */
+    @java.lang.Override
+    public java.lang.String getFile() {
+      java.lang.Object ref = file_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        file_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * file is the path of the recording, empty if recording was stopped
+     * </pre>
+     *
+     * <code>string file = 1;</code>
+     * @return The bytes for file.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getFileBytes() {
+      java.lang.Object ref = file_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        file_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(file_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, file_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(file_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, file_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse other = (io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse) obj;
+
+      if (!getFile()
+          .equals(other.getFile())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + FILE_FIELD_NUMBER;
+      hash = (53 * hash) + getFile().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SetTerminalRecordingResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SetTerminalRecordingResponse)
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        file_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_SetTerminalRecordingResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse result = new io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse(this);
+        result.file_ = file_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.getDefaultInstance()) return this;
+        if (!other.getFile().isEmpty()) {
+          file_ = other.file_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object file_ = "";
+      /**
+       * <pre>
+       * file is the path of the recording, empty if recording was stopped
+       * </pre>
+       *
+       * <code>string file = 1;</code>
+       * @return The file.
+       */
+      public java.lang.String getFile() {
+        java.lang.Object ref = file_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          file_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * file is the path of the recording, empty if recording was stopped
+       * </pre>
+       *
+       * <code>string file = 1;</code>
+       * @return The bytes for file.
+       */
+      public com.google.protobuf.ByteString
+          getFileBytes() {
+        java.lang.Object ref = file_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          file_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * file is the path of the recording, empty if recording was stopped
+       * </pre>
+       *
+       * <code>string file = 1;</code>
+       * @param value The file to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFile(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        file_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * file is the path of the recording, empty if recording was stopped
+       * </pre>
+       *
+       * <code>string file = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFile() {
+
+        file_ = getDefaultInstance().getFile();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * file is the path of the recording, empty if recording was stopped
+       * </pre>
+       *
+       * <code>string file = 1;</code>
+       * @param value The bytes for file to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFileBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        file_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SetTerminalRecordingResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SetTerminalRecordingResponse)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SetTerminalRecordingResponse>
+        PARSER = new com.google.protobuf.AbstractParser<SetTerminalRecordingResponse>() {
+      @java.lang.Override
+      public SetTerminalRecordingResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SetTerminalRecordingResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SetTerminalRecordingResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SetTerminalRecordingResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface UpdateTerminalAnnotationsRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.UpdateTerminalAnnotationsRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string alias = 1;</code>
+     * @return The alias.
+     */
+    java.lang.String getAlias();
+    /**
+     * <code>string alias = 1;</code>
+     * @return The bytes for alias.
+     */
+    com.google.protobuf.ByteString
+        getAliasBytes();
+
+    /**
+     * <pre>
+     * annotations to create or update
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; changed = 2;</code>
+     */
+    int getChangedCount();
+    /**
+     * <pre>
+     * annotations to create or update
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; changed = 2;</code>
+     */
+    boolean containsChanged(
+        java.lang.String key);
+    /**
+     * Use {@link #getChangedMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.String>
+    getChanged();
+    /**
+     * <pre>
+     * annotations to create or update
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; changed = 2;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.String>
+    getChangedMap();
+    /**
+     * <pre>
+     * annotations to create or update
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; changed = 2;</code>
+     */
+
+    /* nullable */
+java.lang.String getChangedOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue);
+    /**
+     * <pre>
+     * annotations to create or update
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; changed = 2;</code>
+     */
+
+    java.lang.String getChangedOrThrow(
+        java.lang.String key);
+
+    /**
+     * <pre>
+     * annotations to remove
+     * </pre>
+     *
+     * <code>repeated string deleted = 3;</code>
+     * @return A list containing the deleted.
+     */
+    java.util.List<java.lang.String>
+        getDeletedList();
+    /**
+     * <pre>
+     * annotations to remove
+     * </pre>
+     *
+     * <code>repeated string deleted = 3;</code>
+     * @return The count of deleted.
+     */
+    int getDeletedCount();
+    /**
+     * <pre>
+     * annotations to remove
+     * </pre>
+     *
+     * <code>repeated string deleted = 3;</code>
+     * @param index The index of the element to return.
+     * @return The deleted at the given index.
+     */
+    java.lang.String getDeleted(int index);
+    /**
+     * <pre>
+     * annotations to remove
+     * </pre>
+     *
+     * <code>repeated string deleted = 3;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the deleted at the given index.
+     */
+    com.google.protobuf.ByteString
+        getDeletedBytes(int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.UpdateTerminalAnnotationsRequest}
+   */
+  public static final class UpdateTerminalAnnotationsRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.UpdateTerminalAnnotationsRequest)
+      UpdateTerminalAnnotationsRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use UpdateTerminalAnnotationsRequest.newBuilder() to construct.
+    private UpdateTerminalAnnotationsRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private UpdateTerminalAnnotationsRequest() {
+      alias_ = "";
+      deleted_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new UpdateTerminalAnnotationsRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private UpdateTerminalAnnotationsRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              alias_ = s;
+              break;
+            }
+            case 18: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                changed_ = com.google.protobuf.MapField.newMapField(
+                    ChangedDefaultEntryHolder.defaultEntry);
+                mutable_bitField0_ |= 0x00000001;
+              }
+              com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+              changed__ = input.readMessage(
+                  ChangedDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+              changed_.getMutableMap().put(
+                  changed__.getKey(), changed__.getValue());
+              break;
+            }
+            case 26: {
+              java.lang.String s = input.readStringRequireUtf8();
+              if (!((mutable_bitField0_ & 0x00000002) != 0)) {
+                deleted_ = new com.google.protobuf.LazyStringArrayList();
+                mutable_bitField0_ |= 0x00000002;
+              }
+              deleted_.add(s);
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000002) != 0)) {
+          deleted_ = deleted_.getUnmodifiableView();
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UpdateTerminalAnnotationsRequest_descriptor;
+    }
+
+    @SuppressWarnings({"rawtypes"})
     @java.lang.Override
     protected com.google.protobuf.MapField internalGetMapField(
         int number) {
@@ -15186,6 +16619,16 @@ java.lang.String defaultValue);
   private static final
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_supervisor_SetTerminalTitleResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SetTerminalRecordingRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SetTerminalRecordingRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SetTerminalRecordingResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SetTerminalRecordingResponse_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_supervisor_UpdateTerminalAnnotationsRequest_descriptor;
   private static final
@@ -15225,63 +16668,69 @@ java.lang.String defaultValue);
       "penTerminalResponse\022&\n\010terminal\030\001 \001(\0132\024." +
       "supervisor.Terminal\022\025\n\rstarter_token\030\002 \001" +
       "(\t\"(\n\027ShutdownTerminalRequest\022\r\n\005alias\030\001" +
-      " \001(\t\"\032\n\030ShutdownTerminalResponse\"\237\002\n\010Ter" +
+      " \001(\t\"\032\n\030ShutdownTerminalResponse\"\262\002\n\010Ter" +
       "minal\022\r\n\005alias\030\001 \001(\t\022\017\n\007command\030\002 \003(\t\022\r\n" +
       "\005title\030\003 \001(\t\022\013\n\003pid\030\004 \001(\003\022\027\n\017initial_wor" +
       "kdir\030\005 \001(\t\022\027\n\017current_workdir\030\006 \001(\t\022:\n\013a" +
       "nnotations\030\007 \003(\0132%.supervisor.Terminal.A" +
       "nnotationsEntry\0225\n\014title_source\030\010 \001(\0162\037." +
-      "supervisor.TerminalTitleSource\0322\n\020Annota" +
-      "tionsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\002" +
-      "8\001\"#\n\022GetTerminalRequest\022\r\n\005alias\030\001 \001(\t\"" +
-      "\026\n\024ListTerminalsRequest\"@\n\025ListTerminals" +
-      "Response\022\'\n\tterminals\030\001 \003(\0132\024.supervisor" +
-      ".Terminal\"&\n\025ListenTerminalRequest\022\r\n\005al" +
-      "ias\030\001 \001(\t\"\217\001\n\026ListenTerminalResponse\022\016\n\004" +
-      "data\030\001 \001(\014H\000\022\023\n\texit_code\030\002 \001(\005H\000\022\017\n\005tit" +
-      "le\030\003 \001(\tH\000\0225\n\014title_source\030\004 \001(\0162\037.super" +
-      "visor.TerminalTitleSourceB\010\n\006output\"4\n\024W" +
-      "riteTerminalRequest\022\r\n\005alias\030\001 \001(\t\022\r\n\005st" +
-      "din\030\002 \001(\014\".\n\025WriteTerminalResponse\022\025\n\rby" +
-      "tes_written\030\001 \001(\r\"}\n\026SetTerminalSizeRequ" +
-      "est\022\r\n\005alias\030\001 \001(\t\022\017\n\005token\030\002 \001(\tH\000\022\017\n\005f" +
-      "orce\030\003 \001(\010H\000\022&\n\004size\030\004 \001(\0132\030.supervisor." +
-      "TerminalSizeB\n\n\010priority\"\031\n\027SetTerminalS" +
-      "izeResponse\"7\n\027SetTerminalTitleRequest\022\r" +
-      "\n\005alias\030\001 \001(\t\022\r\n\005title\030\002 \001(\t\"\032\n\030SetTermi" +
-      "nalTitleResponse\"\276\001\n UpdateTerminalAnnot" +
-      "ationsRequest\022\r\n\005alias\030\001 \001(\t\022J\n\007changed\030" +
-      "\002 \003(\01329.supervisor.UpdateTerminalAnnotat" +
-      "ionsRequest.ChangedEntry\022\017\n\007deleted\030\003 \003(" +
-      "\t\032.\n\014ChangedEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030" +
-      "\002 \001(\t:\0028\001\"#\n!UpdateTerminalAnnotationsRe" +
-      "sponse*+\n\023TerminalTitleSource\022\013\n\007process" +
-      "\020\000\022\007\n\003api\020\0012\260\007\n\017TerminalService\022K\n\004Open\022" +
-      "\037.supervisor.OpenTerminalRequest\032 .super" +
-      "visor.OpenTerminalResponse\"\000\022|\n\010Shutdown" +
-      "\022#.supervisor.ShutdownTerminalRequest\032$." +
-      "supervisor.ShutdownTerminalResponse\"%\202\323\344" +
-      "\223\002\037\022\035/v1/terminal/shutdown/{alias}\022]\n\003Ge" +
-      "t\022\036.supervisor.GetTerminalRequest\032\024.supe" +
-      "rvisor.Terminal\" \202\323\344\223\002\032\022\030/v1/terminal/ge" +
-      "t/{alias}\022f\n\004List\022 .supervisor.ListTermi" +
-      "nalsRequest\032!.supervisor.ListTerminalsRe" +
-      "sponse\"\031\202\323\344\223\002\023\022\021/v1/terminal/list\022v\n\006Lis" +
-      "ten\022!.supervisor.ListenTerminalRequest\032\"" +
-      ".supervisor.ListenTerminalResponse\"#\202\323\344\223" +
-      "\002\035\022\033/v1/terminal/listen/{alias}0\001\022p\n\005Wri" +
-      "te\022 .supervisor.WriteTerminalRequest\032!.s" +
-      "upervisor.WriteTerminalResponse\"\"\202\323\344\223\002\034\"" +
-      "\032/v1/terminal/write/{alias}\022T\n\007SetSize\022\"" +
-      ".supervisor.SetTerminalSizeRequest\032#.sup" +
-      "ervisor.SetTerminalSizeResponse\"\000\022W\n\010Set" +
-      "Title\022#.supervisor.SetTerminalTitleReque" +
-      "st\032$.supervisor.SetTerminalTitleResponse" +
-      "\"\000\022r\n\021UpdateAnnotations\022,.supervisor.Upd" +
-      "ateTerminalAnnotationsRequest\032-.supervis" +
-      "or.UpdateTerminalAnnotationsResponse\"\000BF" +
-      "\n\030io.gitpod.supervisor.apiZ*github.com/g" +
-      "itpod-io/gitpod/supervisor/apib\006proto3"
+      "supervisor.TerminalTitleSource\022\021\n\trecord" +
+      "ing\030\t \001(\t\0322\n\020AnnotationsEntry\022\013\n\003key\030\001 \001" +
+      "(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"#\n\022GetTerminalRequ" +
+      "est\022\r\n\005alias\030\001 \001(\t\"\026\n\024ListTerminalsReque" +
+      "st\"@\n\025ListTerminalsResponse\022\'\n\tterminals" +
+      "\030\001 \003(\0132\024.supervisor.Terminal\"&\n\025ListenTe" +
+      "rminalRequest\022\r\n\005alias\030\001 \001(\t\"\217\001\n\026ListenT" +
+      "erminalResponse\022\016\n\004data\030\001 \001(\014H\000\022\023\n\texit_" +
+      "code\030\002 \001(\005H\000\022\017\n\005title\030\003 \001(\tH\000\0225\n\014title_s" +
+      "ource\030\004 \001(\0162\037.supervisor.TerminalTitleSo" +
+      "urceB\010\n\006output\"4\n\024WriteTerminalRequest\022\r" +
+      "\n\005alias\030\001 \001(\t\022\r\n\005stdin\030\002 \001(\014\".\n\025WriteTer" +
+      "minalResponse\022\025\n\rbytes_written\030\001 \001(\r\"}\n\026" +
+      "SetTerminalSizeRequest\022\r\n\005alias\030\001 \001(\t\022\017\n" +
+      "\005token\030\002 \001(\tH\000\022\017\n\005force\030\003 \001(\010H\000\022&\n\004size\030" +
+      "\004 \001(\0132\030.supervisor.TerminalSizeB\n\n\010prior" +
+      "ity\"\031\n\027SetTerminalSizeResponse\"7\n\027SetTer" +
+      "minalTitleRequest\022\r\n\005alias\030\001 \001(\t\022\r\n\005titl" +
+      "e\030\002 \001(\t\"\032\n\030SetTerminalTitleResponse\"=\n\033S" +
+      "etTerminalRecordingRequest\022\r\n\005alias\030\001 \001(" +
+      "\t\022\017\n\007enabled\030\002 \001(\010\",\n\034SetTerminalRecordi" +
+      "ngResponse\022\014\n\004file\030\001 \001(\t\"\276\001\n UpdateTermi" +
+      "nalAnnotationsRequest\022\r\n\005alias\030\001 \001(\t\022J\n\007" +
+      "changed\030\002 \003(\01329.supervisor.UpdateTermina" +
+      "lAnnotationsRequest.ChangedEntry\022\017\n\007dele" +
+      "ted\030\003 \003(\t\032.\n\014ChangedEntry\022\013\n\003key\030\001 \001(\t\022\r" +
+      "\n\005value\030\002 \001(\t:\0028\001\"#\n!UpdateTerminalAnnot" +
+      "ationsResponse*+\n\023TerminalTitleSource\022\013\n" +
+      "\007process\020\000\022\007\n\003api\020\0012\225\010\n\017TerminalService\022" +
+      "K\n\004Open\022\037.supervisor.OpenTerminalRequest" +
+      "\032 .supervisor.OpenTerminalResponse\"\000\022|\n\010" +
+      "Shutdown\022#.supervisor.ShutdownTerminalRe" +
+      "quest\032$.supervisor.ShutdownTerminalRespo" +
+      "nse\"%\202\323\344\223\002\037\022\035/v1/terminal/shutdown/{alia" +
+      "s}\022]\n\003Get\022\036.supervisor.GetTerminalReques" +
+      "t\032\024.supervisor.Terminal\" \202\323\344\223\002\032\022\030/v1/ter" +
+      "minal/get/{alias}\022f\n\004List\022 .supervisor.L" +
+      "istTerminalsRequest\032!.supervisor.ListTer" +
+      "minalsResponse\"\031\202\323\344\223\002\023\022\021/v1/terminal/lis" +
+      "t\022v\n\006Listen\022!.supervisor.ListenTerminalR" +
+      "equest\032\".supervisor.ListenTerminalRespon" +
+      "se\"#\202\323\344\223\002\035\022\033/v1/terminal/listen/{alias}0" +
+      "\001\022p\n\005Write\022 .supervisor.WriteTerminalReq" +
+      "uest\032!.supervisor.WriteTerminalResponse\"" +
+      "\"\202\323\344\223\002\034\"\032/v1/terminal/write/{alias}\022T\n\007S" +
+      "etSize\022\".supervisor.SetTerminalSizeReque" +
+      "st\032#.supervisor.SetTerminalSizeResponse\"" +
+      "\000\022W\n\010SetTitle\022#.supervisor.SetTerminalTi" +
+      "tleRequest\032$.supervisor.SetTerminalTitle" +
+      "Response\"\000\022r\n\021UpdateAnnotations\022,.superv" +
+      "isor.UpdateTerminalAnnotationsRequest\032-." +
+      "supervisor.UpdateTerminalAnnotationsResp" +
+      "onse\"\000\022c\n\014SetRecording\022\'.supervisor.SetT" +
+      "erminalRecordingRequest\032(.supervisor.Set" +
+      "TerminalRecordingResponse\"\000BF\n\030io.gitpod" +
+      ".supervisor.apiZ*github.com/gitpod-io/gi" +
+      "tpod/supervisor/apib\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -15335,7 +16784,7 @@ java.lang.String defaultValue);
     internal_static_supervisor_Terminal_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_Terminal_descriptor,
-        new java.lang.String[] { "Alias", "Command", "Title", "Pid", "InitialWorkdir", "CurrentWorkdir", "Annotations", "TitleSource", });
+        new java.lang.String[] { "Alias", "Command", "Title", "Pid", "InitialWorkdir", "CurrentWorkdir", "Annotations", "TitleSource", "Recording", });
     internal_static_supervisor_Terminal_AnnotationsEntry_descriptor =
       internal_static_supervisor_Terminal_descriptor.getNestedTypes().get(0);
     internal_static_supervisor_Terminal_AnnotationsEntry_fieldAccessorTable = new
@@ -15408,8 +16857,20 @@ java.lang.String defaultValue);
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_SetTerminalTitleResponse_descriptor,
         new java.lang.String[] { });
-    internal_static_supervisor_UpdateTerminalAnnotationsRequest_descriptor =
+    internal_static_supervisor_SetTerminalRecordingRequest_descriptor =
       getDescriptor().getMessageTypes().get(17);
+    internal_static_supervisor_SetTerminalRecordingRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SetTerminalRecordingRequest_descriptor,
+        new java.lang.String[] { "Alias", "Enabled", });
+    internal_static_supervisor_SetTerminalRecordingResponse_descriptor =
+      getDescriptor().getMessageTypes().get(18);
+    internal_static_supervisor_SetTerminalRecordingResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SetTerminalRecordingResponse_descriptor,
+        new java.lang.String[] { "File", });
+    internal_static_supervisor_UpdateTerminalAnnotationsRequest_descriptor =
+      getDescriptor().getMessageTypes().get(19);
     internal_static_supervisor_UpdateTerminalAnnotationsRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_UpdateTerminalAnnotationsRequest_descriptor,
@@ -15421,7 +16882,7 @@ java.lang.String defaultValue);
         internal_static_supervisor_UpdateTerminalAnnotationsRequest_ChangedEntry_descriptor,
         new java.lang.String[] { "Key", "Value", });
     internal_static_supervisor_UpdateTerminalAnnotationsResponse_descriptor =
-      getDescriptor().getMessageTypes().get(18);
+      getDescriptor().getMessageTypes().get(20);
     internal_static_supervisor_UpdateTerminalAnnotationsResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_UpdateTerminalAnnotationsResponse_descriptor,
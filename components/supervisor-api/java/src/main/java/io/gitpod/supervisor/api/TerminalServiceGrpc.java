@@ -298,6 +298,37 @@ public final class TerminalServiceGrpc {
     return getUpdateAnnotationsMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse> getSetRecordingMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "SetRecording",
+      requestType = io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.class,
+      responseType = io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse> getSetRecordingMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest, io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse> getSetRecordingMethod;
+    if ((getSetRecordingMethod = TerminalServiceGrpc.getSetRecordingMethod) == null) {
+      synchronized (TerminalServiceGrpc.class) {
+        if ((getSetRecordingMethod = TerminalServiceGrpc.getSetRecordingMethod) == null) {
+          TerminalServiceGrpc.getSetRecordingMethod = getSetRecordingMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest, io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "SetRecording"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TerminalServiceMethodDescriptorSupplier("SetRecording"))
+              .build();
+        }
+      }
+    }
+    return getSetRecordingMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -437,6 +468,16 @@ public final class TerminalServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getUpdateAnnotationsMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * SetRecording starts or stops recording the terminal in the asciicast v2 format
+     * </pre>
+     */
+    public void setRecording(io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getSetRecordingMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -502,6 +543,13 @@ public final class TerminalServiceGrpc {
                 io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsRequest,
                 io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsResponse>(
                   this, METHODID_UPDATE_ANNOTATIONS)))
+          .addMethod(
+            getSetRecordingMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest,
+                io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse>(
+                  this, METHODID_SET_RECORDING)))
           .build();
     }
   }
@@ -619,6 +667,17 @@ public final class TerminalServiceGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getUpdateAnnotationsMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     * <pre>
+     * SetRecording starts or stops recording the terminal in the asciicast v2 format
+     * </pre>
+     */
+    public void setRecording(io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getSetRecordingMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -726,6 +785,16 @@ public final class TerminalServiceGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getUpdateAnnotationsMethod(), getCallOptions(), request);
     }
+
+    /**
+     * <pre>
+     * SetRecording starts or stops recording the terminal in the asciicast v2 format
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse setRecording(io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getSetRecordingMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -830,6 +899,17 @@ public final class TerminalServiceGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getUpdateAnnotationsMethod(), getCallOptions()), request);
     }
+
+    /**
+     * <pre>
+     * SetRecording starts or stops recording the terminal in the asciicast v2 format
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse> setRecording(
+        io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getSetRecordingMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_OPEN = 0;
@@ -841,6 +921,7 @@ public final class TerminalServiceGrpc {
   private static final int METHODID_SET_SIZE = 6;
   private static final int METHODID_SET_TITLE = 7;
   private static final int METHODID_UPDATE_ANNOTATIONS = 8;
+  private static final int METHODID_SET_RECORDING = 9;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -895,6 +976,10 @@ public final class TerminalServiceGrpc {
           serviceImpl.updateAnnotations((io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsResponse>) responseObserver);
           break;
+        case METHODID_SET_RECORDING:
+          serviceImpl.setRecording((io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.SetTerminalRecordingResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -965,6 +1050,7 @@ public final class TerminalServiceGrpc {
               .addMethod(getSetSizeMethod())
               .addMethod(getSetTitleMethod())
               .addMethod(getUpdateAnnotationsMethod())
+              .addMethod(getSetRecordingMethod())
               .build();
         }
       }
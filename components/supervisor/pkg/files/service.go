@@ -0,0 +1,529 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package files
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// maxReadSize is the maximum number of bytes returned by a single read
+	maxReadSize = 1 << 20
+	// defaultFileMode are the permissions of files created by a write
+	defaultFileMode = 0644
+)
+
+// NewFileService creates a new file service which provides access to the files below root.
+func NewFileService(root string) *FileService {
+	return &FileService{
+		Root: root,
+	}
+}
+
+// FileService implements the file service API.
+type FileService struct {
+	// Root is the directory clients have access to
+	Root string
+	// Creds are the credentials file operations are performed with. Clients cannot access files
+	// the workspace user has no permission for, even if a path is replaced by a symlink after it was resolved.
+	// Only the supplementary groups listed in Creds.Groups apply, hence an empty list grants no group access beyond Creds.Gid.
+	Creds *syscall.Credential
+
+	api.UnimplementedFileServiceServer
+}
+
+// RegisterGRPC registers a gRPC service.
+func (srv *FileService) RegisterGRPC(s *grpc.Server) {
+	api.RegisterFileServiceServer(s, srv)
+}
+
+// RegisterREST registers a REST service.
+func (srv *FileService) RegisterREST(mux *gwruntime.ServeMux, grpcEndpoint string) error {
+	return api.RegisterFileServiceHandlerFromEndpoint(context.Background(), mux, grpcEndpoint, []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
+}
+
+// Stat returns information about a file or directory.
+func (srv *FileService) Stat(ctx context.Context, req *api.StatFileRequest) (*api.StatFileResponse, error) {
+	return asUser(srv.Creds, func() (*api.StatFileResponse, error) {
+		return srv.statFile(req)
+	})
+}
+
+func (srv *FileService) statFile(req *api.StatFileRequest) (*api.StatFileResponse, error) {
+	fn, err := srv.resolve(req.Path, false)
+	if err != nil {
+		return nil, err
+	}
+	info, err := srv.stat(fn)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &api.StatFileResponse{File: info}, nil
+}
+
+// Read reads the content of a file, or a range of it.
+func (srv *FileService) Read(ctx context.Context, req *api.ReadFileRequest) (*api.ReadFileResponse, error) {
+	return asUser(srv.Creds, func() (*api.ReadFileResponse, error) {
+		return srv.readFile(req)
+	})
+}
+
+func (srv *FileService) readFile(req *api.ReadFileRequest) (*api.ReadFileResponse, error) {
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+	fn, err := srv.resolve(req.Path, true)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if stat.IsDir() {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is a directory", req.Path)
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxReadSize {
+		limit = maxReadSize
+	}
+	content := make([]byte, limit)
+	n, err := f.ReadAt(content, req.Offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, toStatus(err)
+	}
+	return &api.ReadFileResponse{
+		Content: content[:n],
+		Size:    stat.Size(),
+	}, nil
+}
+
+// Write replaces the content of a file atomically.
+func (srv *FileService) Write(ctx context.Context, req *api.WriteFileRequest) (*api.WriteFileResponse, error) {
+	return asUser(srv.Creds, func() (*api.WriteFileResponse, error) {
+		return srv.writeFile(req)
+	})
+}
+
+func (srv *FileService) writeFile(req *api.WriteFileRequest) (*api.WriteFileResponse, error) {
+	fn, err := srv.resolve(req.Path, true)
+	if err != nil {
+		return nil, err
+	}
+	if fn == filepath.Clean(srv.Root) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is a directory", req.Path)
+	}
+
+	dir := filepath.Dir(fn)
+	if req.CreateParents {
+		err = os.MkdirAll(dir, 0755)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	mode := fs.FileMode(defaultFileMode)
+	if req.Mode != 0 {
+		mode = fs.FileMode(req.Mode) & fs.ModePerm
+	}
+	stat, err := os.Stat(fn)
+	if err == nil && stat.IsDir() {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is a directory", req.Path)
+	}
+	existing := err == nil
+	if existing {
+		mode = stat.Mode().Perm()
+	}
+
+	// we write to a temporary file which we rename, s.t. readers observe either the previous or the new content
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(fn)+".*.tmp")
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(req.Content)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), mode)
+	}
+	if err == nil && existing {
+		err = chownLike(tmp.Name(), stat)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), fn)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	info, err := srv.stat(fn)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &api.WriteFileResponse{File: info}, nil
+}
+
+// List lists the entries of a directory.
+func (srv *FileService) List(ctx context.Context, req *api.ListFilesRequest) (*api.ListFilesResponse, error) {
+	return asUser(srv.Creds, func() (*api.ListFilesResponse, error) {
+		return srv.listFile(req)
+	})
+}
+
+func (srv *FileService) listFile(req *api.ListFilesRequest) (*api.ListFilesResponse, error) {
+	fn, err := srv.resolve(req.Path, true)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(fn)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	files := make([]*api.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := srv.stat(filepath.Join(fn, entry.Name()))
+		if errors.Is(err, fs.ErrNotExist) {
+			// the entry was removed in the meantime
+			continue
+		}
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		files = append(files, info)
+	}
+	return &api.ListFilesResponse{Files: files}, nil
+}
+
+// Delete deletes a file or directory.
+func (srv *FileService) Delete(ctx context.Context, req *api.DeleteFileRequest) (*api.DeleteFileResponse, error) {
+	return asUser(srv.Creds, func() (*api.DeleteFileResponse, error) {
+		return srv.deleteFile(req)
+	})
+}
+
+func (srv *FileService) deleteFile(req *api.DeleteFileRequest) (*api.DeleteFileResponse, error) {
+	fn, err := srv.resolve(req.Path, false)
+	if err != nil {
+		return nil, err
+	}
+	if fn == filepath.Clean(srv.Root) {
+		return nil, status.Error(codes.PermissionDenied, "cannot delete the workspace location")
+	}
+
+	_, err = os.Lstat(fn)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if req.Recursive {
+		err = os.RemoveAll(fn)
+	} else {
+		err = os.Remove(fn)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &api.DeleteFileResponse{}, nil
+}
+
+// Watch streams the changes of the entries of a directory.
+func (srv *FileService) Watch(req *api.WatchFilesRequest, resp api.FileService_WatchServer) error {
+	_, err := asUser(srv.Creds, func() (any, error) {
+		return nil, srv.watch(req, resp)
+	})
+	return err
+}
+
+func (srv *FileService) watch(req *api.WatchFilesRequest, resp api.FileService_WatchServer) error {
+	fn, err := srv.resolve(req.Path, true)
+	if err != nil {
+		return err
+	}
+	stat, err := os.Stat(fn)
+	if err != nil {
+		return toStatus(err)
+	}
+	if !stat.IsDir() {
+		return status.Errorf(codes.FailedPrecondition, "%s is not a directory", req.Path)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return toStatus(err)
+	}
+	defer watcher.Close()
+
+	watch := func(dir string) error {
+		if !req.Recursive {
+			return watcher.Add(dir)
+		}
+		return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				return nil
+			}
+			err = watcher.Add(path)
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		})
+	}
+	err = watch(fn)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	ctx := resp.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.Errors:
+			return toStatus(err)
+		case ev := <-watcher.Events:
+			var tpe api.FileChangeType
+			switch {
+			case ev.Op&fsnotify.Create != 0:
+				tpe = api.FileChangeType_created
+				if req.Recursive {
+					if stat, err := os.Lstat(ev.Name); err == nil && stat.IsDir() {
+						err = watch(ev.Name)
+						if err != nil {
+							return toStatus(err)
+						}
+					}
+				}
+			case ev.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+				// renamed files are reported as created at their new location
+				tpe = api.FileChangeType_deleted
+			case ev.Op&fsnotify.Write != 0:
+				tpe = api.FileChangeType_modified
+			default:
+				continue
+			}
+			err = resp.Send(&api.WatchFilesResponse{
+				Type: tpe,
+				Path: ev.Name,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// resolve returns the absolute path of a file below root.
+// Symlinks are resolved s.t. they cannot point outside of root. If followLast is false,
+// the last element of the path is not resolved, i.e. operations apply to the symlink itself.
+func (srv *FileService) resolve(path string, followLast bool) (string, error) {
+	location := filepath.Clean(srv.Root)
+	root, err := filepath.EvalSymlinks(location)
+	if err != nil {
+		return "", toStatus(err)
+	}
+
+	fn := path
+	if !filepath.IsAbs(fn) {
+		fn = filepath.Join(location, fn)
+	}
+	fn = filepath.Clean(fn)
+	if !isBelow(location, fn) {
+		return "", status.Errorf(codes.PermissionDenied, "%s is outside of the workspace location", path)
+	}
+	if fn == location {
+		return location, nil
+	}
+
+	var base string
+	if !followLast {
+		fn, base = filepath.Split(fn)
+		fn = filepath.Clean(fn)
+	}
+
+	// resolve the existing part of the path, files which don't exist yet cannot be symlinks
+	existing, missing := fn, ""
+	for {
+		_, err := os.Lstat(existing)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", toStatus(err)
+		}
+		missing = filepath.Join(filepath.Base(existing), missing)
+		existing = filepath.Dir(existing)
+	}
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", toStatus(err)
+	}
+	resolved = filepath.Join(resolved, missing, base)
+	if !isBelow(root, resolved) {
+		return "", status.Errorf(codes.PermissionDenied, "%s is outside of the workspace location", path)
+	}
+
+	// we keep paths relative to root as clients know it
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil {
+		return "", toStatus(err)
+	}
+	return filepath.Join(location, rel), nil
+}
+
+func isBelow(root, fn string) bool {
+	rel, err := filepath.Rel(root, fn)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (srv *FileService) stat(fn string) (*api.FileInfo, error) {
+	stat, err := os.Lstat(fn)
+	if err != nil {
+		return nil, err
+	}
+	info := &api.FileInfo{
+		Path:      fn,
+		Name:      filepath.Base(fn),
+		Size:      stat.Size(),
+		Mode:      uint32(stat.Mode().Perm()),
+		IsDir:     stat.IsDir(),
+		IsSymlink: stat.Mode()&fs.ModeSymlink != 0,
+		Modified:  timestamppb.New(stat.ModTime()),
+	}
+	if info.IsSymlink {
+		// clients are interested in whether they can list or read symlinks
+		if target, err := os.Stat(fn); err == nil {
+			info.IsDir = target.IsDir()
+		}
+	}
+	return info, nil
+}
+
+// chownLike preserves the owner of a file which is replaced
+func chownLike(fn string, stat fs.FileInfo) error {
+	sys, ok := stat.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	return chown(fn, int(sys.Uid), int(sys.Gid))
+}
+
+func chown(fn string, uid, gid int) error {
+	err := os.Chown(fn, uid, gid)
+	if errors.Is(err, fs.ErrPermission) {
+		// we can only change the owner if we're privileged
+		log.WithField("file", fn).Debug("cannot change the owner of the file")
+		return nil
+	}
+	return err
+}
+
+// asUser runs op on a dedicated thread with the file system credentials of creds.
+func asUser[T any](creds *syscall.Credential, op func() (T, error)) (T, error) {
+	if creds == nil {
+		return op()
+	}
+
+	type result struct {
+		res T
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		// we never unlock the thread, s.t. it is terminated once this goroutine exits
+		// and the credentials don't leak to other goroutines
+		runtime.LockOSThread()
+
+		var res result
+		res.err = setFSCreds(creds)
+		if res.err == nil {
+			res.res, res.err = op()
+		}
+		done <- res
+	}()
+	res := <-done
+	return res.res, res.err
+}
+
+// setFSCreds changes the credentials of the current thread which are used for file system access checks
+func setFSCreds(creds *syscall.Credential) error {
+	groups := make([]int, 0, len(creds.Groups))
+	for _, g := range creds.Groups {
+		groups = append(groups, int(g))
+	}
+	// unlike syscall.Setgroups, this only applies to the current thread
+	err := unix.Setgroups(groups)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot change supplementary groups: %v", err)
+	}
+	// setfsuid and setfsgid don't report failures, but return the previous value
+	unix.Setfsgid(int(creds.Gid))
+	unix.Setfsuid(int(creds.Uid))
+	if gid, _ := unix.SetfsgidRetGid(-1); gid != int(creds.Gid) {
+		return status.Errorf(codes.Internal, "cannot change file system gid to %d", creds.Gid)
+	}
+	if uid, _ := unix.SetfsuidRetUid(-1); uid != int(creds.Uid) {
+		return status.Errorf(codes.Internal, "cannot change file system uid to %d", creds.Uid)
+	}
+	return nil
+}
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, syscall.ENOTDIR), errors.Is(err, syscall.EISDIR), errors.Is(err, syscall.ENOTEMPTY):
+		// checked first, ENOTEMPTY also matches fs.ErrExist
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, fs.ErrNotExist):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, fs.ErrExist):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, fs.ErrPermission):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, syscall.ENOSPC):
+		// also returned if the inotify watch limit is reached
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package files
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func TestResolve(t *testing.T) {
+	var (
+		outside = t.TempDir()
+		root    = t.TempDir()
+		srv     = NewFileService(root)
+	)
+	err := os.Symlink(outside, filepath.Join(root, "outside"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Mkdir(filepath.Join(root, "dir"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Symlink(filepath.Join(root, "dir"), filepath.Join(root, "inside"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Symlink(filepath.Join(root, "missing"), filepath.Join(root, "dangling"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Path        string
+		FollowLast  bool
+		Expectation string
+		Code        codes.Code
+	}{
+		{Path: "", Expectation: root},
+		{Path: "dir/file", FollowLast: true, Expectation: filepath.Join(root, "dir/file")},
+		{Path: filepath.Join(root, "dir/../file"), FollowLast: true, Expectation: filepath.Join(root, "file")},
+		{Path: "inside/file", FollowLast: true, Expectation: filepath.Join(root, "dir/file")},
+		{Path: "inside", FollowLast: false, Expectation: filepath.Join(root, "inside")},
+		{Path: "outside", FollowLast: false, Expectation: filepath.Join(root, "outside")},
+		{Path: "dangling/file", FollowLast: true, Code: codes.NotFound},
+		{Path: "../file", FollowLast: true, Code: codes.PermissionDenied},
+		{Path: filepath.Join(outside, "file"), FollowLast: true, Code: codes.PermissionDenied},
+		{Path: "outside", FollowLast: true, Code: codes.PermissionDenied},
+		{Path: "outside/file", FollowLast: false, Code: codes.PermissionDenied},
+	}
+	for _, test := range tests {
+		t.Run(test.Path, func(t *testing.T) {
+			act, err := srv.resolve(test.Path, test.FollowLast)
+			if code := status.Code(err); code != test.Code {
+				t.Fatalf("unexpected error code: want %s, got %s (%v)", test.Code, code, err)
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected path: want %s, got %s", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestFileService(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	srv := NewFileService(root)
+
+	_, err := srv.Write(ctx, &api.WriteFileRequest{Path: "dir/file", Content: []byte("hello")})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("expected writing without parents to fail with not found, got %v", err)
+	}
+	written, err := srv.Write(ctx, &api.WriteFileRequest{Path: "dir/file", Content: []byte("hello world"), CreateParents: true, Mode: 0600})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written.File.Path != filepath.Join(root, "dir/file") || written.File.Size != 11 || written.File.Mode != 0600 {
+		t.Errorf("unexpected file info: %v", written.File)
+	}
+	_, err = srv.Write(ctx, &api.WriteFileRequest{Path: "dir/file", Content: []byte("hello gitpod"), Mode: 0644})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := srv.Read(ctx, &api.ReadFileRequest{Path: "dir/file", Offset: 6, Limit: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(read.Content) != "git" || read.Size != 12 {
+		t.Errorf("unexpected read: %q of %d bytes", read.Content, read.Size)
+	}
+	read, err = srv.Read(ctx, &api.ReadFileRequest{Path: filepath.Join(root, "dir/file")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(read.Content) != "hello gitpod" {
+		t.Errorf("unexpected read: %q", read.Content)
+	}
+
+	stat, err := srv.Stat(ctx, &api.StatFileRequest{Path: "dir/file"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.File.Mode != 0600 {
+		t.Errorf("existing file did not keep its permissions: %o", stat.File.Mode)
+	}
+
+	_, err = srv.Write(ctx, &api.WriteFileRequest{Path: "dir/other", Content: []byte("other")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := srv.List(ctx, &api.ListFilesRequest{Path: "dir"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range list.Files {
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	if diff := cmp.Diff([]string{"file", "other"}, names); diff != "" {
+		t.Errorf("unexpected files (-want +got):\n%s", diff)
+	}
+
+	_, err = srv.Delete(ctx, &api.DeleteFileRequest{Path: "dir"})
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Errorf("expected deleting a non-empty directory to fail, got %v", err)
+	}
+	_, err = srv.Delete(ctx, &api.DeleteFileRequest{Path: "dir", Recursive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = srv.Stat(ctx, &api.StatFileRequest{Path: "dir"})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("expected deleted directory to be not found, got %v", err)
+	}
+	_, err = srv.Delete(ctx, &api.DeleteFileRequest{Path: "", Recursive: true})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Errorf("expected deleting the workspace location to be denied, got %v", err)
+	}
+}
+
+func TestFileServiceCreds(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("changing the file system credentials requires root")
+	}
+
+	const nobody = 65534
+	ctx := context.Background()
+	root := t.TempDir()
+	// the parent of the temporary directory is only accessible by root
+	err := os.Chmod(filepath.Dir(root), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Mkdir(filepath.Join(root, "user"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chown(filepath.Join(root, "user"), nobody, nobody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(root, "secret"), []byte("secret"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := NewFileService(root)
+	srv.Creds = &syscall.Credential{Uid: nobody, Gid: nobody}
+
+	_, err = srv.Read(ctx, &api.ReadFileRequest{Path: "secret"})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Errorf("expected reading a file of another user to be denied, got %v", err)
+	}
+	_, err = srv.Write(ctx, &api.WriteFileRequest{Path: "file", Content: []byte("hello")})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Errorf("expected writing to a directory of another user to be denied, got %v", err)
+	}
+	_, err = srv.Write(ctx, &api.WriteFileRequest{Path: "user/dir/file", Content: []byte("hello"), CreateParents: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fn := range []string{"user/dir", "user/dir/file"} {
+		stat, err := os.Stat(filepath.Join(root, fn))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sys := stat.Sys().(*syscall.Stat_t); sys.Uid != nobody || sys.Gid != nobody {
+			t.Errorf("%s is owned by %d:%d, expected %d:%d", fn, sys.Uid, sys.Gid, nobody, nobody)
+		}
+	}
+
+	// the credentials must not leak to other goroutines
+	err = os.WriteFile(filepath.Join(root, "file"), []byte("hello"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+type testWatchServer struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	changes chan *api.WatchFilesResponse
+}
+
+func (s *testWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchServer) Send(resp *api.WatchFilesResponse) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.changes <- resp:
+		return nil
+	}
+}
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	root := t.TempDir()
+	srv := NewFileService(root)
+	stream := &testWatchServer{ctx: ctx, changes: make(chan *api.WatchFilesResponse, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Watch(&api.WatchFilesRequest{Recursive: true}, stream)
+	}()
+
+	expect := func(tpe api.FileChangeType, fn string) {
+		t.Helper()
+		for {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("did not observe %s of %s", tpe, fn)
+			case change := <-stream.changes:
+				if change.Type == tpe && change.Path == filepath.Join(root, fn) {
+					return
+				}
+			}
+		}
+	}
+
+	// give the watcher time to start
+	time.Sleep(100 * time.Millisecond)
+	err := os.Mkdir(filepath.Join(root, "dir"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(api.FileChangeType_created, "dir")
+
+	// give the watcher time to watch the new directory
+	time.Sleep(100 * time.Millisecond)
+	err = os.WriteFile(filepath.Join(root, "dir/file"), []byte("hello"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(api.FileChangeType_created, "dir/file")
+	expect(api.FileChangeType_modified, "dir/file")
+
+	err = os.Remove(filepath.Join(root, "dir/file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(api.FileChangeType_deleted, "dir/file")
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("watch failed: %v", err)
+	}
+}
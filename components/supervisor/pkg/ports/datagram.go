@@ -0,0 +1,72 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"encoding/binary"
+	"net"
+
+	"golang.org/x/xerrors"
+)
+
+// datagramHeaderSize is the size of the length prefix of a datagram framed over a stream
+const datagramHeaderSize = 2
+
+// maxDatagramSize is the largest UDP payload
+const maxDatagramSize = 0xFFFF
+
+// datagramStreamConn turns a connected UDP socket into a stream, s.t. datagrams can be tunneled like TCP connections.
+// Each datagram is prefixed by its length as a big endian uint16.
+type datagramStreamConn struct {
+	net.Conn
+
+	rbuf    []byte
+	pending []byte
+
+	wbuf []byte
+}
+
+func newDatagramStreamConn(conn net.Conn) *datagramStreamConn {
+	return &datagramStreamConn{
+		Conn: conn,
+		rbuf: make([]byte, datagramHeaderSize+maxDatagramSize),
+	}
+}
+
+// Read reads the next framed datagram from the socket.
+func (c *datagramStreamConn) Read(p []byte) (int, error) {
+	if len(c.pending) == 0 {
+		n, err := c.Conn.Read(c.rbuf[datagramHeaderSize:])
+		if err != nil {
+			return 0, err
+		}
+		binary.BigEndian.PutUint16(c.rbuf, uint16(n))
+		c.pending = c.rbuf[:datagramHeaderSize+n]
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+// Write sends all complete datagrams of the stream to the socket.
+func (c *datagramStreamConn) Write(p []byte) (int, error) {
+	c.wbuf = append(c.wbuf, p...)
+	var sent int
+	for len(c.wbuf)-sent >= datagramHeaderSize {
+		size := datagramHeaderSize + int(binary.BigEndian.Uint16(c.wbuf[sent:]))
+		if len(c.wbuf)-sent < size {
+			break
+		}
+		_, err := c.Conn.Write(c.wbuf[sent+datagramHeaderSize : sent+size])
+		if err != nil {
+			return 0, xerrors.Errorf("cannot send datagram: %w", err)
+		}
+		sent += size
+	}
+	// keep the incomplete datagram for the next write
+	n := copy(c.wbuf, c.wbuf[sent:])
+	c.wbuf = c.wbuf[:n]
+	return len(p), nil
+}
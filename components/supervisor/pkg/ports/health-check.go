@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
+)
+
+const defaultHealthCheckInterval = 5 * time.Second
+
+// healthCheck periodically checks the health of the application serving a port
+type healthCheck struct {
+	config   gitpod.HealthCheck
+	protocol string
+	cancel   context.CancelFunc
+	healthy  bool
+}
+
+// updateHealthChecks starts health checks for served ports which have one configured,
+// and stops those of ports which aren't served anymore.
+// Callers are expected to hold mu.
+func (pm *Manager) updateHealthChecks(ctx context.Context) {
+	served := make(map[uint32]struct{}, len(pm.served))
+	for _, s := range pm.served {
+		served[s.Port] = struct{}{}
+	}
+
+	for port, hc := range pm.healthChecks {
+		config, _, exists := pm.configs.Get(port)
+		_, isServed := served[port]
+		if isServed && exists && config.HealthCheck != nil && *config.HealthCheck == hc.config && config.Protocol == hc.protocol {
+			continue
+		}
+		hc.cancel()
+		delete(pm.healthChecks, port)
+	}
+
+	for port := range served {
+		if _, exists := pm.healthChecks[port]; exists || pm.boundInternally(port) {
+			continue
+		}
+		config, _, exists := pm.configs.Get(port)
+		if !exists || config.HealthCheck == nil {
+			continue
+		}
+
+		hcCtx, cancel := context.WithCancel(ctx)
+		hc := &healthCheck{
+			config:   *config.HealthCheck,
+			protocol: config.Protocol,
+			cancel:   cancel,
+		}
+		pm.healthChecks[port] = hc
+		go pm.runHealthCheck(hcCtx, port, hc)
+	}
+}
+
+func (pm *Manager) runHealthCheck(ctx context.Context, port uint32, hc *healthCheck) {
+	interval := defaultHealthCheckInterval
+	if hc.config.Interval != "" {
+		d, err := time.ParseDuration(hc.config.Interval)
+		if err != nil || d <= 0 {
+			log.WithError(err).WithField("port", port).WithField("interval", hc.config.Interval).Warn("invalid health check interval, using the default")
+		} else {
+			interval = d
+		}
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		healthy := checkPortHealth(ctx, port, hc.protocol, &hc.config, interval)
+
+		pm.mu.Lock()
+		changed := ctx.Err() == nil && hc.healthy != healthy
+		if changed {
+			hc.healthy = healthy
+		}
+		pm.mu.Unlock()
+		if changed {
+			log.WithField("port", port).WithField("healthy", healthy).Info("port health changed")
+			pm.forceUpdate()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// checkPortHealth checks once whether the application serving a port is healthy. Applications may
+// serve a port on localhost or on the workspace IP only, hence the port is healthy if either is.
+func checkPortHealth(ctx context.Context, port uint32, protocol string, config *gitpod.HealthCheck, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	hosts := []string{"localhost"}
+	if workspaceIPAdress != "" {
+		hosts = append(hosts, workspaceIPAdress)
+	}
+	for _, host := range hosts {
+		if checkAddrHealth(ctx, net.JoinHostPort(host, strconv.Itoa(int(port))), protocol, config) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkAddrHealth(ctx context.Context, addr string, protocol string, config *gitpod.HealthCheck) bool {
+	if config.Type == "tcp" {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}
+
+	scheme := "http"
+	if protocol == gitpod.PortProtocolHTTPS {
+		scheme = "https"
+	}
+	path := config.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s%s", scheme, addr, path), nil)
+	if err != nil {
+		return false
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			// applications in workspaces commonly serve HTTPS using self-signed certificates
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+		// redirects are a healthy response
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	client.CloseIdleConnections()
+
+	if config.Status != 0 {
+		return resp.StatusCode == config.Status
+	}
+	return resp.StatusCode < 400
+}
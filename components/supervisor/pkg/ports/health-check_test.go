@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
+)
+
+func TestCheckPortHealth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ready":
+			w.WriteHeader(http.StatusOK)
+		case "/starting":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/redirect":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	served := portOf(t, srv.Listener.Addr())
+
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unserved := portOf(t, l.Addr())
+	l.Close()
+
+	tests := []struct {
+		Desc        string
+		Port        uint32
+		Config      gitpod.HealthCheck
+		Expectation bool
+	}{
+		{Desc: "http ok", Port: served, Config: gitpod.HealthCheck{Path: "/ready"}, Expectation: true},
+		{Desc: "http path without leading slash", Port: served, Config: gitpod.HealthCheck{Path: "ready"}, Expectation: true},
+		{Desc: "http error status", Port: served, Config: gitpod.HealthCheck{Path: "/starting"}, Expectation: false},
+		{Desc: "http redirect", Port: served, Config: gitpod.HealthCheck{Path: "/redirect"}, Expectation: true},
+		{Desc: "http expected status", Port: served, Config: gitpod.HealthCheck{Path: "/missing", Status: http.StatusNotFound}, Expectation: true},
+		{Desc: "http unexpected status", Port: served, Config: gitpod.HealthCheck{Path: "/ready", Status: http.StatusNoContent}, Expectation: false},
+		{Desc: "http not served", Port: unserved, Config: gitpod.HealthCheck{Path: "/ready"}, Expectation: false},
+		{Desc: "tcp", Port: served, Config: gitpod.HealthCheck{Type: "tcp"}, Expectation: true},
+		{Desc: "tcp not served", Port: unserved, Config: gitpod.HealthCheck{Type: "tcp"}, Expectation: false},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			act := checkPortHealth(context.Background(), test.Port, gitpod.PortProtocolHTTP, &test.Config, 5*time.Second)
+			if act != test.Expectation {
+				t.Errorf("unexpected health: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func portOf(t *testing.T, addr net.Addr) uint32 {
+	_, p, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.ParseUint(p, 10, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return uint32(port)
+}
@@ -82,6 +82,7 @@ func (configs *Configs) Get(port uint32) (*SortConfig, ConfigKind, bool) {
 					Description: rangeConfig.Description,
 					Protocol:    rangeConfig.Protocol,
 					Name:        rangeConfig.Name,
+					HealthCheck: rangeConfig.HealthCheck,
 				},
 				Sort: rangeConfig.Sort,
 			}, RangeConfigKind, true
@@ -182,6 +183,7 @@ func parseInstanceConfigs(ports []*gitpod.PortsItems) (portConfigs map[uint32]*S
 						Description: config.Description,
 						Protocol:    config.Protocol,
 						Name:        config.Name,
+						HealthCheck: config.HealthCheck,
 					},
 					Sort: uint32(index),
 				}
@@ -51,6 +51,7 @@ func NewManager(exposed ExposedPortsInterface, served ServedPortsObserver, confi
 		proxies:      make(map[uint32]*localhostProxy),
 		autoExposed:  make(map[uint32]*autoExposure),
 		autoTunneled: make(map[uint32]struct{}),
+		healthChecks: make(map[uint32]*healthCheck),
 
 		state:         state,
 		subscriptions: make(map[*Subscription]struct{}),
@@ -90,10 +91,13 @@ type Manager struct {
 	autoTunneled      map[uint32]struct{}
 	autoTunnelEnabled bool
 
-	configs  *Configs
-	exposed  []ExposedPort
-	served   []ServedPort
-	tunneled []PortTunnelState
+	healthChecks map[uint32]*healthCheck
+
+	configs   *Configs
+	exposed   []ExposedPort
+	served    []ServedPort
+	servedUDP []ServedPort
+	tunneled  []PortTunnelState
 
 	state map[uint32]*managedPort
 	mu    sync.RWMutex
@@ -104,6 +108,7 @@ type Manager struct {
 
 type managedPort struct {
 	Served       bool
+	ServedUDP    bool
 	Exposed      bool
 	Visibility   api.PortVisibility
 	Protocol     api.PortProtocol
@@ -113,6 +118,7 @@ type managedPort struct {
 	OnExposed    api.OnPortExposedAction // deprecated
 	OnOpen       api.PortsStatus_OnOpenAction
 	AutoExposure api.PortAutoExposure
+	Health       api.PortHealth
 
 	LocalhostPort uint32
 
@@ -265,7 +271,16 @@ func (pm *Manager) updateState(ctx context.Context, exposed []ExposedPort, serve
 
 	if served != nil {
 		servedMap := make(map[uint32]ServedPort)
+		servedUDPMap := make(map[uint32]ServedPort)
 		for _, port := range served {
+			if port.Transport == api.PortTransport_udp {
+				current, exists := servedUDPMap[port.Port]
+				if !exists || (!port.BoundToLocalhost && current.BoundToLocalhost) {
+					servedUDPMap[port.Port] = port
+				}
+				continue
+			}
+
 			if _, existProxy := pm.proxies[port.Port]; existProxy && port.Address.String() == workspaceIPAdress {
 				// Ignore entries that are bound to the workspace ip address
 				// as they are created by the internal reverse proxy
@@ -278,22 +293,12 @@ func (pm *Manager) updateState(ctx context.Context, exposed []ExposedPort, serve
 			}
 		}
 
-		var servedKeys []uint32
-		for k := range servedMap {
-			servedKeys = append(servedKeys, k)
-		}
-		sort.Slice(servedKeys, func(i, j int) bool {
-			return servedKeys[i] < servedKeys[j]
-		})
-
-		var newServed []ServedPort
-		for _, key := range servedKeys {
-			newServed = append(newServed, servedMap[key])
-		}
-
-		if !reflect.DeepEqual(pm.served, newServed) {
-			log.WithField("served", newServed).Debug("updating served ports")
+		newServed := sortServedPorts(servedMap)
+		newServedUDP := sortServedPorts(servedUDPMap)
+		if !reflect.DeepEqual(pm.served, newServed) || !reflect.DeepEqual(pm.servedUDP, newServedUDP) {
+			log.WithField("served", newServed).WithField("servedUDP", newServedUDP).Debug("updating served ports")
 			pm.served = newServed
+			pm.servedUDP = newServedUDP
 			pm.updateProxies()
 			pm.autoTunnel(ctx)
 		}
@@ -303,6 +308,10 @@ func (pm *Manager) updateState(ctx context.Context, exposed []ExposedPort, serve
 		pm.configs = configured
 	}
 
+	if served != nil || configured != nil {
+		pm.updateHealthChecks(ctx)
+	}
+
 	newState := pm.nextState(ctx)
 	stateChanged := !reflect.DeepEqual(newState, pm.state)
 	pm.state = newState
@@ -323,6 +332,22 @@ func (pm *Manager) updateState(ctx context.Context, exposed []ExposedPort, serve
 	}
 }
 
+func sortServedPorts(servedMap map[uint32]ServedPort) []ServedPort {
+	var servedKeys []uint32
+	for k := range servedMap {
+		servedKeys = append(servedKeys, k)
+	}
+	sort.Slice(servedKeys, func(i, j int) bool {
+		return servedKeys[i] < servedKeys[j]
+	})
+
+	var served []ServedPort
+	for _, key := range servedKeys {
+		served = append(served, servedMap[key])
+	}
+	return served
+}
+
 func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 	state := make(map[uint32]*managedPort)
 
@@ -415,6 +440,12 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 		}
 		mp := genManagedPort(port)
 		mp.Served = true
+		if hc, checked := pm.healthChecks[port]; checked {
+			mp.Health = api.PortHealth_unhealthy
+			if hc.healthy {
+				mp.Health = api.PortHealth_healthy
+			}
+		}
 
 		autoExposure, autoExposed := pm.autoExposed[port]
 		if autoExposed {
@@ -451,6 +482,15 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 		mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, public, protocol).state
 	}
 
+	// 4. ports served over UDP can only be tunneled, they aren't proxied nor exposed
+	for _, served := range pm.servedUDP {
+		if pm.boundInternally(served.Port) {
+			continue
+		}
+		mp := genManagedPort(served.Port)
+		mp.ServedUDP = true
+	}
+
 	var ports []uint32
 	for port := range state {
 		ports = append(ports, port)
@@ -507,8 +547,10 @@ func (pm *Manager) RetryAutoExpose(ctx context.Context, localPort uint32) {
 }
 
 func (pm *Manager) forceUpdate() {
-	if len(pm.forceUpdates) == 0 {
-		pm.forceUpdates <- struct{}{}
+	select {
+	case pm.forceUpdates <- struct{}{}:
+	default:
+		// an update is pending already
 	}
 }
 
@@ -527,13 +569,17 @@ func (pm *Manager) autoTunnel(ctx context.Context) {
 		return
 	}
 	var descs []*PortTunnelDescription
-	for _, served := range pm.served {
+	tunneling := make(map[uint32]struct{})
+	for _, served := range append(append([]ServedPort{}, pm.served...), pm.servedUDP...) {
 		if pm.boundInternally(served.Port) {
 			continue
 		}
 
 		_, autoTunneled := pm.autoTunneled[served.Port]
-		if !autoTunneled {
+		// a tunnel forwards both, TCP and UDP
+		_, alreadyTunneling := tunneling[served.Port]
+		if !autoTunneled && !alreadyTunneling {
+			tunneling[served.Port] = struct{}{}
 			descs = append(descs, &PortTunnelDescription{
 				LocalPort:  served.Port,
 				TargetPort: served.Port,
@@ -728,8 +774,8 @@ func (pm *Manager) CloseTunnel(ctx context.Context, port uint32) error {
 }
 
 // EstablishTunnel actually establishes the tunnel
-func (pm *Manager) EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32) (net.Conn, error) {
-	return pm.T.EstablishTunnel(ctx, clientID, localPort, targetPort)
+func (pm *Manager) EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32, transport api.PortTransport) (net.Conn, error) {
+	return pm.T.EstablishTunnel(ctx, clientID, localPort, targetPort, transport)
 }
 
 // AutoTunnel controls enablement of auto tunneling
@@ -814,6 +860,8 @@ func (pm *Manager) getPortStatus(port uint32) *api.PortsStatus {
 		Description: mp.Description,
 		Name:        mp.Name,
 		OnOpen:      mp.OnOpen,
+		Health:      mp.Health,
+		ServedUdp:   mp.ServedUDP,
 	}
 	if mp.Exposed && mp.URL != "" {
 		ps.Exposed = &api.ExposedPortInfo{
@@ -47,10 +47,10 @@ func TestPortsUpdateState(t *testing.T) {
 		{
 			Desc: "basic locally served",
 			Changes: []Change{
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortTransport_tcp}}},
 				{Exposed: []ExposedPort{{LocalPort: 8080, URL: "foobar"}}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}, {net.IPv4zero, 60000, false}}},
-				{Served: []ServedPort{{net.IPv4zero, 60000, false}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortTransport_tcp}, {net.IPv4zero, 60000, false, api.PortTransport_tcp}}},
+				{Served: []ServedPort{{net.IPv4zero, 60000, false, api.PortTransport_tcp}}},
 				{Served: []ServedPort{}},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -69,7 +69,7 @@ func TestPortsUpdateState(t *testing.T) {
 		{
 			Desc: "basic globally served",
 			Changes: []Change{
-				{Served: []ServedPort{{net.IPv4zero, 8080, false}}},
+				{Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortTransport_tcp}}},
 				{Served: []ServedPort{}},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -81,6 +81,23 @@ func TestPortsUpdateState(t *testing.T) {
 				{},
 			},
 		},
+		{
+			Desc: "served over udp",
+			Changes: []Change{
+				{Served: []ServedPort{{net.IPv4zero, 5353, false, api.PortTransport_udp}}},
+				{Served: []ServedPort{{net.IPv4zero, 5353, false, api.PortTransport_udp}, {net.IPv4zero, 5353, false, api.PortTransport_tcp}}},
+				{Served: []ServedPort{}},
+			},
+			ExpectedExposure: []ExposedPort{
+				{LocalPort: 5353},
+			},
+			ExpectedUpdates: UpdateExpectation{
+				{},
+				[]*api.PortsStatus{{LocalPort: 5353, ServedUdp: true, OnOpen: api.PortsStatus_notify_private}},
+				[]*api.PortsStatus{{LocalPort: 5353, Served: true, ServedUdp: true, OnOpen: api.PortsStatus_notify_private}},
+				{},
+			},
+		},
 		{
 			Desc: "basic port publically exposed",
 			Changes: []Change{
@@ -103,7 +120,7 @@ func TestPortsUpdateState(t *testing.T) {
 			InternalPorts: []uint32{8080},
 			Changes: []Change{
 				{Served: []ServedPort{}},
-				{Served: []ServedPort{{net.IPv4zero, 8080, false}}},
+				{Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortTransport_tcp}}},
 			},
 			ExpectedExposure: ExposureExpectation(nil),
 			ExpectedUpdates:  UpdateExpectation{{}},
@@ -117,9 +134,9 @@ func TestPortsUpdateState(t *testing.T) {
 						Port:   "4000-5000",
 					}},
 				}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true, api.PortTransport_tcp}}},
 				{Exposed: []ExposedPort{{LocalPort: 4040, Public: true, URL: "4040-foobar"}}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true}, {net.IPv4zero, 60000, false}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true, api.PortTransport_tcp}, {net.IPv4zero, 60000, false, api.PortTransport_tcp}}},
 			},
 			ExpectedExposure: []ExposedPort{
 				{LocalPort: 4040},
@@ -151,19 +168,19 @@ func TestPortsUpdateState(t *testing.T) {
 					Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortTransport_tcp}},
 				},
 				{
 					Served: []ServedPort{},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, false, api.PortTransport_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -183,7 +200,7 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "starting multiple proxies for the same served event",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}, {net.IPv4zero, 3000, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortTransport_tcp}, {net.IPv4zero, 3000, true, api.PortTransport_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -207,7 +224,7 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 8080, false}},
+					Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
@@ -227,13 +244,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally and then globally too, prefer globally (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}, {net.IPv4zero, 5900, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -252,10 +269,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally and then globally too, prefer globally (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}, {net.IPv4zero, 5900, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -277,13 +294,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served globally and then locally too, prefer globally (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}, {net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -299,10 +316,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served globally and then locally too, prefer globally (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}, {net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -321,13 +338,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then locally on ip6 too, prefer first (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv6zero, 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}, {net.IPv6zero, 5900, true, api.PortTransport_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -343,10 +360,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then locally on ip6 too, prefer first (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv6zero, 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortTransport_tcp}, {net.IPv6zero, 5900, true, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -365,13 +382,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then globally on ip6 too, prefer first (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv6zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}, {net.IPv6zero, 5900, false, api.PortTransport_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -387,10 +404,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then globally on ip6 too, prefer first (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv6zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortTransport_tcp}, {net.IPv6zero, 5900, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -414,7 +431,7 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 8080, false}},
+					Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
@@ -439,7 +456,7 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 3000, false}},
+					Served: []ServedPort{{net.IPv4zero, 3000, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 3000, Public: false, URL: "foobar"}},
@@ -472,10 +489,10 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5002, false}},
+					Served: []ServedPort{{net.IPv4zero, 5002, false, api.PortTransport_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5002, false}, {net.IPv4zero, 5001, false}},
+					Served: []ServedPort{{net.IPv4zero, 5002, false, api.PortTransport_tcp}, {net.IPv4zero, 5001, false, api.PortTransport_tcp}},
 				},
 				{
 					Config: &ConfigChange{instance: []*gitpod.PortsItems{
@@ -484,7 +501,7 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5001, false}, {net.IPv4zero, 3000, false}},
+					Served: []ServedPort{{net.IPv4zero, 5001, false, api.PortTransport_tcp}, {net.IPv4zero, 3000, false, api.PortTransport_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 3000, Public: false, URL: "foobar"}},
@@ -557,10 +574,10 @@ func TestPortsUpdateState(t *testing.T) {
 					},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 3000, false}},
+					Served: []ServedPort{{net.IPv4zero, 3000, false, api.PortTransport_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 3000, false}, {net.IPv4zero, 3001, false}, {net.IPv4zero, 3002, false}},
+					Served: []ServedPort{{net.IPv4zero, 3000, false, api.PortTransport_tcp}, {net.IPv4zero, 3001, false, api.PortTransport_tcp}, {net.IPv4zero, 3002, false, api.PortTransport_tcp}},
 				},
 				{
 					Config: &ConfigChange{
@@ -760,7 +777,7 @@ func (tep *testTunneledPorts) Tunnel(ctx context.Context, options *TunnelOptions
 func (tep *testTunneledPorts) CloseTunnel(ctx context.Context, localPorts ...uint32) ([]uint32, error) {
 	return nil, nil
 }
-func (tep *testTunneledPorts) EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32) (net.Conn, error) {
+func (tep *testTunneledPorts) EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32, transport api.PortTransport) (net.Conn, error) {
 	return nil, nil
 }
 
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
 )
 
 // ServedPort describes a port served by a local service.
@@ -26,6 +27,7 @@ type ServedPort struct {
 	Address          net.IP
 	Port             uint32
 	BoundToLocalhost bool
+	Transport        api.PortTransport
 }
 
 // ServedPortsObserver observes the locally served ports and provides
@@ -44,6 +46,12 @@ const (
 
 	fnNetTCP  = "/proc/net/tcp"
 	fnNetTCP6 = "/proc/net/tcp6"
+	fnNetUDP  = "/proc/net/udp"
+	fnNetUDP6 = "/proc/net/udp6"
+
+	// socket states as found in /proc/net/{tcp,udp}*, see include/net/tcp_states.h
+	socketStateListen      = "0A"
+	socketStateUnconnected = "07"
 )
 
 // PollingServedPortsObserver regularly polls "/proc" to observe port changes.
@@ -85,7 +93,7 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 			)
 
 			var protos []string
-			for _, path := range []string{fnNetTCP, fnNetTCP6} {
+			for _, path := range []string{fnNetTCP, fnNetTCP6, fnNetUDP, fnNetUDP6} {
 				if _, err := os.Stat(path); err == nil {
 					protos = append(protos, path)
 				}
@@ -97,7 +105,12 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 					errchan <- err
 					continue
 				}
-				ps, err := readNetTCPFile(fc, true)
+				var ps []ServedPort
+				if fn == fnNetUDP || fn == fnNetUDP6 {
+					ps, err = readNetUDPFile(fc)
+				} else {
+					ps, err = readNetTCPFile(fc, true)
+				}
 				fc.Close()
 
 				if err != nil {
@@ -105,7 +118,7 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 					continue
 				}
 				for _, port := range ps {
-					key := fmt.Sprintf("%s:%d", hex.EncodeToString(port.Address), port.Port)
+					key := fmt.Sprintf("%s:%d/%s", hex.EncodeToString(port.Address), port.Port, port.Transport)
 					_, exists := visited[key]
 					if exists {
 						continue
@@ -125,13 +138,27 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 }
 
 func readNetTCPFile(fc io.Reader, listeningOnly bool) (ports []ServedPort, err error) {
+	var state string
+	if listeningOnly {
+		state = socketStateListen
+	}
+	return readNetFile(fc, state, api.PortTransport_tcp)
+}
+
+// readNetUDPFile reads the ports of unconnected UDP sockets, i.e. those which receive datagrams from anyone.
+// Connected sockets are typically used by clients, e.g. for DNS lookups.
+func readNetUDPFile(fc io.Reader) (ports []ServedPort, err error) {
+	return readNetFile(fc, socketStateUnconnected, api.PortTransport_udp)
+}
+
+func readNetFile(fc io.Reader, state string, transport api.PortTransport) (ports []ServedPort, err error) {
 	scanner := bufio.NewScanner(fc)
 	for scanner.Scan() {
 		fields := strings.Fields(scanner.Text())
 		if len(fields) < 4 {
 			continue
 		}
-		if listeningOnly && fields[3] != "0A" {
+		if state != "" && fields[3] != state {
 			continue
 		}
 
@@ -143,7 +170,7 @@ func readNetTCPFile(fc io.Reader, listeningOnly bool) (ports []ServedPort, err e
 
 		port, err := strconv.ParseUint(portHex, 16, 32)
 		if err != nil {
-			log.WithError(err).WithField("port", portHex).Warn("cannot parse port entry from /proc/net/tcp* file")
+			log.WithError(err).WithField("port", portHex).Warn("cannot parse port entry from /proc/net/* file")
 			continue
 		}
 		ipAddress := hexDecodeIP([]byte(addrHex))
@@ -152,6 +179,7 @@ func readNetTCPFile(fc io.Reader, listeningOnly bool) (ports []ServedPort, err e
 			BoundToLocalhost: ipAddress.IsLoopback(),
 			Address:          ipAddress,
 			Port:             uint32(port),
+			Transport:        transport,
 		})
 
 		sort.Slice(ports, func(i, j int) bool {
@@ -15,6 +15,8 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
 )
 
 const validTCPInput = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
@@ -36,12 +38,19 @@ const validTCP6Input = `  sl  local_address                         remote_addre
    7: 0000000000000000FFFF0000940C380A:59D7 0000000000000000FFFF00006100840A:E08A 06 00000000:00000000 03:000003E6 00000000     0        0 0 3 0000000000000000
   20: 0000000000000000FFFF00000100007F:59D7 0000000000000000FFFF00000100007F:EB64 01 00000000:00000000 02:000003D2 00000000 33333        0 57014424 2 0000000000000000 20 4 0 10 -1`
 
+const validUDPInput = `   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  130: 00000000:14E9 00000000:0000 07 00000000:00000000 00:00000000 00000000 33333        0 57123456 2 0000000000000000 0
+  201: 0100007F:0035 00000000:0000 07 00000000:00000000 00:00000000 00000000 33333        0 57123457 2 0000000000000000 0
+  305: 0100007F:9A3C 0100007F:0035 01 00000000:00000000 00:00000000 00000000 33333        0 57123458 2 0000000000000000 0
+`
+
 func TestObserve(t *testing.T) {
 	type Expectation [][]ServedPort
 	tests := []struct {
-		Name         string
-		FileContents []string
-		Expectation  Expectation
+		Name            string
+		FileContents    []string
+		UDPFileContents []string
+		Expectation     Expectation
 	}{
 		{
 			Name: "basic positive",
@@ -61,6 +70,26 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "tcp and udp",
+			FileContents: []string{
+				"", "",
+				validTCPInput, "",
+			},
+			UDPFileContents: []string{
+				"", "",
+				validUDPInput, "",
+			},
+			Expectation: Expectation{
+				{
+					{Address: net.IPv4(127, 0, 0, 1), Port: 5900, BoundToLocalhost: true},
+					{Address: net.IPv4zero, Port: 6080},
+					{Address: net.IPv4zero, Port: 23000},
+					{Address: net.IPv4(127, 0, 0, 1), Port: 53, BoundToLocalhost: true, Transport: api.PortTransport_udp},
+					{Address: net.IPv4zero, Port: 5353, Transport: api.PortTransport_udp},
+				},
+			},
+		},
 		{
 			Name: "the same port bound locally on ip4 and ip6",
 			FileContents: []string{
@@ -208,10 +237,19 @@ func TestObserve(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			var f int
+			var f, u int
 			obs := PollingServedPortsObserver{
 				RefreshInterval: 100 * time.Millisecond,
 				fileOpener: func(fn string) (io.ReadCloser, error) {
+					if fn == fnNetUDP || fn == fnNetUDP6 {
+						if u >= len(test.UDPFileContents) {
+							return io.NopCloser(bytes.NewReader(nil)), nil
+						}
+
+						res := io.NopCloser(bytes.NewReader([]byte(test.UDPFileContents[u])))
+						u++
+						return res, nil
+					}
 					if f >= len(test.FileContents) {
 						return nil, os.ErrNotExist
 					}
@@ -300,3 +338,18 @@ func TestReadNetTCPFile(t *testing.T) {
 		})
 	}
 }
+
+func TestReadNetUDPFile(t *testing.T) {
+	ports, err := readNetUDPFile(bytes.NewReader([]byte(validUDPInput)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectation := []ServedPort{
+		{Address: net.IPv4(127, 0, 0, 1), Port: 53, BoundToLocalhost: true, Transport: api.PortTransport_udp},
+		{Address: net.IPv4zero, Port: 5353, Transport: api.PortTransport_udp},
+	}
+	if diff := cmp.Diff(expectation, ports); diff != "" {
+		t.Errorf("unexpected result (-want +got):\n%s", diff)
+	}
+}
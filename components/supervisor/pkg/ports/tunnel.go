@@ -55,7 +55,8 @@ type TunneledPortsInterface interface {
 	CloseTunnel(ctx context.Context, localPorts ...uint32) ([]uint32, error)
 
 	// EstablishTunnel actually establishes the tunnel for an incoming connection on a remote machine.
-	EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32) (net.Conn, error)
+	// UDP tunnels frame each datagram with its length, see datagramStreamConn.
+	EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32, transport api.PortTransport) (net.Conn, error)
 }
 
 // TunneledPortsService observes the tunneled ports.
@@ -205,7 +206,7 @@ func (p *TunneledPortsService) CloseTunnel(ctx context.Context, localPorts ...ui
 }
 
 // EstablishTunnel actually establishes the tunnel.
-func (p *TunneledPortsService) EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32) (net.Conn, error) {
+func (p *TunneledPortsService) EstablishTunnel(ctx context.Context, clientID string, localPort uint32, targetPort uint32, transport api.PortTransport) (net.Conn, error) {
 	p.cond.L.Lock()
 	defer p.cond.L.Unlock()
 
@@ -220,9 +221,24 @@ func (p *TunneledPortsService) EstablishTunnel(ctx context.Context, clientID str
 	}
 
 	addr := net.JoinHostPort("localhost", strconv.FormatInt(int64(localPort), 10))
-	conn, err := net.Dial("tcp", addr)
-	if err != nil {
-		return nil, err
+	var conn net.Conn
+	switch transport {
+	case api.PortTransport_tcp:
+		var err error
+		conn, err = net.Dial("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+	case api.PortTransport_udp:
+		// there is no handshake which would make us fall back to IPv4 if nobody listens on the IPv6 loopback,
+		// and servers listening on all interfaces receive datagrams sent to the IPv4 loopback as well
+		udpConn, err := net.Dial("udp", net.JoinHostPort("127.0.0.1", strconv.FormatInt(int64(localPort), 10)))
+		if err != nil {
+			return nil, err
+		}
+		conn = newDatagramStreamConn(udpConn)
+	default:
+		return nil, xerrors.Errorf("client '%s': unsupported transport: %s", clientID, transport)
 	}
 	var result net.Conn
 	result = &tunnelConn{
@@ -119,7 +119,7 @@ func TestLocalPortTunneling(t *testing.T) {
 		}
 		defer src.Close()
 
-		dst, err := service.EstablishTunnel(ctx, "test", localPort, targetPort)
+		dst, err := service.EstablishTunnel(ctx, "test", localPort, targetPort, api.PortTransport_tcp)
 		if err != nil {
 			return err
 		}
@@ -183,3 +183,60 @@ func availablePort() (uint32, error) {
 	}
 	return uint32(port), nil
 }
+
+func TestUDPTunneling(t *testing.T) {
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, addr, err := server.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = server.WriteTo(append(buf[:n], '!'), addr)
+		}
+	}()
+	localPort := uint32(server.LocalAddr().(*net.UDPAddr).Port)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service := NewTunneledPortsService(false)
+	_, err = service.Tunnel(ctx, &TunnelOptions{}, &PortTunnelDescription{
+		LocalPort:  localPort,
+		TargetPort: localPort,
+		Visibility: api.TunnelVisiblity_host,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tunnel, err := service.EstablishTunnel(ctx, "test", localPort, localPort, api.PortTransport_udp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tunnel.Close()
+
+	for _, msg := range []string{"Hello", "World"} {
+		frame := append([]byte{0, byte(len(msg))}, msg...)
+		// datagrams may be split across writes to the tunnel stream
+		for _, part := range [][]byte{frame[:1], frame[1:4], frame[4:]} {
+			_, err = tunnel.Write(part)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		resp := make([]byte, datagramHeaderSize+len(msg)+1)
+		_, err = io.ReadFull(tunnel, resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(append([]byte{0, byte(len(msg) + 1)}, msg+"!"...), resp); diff != "" {
+			t.Errorf("unexpected response (-want +got):\n%s", diff)
+		}
+	}
+}
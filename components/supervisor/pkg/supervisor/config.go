@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	env "github.com/Netflix/go-env"
@@ -347,6 +348,12 @@ type TaskConfig struct {
 	Env      *map[string]interface{} `json:"env,omitempty"`
 	OpenIn   *string                 `json:"openIn,omitempty"`
 	OpenMode *string                 `json:"openMode,omitempty"`
+
+	DependsOn      []string               `json:"dependsOn,omitempty"`
+	ReadinessProbe *gitpod.ReadinessProbe `json:"readinessProbe,omitempty"`
+
+	Restart     *string `json:"restart,omitempty"`
+	MaxRestarts *int    `json:"maxRestarts,omitempty"`
 }
 
 // Validate validates this configuration.
@@ -438,6 +445,17 @@ func (c WorkspaceConfig) GitpodAPIEndpoint() (endpoint, host string, err error)
 	return
 }
 
+// workspaceLocation returns the directory which contains the workspace content, e.g. /workspace.
+// A workspace root at the file system root falls back to /workspace, because clients must not get access to the whole file system.
+func (c WorkspaceConfig) workspaceLocation() string {
+	root := filepath.Clean(c.WorkspaceRoot)
+	elems := strings.SplitN(strings.TrimPrefix(root, string(filepath.Separator)), string(filepath.Separator), 2)
+	if elems[0] == "" || elems[0] == "." {
+		return "/workspace"
+	}
+	return string(filepath.Separator) + elems[0]
+}
+
 // isPrebuild returns true if the workspace is prebuild.
 func (c WorkspaceConfig) isPrebuild() bool {
 	return c.GitpodHeadless == "true" || c.DebugWorkspaceType == api.DebugWorkspaceType_prebuild
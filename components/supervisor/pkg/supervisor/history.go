@@ -0,0 +1,148 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
+)
+
+const (
+	// terminalHistoryFileName is the file in the store location the terminal history is persisted to when the workspace stops
+	terminalHistoryFileName = "terminal-history.json"
+	// terminalHistorySize is the number of bytes of output we persist per terminal
+	terminalHistorySize = 64 << 10
+)
+
+// terminalHistory is the persisted state of a terminal, which is restored when the workspace restarts
+type terminalHistory struct {
+	// Task is the ID of the task which ran in the terminal, empty for terminals of users
+	Task string `json:"task,omitempty"`
+	// Title is the title that was set using the API
+	Title       string            `json:"title,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Workdir     string            `json:"workdir,omitempty"`
+	Output      []byte            `json:"output,omitempty"`
+}
+
+// saveTerminalHistory persists the recent output, title and annotations of all terminals to the store location.
+func saveTerminalHistory(storeLocation string, termMuxSrv *terminal.MuxTerminalService, tasks *tasksManager) error {
+	terminals, err := termMuxSrv.List(context.Background(), &api.ListTerminalsRequest{})
+	if err != nil {
+		return err
+	}
+
+	taskOfTerminal := make(map[string]string)
+	for _, t := range tasks.Status() {
+		if t.Terminal != "" {
+			taskOfTerminal[t.Terminal] = t.Id
+		}
+	}
+
+	history := make([]*terminalHistory, 0, len(terminals.Terminals))
+	for _, t := range terminals.Terminals {
+		term, ok := termMuxSrv.Mux.Get(t.Alias)
+		if !ok {
+			continue
+		}
+
+		h := &terminalHistory{
+			Task:        taskOfTerminal[t.Alias],
+			Annotations: t.Annotations,
+			Workdir:     t.CurrentWorkdir,
+			Output:      term.History(terminalHistorySize),
+		}
+		if t.TitleSource == api.TerminalTitleSource_api {
+			h.Title = t.Title
+		}
+		history = append(history, h)
+	}
+	if len(history) == 0 {
+		return nil
+	}
+
+	content, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	fn := filepath.Join(storeLocation, terminalHistoryFileName)
+	err = os.MkdirAll(storeLocation, 0755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(fn, content, 0644)
+	if err != nil {
+		return xerrors.Errorf("cannot write terminal history: %w", err)
+	}
+	_ = os.Chown(fn, gitpodUID, gitpodGID)
+
+	return nil
+}
+
+// loadTerminalHistory loads the terminal history persisted when the workspace stopped.
+// The history is removed from the store location s.t. it's restored only once.
+func loadTerminalHistory(storeLocation string) ([]*terminalHistory, error) {
+	fn := filepath.Join(storeLocation, terminalHistoryFileName)
+	content, err := os.ReadFile(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	_ = os.Remove(fn)
+
+	var history []*terminalHistory
+	err = json.Unmarshal(content, &history)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse terminal history: %w", err)
+	}
+	return history, nil
+}
+
+// restoreTerminals opens the terminals of users again, showing their history.
+func restoreTerminals(ctx context.Context, termMuxSrv *terminal.MuxTerminalService, history []*terminalHistory) {
+	for _, h := range history {
+		if h.Task != "" {
+			continue
+		}
+
+		annotations := h.Annotations
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		req := &api.OpenTerminalRequest{}
+		if h.Workdir != "" {
+			if stat, err := os.Stat(h.Workdir); err == nil && stat.IsDir() {
+				req.Workdir = h.Workdir
+			}
+		}
+		resp, err := termMuxSrv.OpenWithOptions(ctx, req, terminal.TermOptions{
+			ReadTimeout: 5 * time.Second,
+			Annotations: annotations,
+			History:     h.Output,
+		})
+		if err != nil {
+			log.WithError(err).Warn("cannot restore terminal")
+			continue
+		}
+		if h.Title != "" {
+			if term, ok := termMuxSrv.Mux.Get(resp.Terminal.Alias); ok {
+				term.SetTitle(h.Title)
+			}
+		}
+	}
+}
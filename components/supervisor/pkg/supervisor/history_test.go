@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
+)
+
+func TestTerminalHistory(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var (
+		storeLocation = t.TempDir()
+		mux           = terminal.NewMux()
+		termMuxSrv    = terminal.NewMuxTerminalService(mux)
+	)
+	defer func() {
+		// the shells don't stop on SIGTERM while they wait for sleep
+		closeCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+		mux.Close(closeCtx)
+	}()
+	termMuxSrv.DefaultWorkdir = storeLocation
+	termMuxSrv.DefaultShell = "/bin/sh"
+
+	open := func(output string) (alias string) {
+		resp, err := termMuxSrv.OpenWithOptions(ctx, &api.OpenTerminalRequest{ShellArgs: []string{"-c", "echo " + output + "; sleep 10"}}, terminal.TermOptions{
+			Annotations: map[string]string{"output": output},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		term, _ := mux.Get(resp.Terminal.Alias)
+		for !strings.Contains(string(term.History(terminalHistorySize)), output) {
+			if ctx.Err() != nil {
+				t.Fatalf("terminal did not print %s", output)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		return resp.Terminal.Alias
+	}
+	taskTerm := open("task")
+	userTerm := open("user")
+	_, err := termMuxSrv.SetTitle(ctx, &api.SetTerminalTitleRequest{Alias: userTerm, Title: "my terminal"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tasks := &tasksManager{tasks: []*task{{TaskStatus: api.TaskStatus{Id: "0", Terminal: taskTerm}}}}
+	err = saveTerminalHistory(storeLocation, termMuxSrv, tasks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := loadTerminalHistory(storeLocation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(storeLocation, terminalHistoryFileName)); !os.IsNotExist(err) {
+		t.Errorf("terminal history was not removed after it was loaded: %v", err)
+	}
+
+	type summary struct {
+		Task, Title, Output string
+		Annotations         map[string]string
+	}
+	var summaries []summary
+	for _, h := range history {
+		summaries = append(summaries, summary{Task: h.Task, Title: h.Title, Output: strings.TrimSpace(string(h.Output)), Annotations: h.Annotations})
+	}
+	expectation := []summary{
+		{Task: "0", Output: "task", Annotations: map[string]string{"output": "task"}},
+		{Title: "my terminal", Output: "user", Annotations: map[string]string{"output": "user"}},
+	}
+	if diff := cmp.Diff(expectation, summaries); diff != "" {
+		t.Fatalf("unexpected terminal history (-want +got):\n%s", diff)
+	}
+
+	err = mux.CloseTerminal(ctx, userTerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restoreTerminals(ctx, termMuxSrv, history)
+
+	terminals, err := termMuxSrv.List(ctx, &api.ListTerminalsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(terminals.Terminals) != 2 {
+		t.Fatalf("expected the user terminal to be restored, got %d terminals", len(terminals.Terminals))
+	}
+	restored := terminals.Terminals[1]
+	if restored.Title != "my terminal" {
+		t.Errorf("restored terminal has title %q", restored.Title)
+	}
+	if diff := cmp.Diff(map[string]string{"output": "user"}, restored.Annotations); diff != "" {
+		t.Errorf("unexpected annotations of the restored terminal (-want +got):\n%s", diff)
+	}
+	term, _ := mux.Get(restored.Alias)
+	if out := string(term.History(terminalHistorySize)); !strings.HasPrefix(out, "user") || !strings.Contains(out, "before the workspace restarted") {
+		t.Errorf("restored terminal does not start with its history: %q", out)
+	}
+}
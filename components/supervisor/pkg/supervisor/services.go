@@ -758,6 +758,7 @@ func (is *InfoService) WorkspaceInfo(ctx context.Context, req *api.WorkspaceInfo
 // ControlService implements the supervisor control service.
 type ControlService struct {
 	portsManager *ports.Manager
+	tasks        *tasksManager
 
 	privateKey string
 	publicKey  string
@@ -991,7 +992,7 @@ func (s *portService) EstablishTunnel(stream api.PortService_EstablishTunnelServ
 		return status.Error(codes.FailedPrecondition, "first request should be a desc")
 	}
 
-	tunnel, err := s.portsManager.EstablishTunnel(stream.Context(), desc.ClientId, desc.Port, desc.TargetPort)
+	tunnel, err := s.portsManager.EstablishTunnel(stream.Context(), desc.ClientId, desc.Port, desc.TargetPort, desc.Transport)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed establish the tunnel: %v", err)
 	}
@@ -1060,3 +1061,18 @@ func (s *portService) RetryAutoExpose(ctx context.Context, req *api.RetryAutoExp
 func (s *statusService) ResourcesStatus(ctx context.Context, in *api.ResourcesStatuRequest) (*api.ResourcesStatusResponse, error) {
 	return s.topService.data, nil
 }
+
+// RestartTask restarts the command of a task.
+func (c *ControlService) RestartTask(ctx context.Context, req *api.RestartTaskRequest) (*api.RestartTaskResponse, error) {
+	err := c.tasks.Restart(req.Id)
+	if errors.Is(err, ErrTaskNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, ErrTaskNotRestartable) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.RestartTaskResponse{}, nil
+}
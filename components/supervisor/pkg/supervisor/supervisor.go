@@ -55,10 +55,12 @@ import (
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/executor"
 	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
 	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/config"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/dropwriter"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/files"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/metrics"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/ports"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/serverapi"
@@ -334,6 +336,11 @@ func Run(options ...RunOption) {
 	}
 
 	termMux := terminal.NewMux()
+	termMux.RecordingLocation = logs.TerminalRecordingLocation
+	termMux.RecordingCreds = &syscall.Credential{
+		Uid: gitpodUID,
+		Gid: gitpodGID,
+	}
 	termMuxSrv := terminal.NewMuxTerminalService(termMux)
 	termMuxSrv.DefaultWorkdir = cfg.RepoRoot
 	if cfg.WorkspaceRoot != "" {
@@ -354,6 +361,14 @@ func Run(options ...RunOption) {
 		Gid: gitpodGID,
 	}
 
+	fileSrv := files.NewFileService(cfg.workspaceLocation())
+	// Like terminals and tasks, file operations run without supplementary groups. Clients must not
+	// get access to files which the workspace user could not access from a terminal.
+	fileSrv.Creds = &syscall.Credential{
+		Uid: gitpodUID,
+		Gid: gitpodGID,
+	}
+
 	taskManager := newTasksManager(cfg, termMuxSrv, cstate, nil, ideReady, desktopIdeReady)
 
 	gitStatusWg := &sync.WaitGroup{}
@@ -382,10 +397,11 @@ func Run(options ...RunOption) {
 			topService:      topService,
 		},
 		termMuxSrv,
+		fileSrv,
 		RegistrableTokenService{Service: tokenService},
 		notificationService,
 		&InfoService{cfg: cfg, ContentState: cstate, GitpodService: gitpodService},
-		&ControlService{portsManager: portMgmt},
+		&ControlService{portsManager: portMgmt, tasks: taskManager},
 		&portService{portsManager: portMgmt},
 	}
 	apiServices = append(apiServices, additionalServices...)
@@ -498,6 +514,13 @@ func Run(options ...RunOption) {
 	defer cancelTermination()
 	cancel()
 	ideWG.Wait()
+	// persist the terminal output s.t. it can be restored when the workspace restarts
+	if !cfg.isHeadless() {
+		err := saveTerminalHistory(logs.TerminalStoreLocation, termMuxSrv, taskManager)
+		if err != nil {
+			log.WithError(err).Warn("cannot persist terminal history")
+		}
+	}
 	// terminate all terminal processes once the IDE is gone
 	termMux.Close(terminalShutdownCtx)
 
@@ -1446,7 +1469,7 @@ func tunnelOverSSH(ctx context.Context, tunneled *ports.TunneledPortsService, ne
 		return
 	}
 
-	tunnel, err := tunneled.EstablishTunnel(ctx, tunnelReq.ClientId, tunnelReq.Port, tunnelReq.TargetPort)
+	tunnel, err := tunneled.EstablishTunnel(ctx, tunnelReq.ClientId, tunnelReq.Port, tunnelReq.TargetPort, tunnelReq.Transport)
 	if err != nil {
 		log.WithError(err).Error("tunnel: failed to establish")
 		_ = newCh.Reject(ssh.Prohibited, err.Error())
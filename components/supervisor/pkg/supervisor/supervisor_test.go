@@ -162,3 +162,24 @@ func TestIsBlacklistedEnvvar(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkspaceLocation(t *testing.T) {
+	tests := []struct {
+		WorkspaceRoot string
+		Expectation   string
+	}{
+		{WorkspaceRoot: "/workspace/gitpod", Expectation: "/workspace"},
+		{WorkspaceRoot: "/workspace", Expectation: "/workspace"},
+		{WorkspaceRoot: "/home/gitpod/project/", Expectation: "/home"},
+		{WorkspaceRoot: "/", Expectation: "/workspace"},
+		{WorkspaceRoot: "//", Expectation: "/workspace"},
+	}
+	for _, test := range tests {
+		t.Run(test.WorkspaceRoot, func(t *testing.T) {
+			act := WorkspaceConfig{WorkspaceRoot: test.WorkspaceRoot}.workspaceLocation()
+			if act != test.Expectation {
+				t.Errorf("unexpected workspace location: want %s, got %s", test.Expectation, act)
+			}
+		})
+	}
+}
@@ -8,19 +8,25 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
+	"net"
+	"net/http"
 	"os"
+	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
 )
@@ -70,6 +76,32 @@ type task struct {
 	successChan chan taskSuccess
 	title       string
 	lastOutput  string
+
+	// dependencies are the tasks which must be ready before this task starts
+	dependencies []*task
+	// hasDependents is true if other tasks wait for this task to become ready
+	hasDependents bool
+	// ready is closed once the task is ready or cannot become ready anymore, readiness tells which one it is
+	ready     chan struct{}
+	readiness taskSuccess
+	readyOnce sync.Once
+
+	// exitFile is a named pipe the terminal of the task reports the exit codes of the task's command to
+	exitFile string
+	// restartRequests receives requests to restart the task's command
+	restartRequests chan struct{}
+	// terminalClosed is closed once the terminal of the task has been closed
+	terminalClosed chan struct{}
+
+	// history is the output of the task's terminal before the workspace restarted
+	history []byte
+}
+
+func (t *task) markReady(readiness taskSuccess) {
+	t.readyOnce.Do(func() {
+		t.readiness = readiness
+		close(t.ready)
+	})
 }
 
 type headlessTaskProgressReporter interface {
@@ -194,6 +226,20 @@ func (tm *tasksManager) init(ctx context.Context) {
 	contentSource, _ := tm.contentState.ContentSource()
 	tm.contentSource = contentSource
 
+	history := make(map[string][]byte)
+	if contentSource == csapi.WorkspaceInitFromBackup {
+		terminals, err := loadTerminalHistory(tm.storeLocation)
+		if err != nil {
+			log.WithError(err).Warn("cannot load terminal history")
+		}
+		for _, h := range terminals {
+			if h.Task != "" {
+				history[h.Task] = h.Output
+			}
+		}
+		go restoreTerminals(ctx, tm.terminalService, terminals)
+	}
+
 	// give 1s window between content and tasks for IDE to startup, i.e. no competition for resources
 	tm.waitForIde(ctx, 1*time.Second)
 
@@ -220,13 +266,79 @@ func (tm *tasksManager) init(ctx context.Context) {
 			config:      config,
 			successChan: make(chan taskSuccess, 1),
 			title:       presentation.Name,
+			ready:       make(chan struct{}),
+
+			restartRequests: make(chan struct{}, 1),
+			terminalClosed:  make(chan struct{}),
+
+			history: history[id],
+		}
+		tm.tasks = append(tm.tasks, task)
+	}
+
+	tm.resolveDependencies()
+
+	for _, task := range tm.tasks {
+		if task.State == api.TaskState_closed {
+			continue
+		}
+		if !tm.config.isHeadless() && task.config.Command != nil && strings.TrimSpace(*task.config.Command) != "" {
+			task.exitFile = exitFileName(task, tm.storeLocation)
 		}
 		task.command = getCommand(task, tm.config.isHeadless(), tm.config.isPrebuild(), tm.contentSource, tm.storeLocation)
 		if tm.config.isHeadless() && task.command == "exit" {
 			task.State = api.TaskState_closed
 			task.successChan <- taskSuccessful
+			task.markReady(taskSuccessful)
+			continue
 		}
-		tm.tasks = append(tm.tasks, task)
+		if !tm.config.isHeadless() && task.hasDependents && task.config.ReadinessProbe == nil && task.exitFile == "" && strings.TrimSpace(task.command) != "" {
+			// without a readiness probe a task is ready once its commands have terminated successfully,
+			// but the task terminal stays open after that. Tasks with a command learn about that from its exit code.
+			marker := readyMarkerFileName(task, tm.storeLocation)
+			_ = os.Remove(marker)
+			task.command += " && touch " + marker
+		}
+	}
+}
+
+// resolveDependencies links tasks to the tasks they depend on. Tasks with dependencies are waiting
+// until their dependencies are ready. If the dependencies are invalid, e.g. cyclic, tasks with dependencies fail.
+func (tm *tasksManager) resolveDependencies() {
+	var (
+		items  = make([]*gitpod.TasksItems, 0, len(tm.tasks))
+		byName = make(map[string]*task, len(tm.tasks))
+	)
+	for _, t := range tm.tasks {
+		item := &gitpod.TasksItems{DependsOn: t.config.DependsOn}
+		if t.config.Name != nil {
+			item.Name = *t.config.Name
+			byName[item.Name] = t
+		}
+		items = append(items, item)
+	}
+	err := gitpod.ValidateTaskDependencies(items)
+	if err != nil {
+		log.WithError(err).Error("invalid task dependencies")
+	}
+
+	for _, t := range tm.tasks {
+		if len(t.config.DependsOn) == 0 {
+			continue
+		}
+		if err != nil {
+			t.State = api.TaskState_closed
+			t.successChan <- taskFailed(fmt.Sprintf("invalid task dependencies: %v", err))
+			t.markReady(taskFailed("invalid task dependencies"))
+			continue
+		}
+
+		for _, name := range t.config.DependsOn {
+			dep := byName[name]
+			dep.hasDependents = true
+			t.dependencies = append(t.dependencies, dep)
+		}
+		t.State = api.TaskState_waiting
 	}
 }
 
@@ -259,103 +371,551 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 	tm.init(ctx)
 
 	for _, t := range tm.tasks {
-		if t.State == api.TaskState_closed {
+		switch t.State {
+		case api.TaskState_closed:
 			continue
+		case api.TaskState_waiting:
+			go tm.startAfterDependencies(ctx, t)
+		default:
+			tm.start(ctx, t)
+		}
+	}
+
+	var success taskSuccess
+	for _, task := range tm.tasks {
+		select {
+		case <-ctx.Done():
+			success = taskFailed(ctx.Err().Error())
+		case taskResult := <-task.successChan:
+			if taskResult.Failed() {
+				success = success.Fail(string(taskResult))
+			}
 		}
-		taskLog := log.WithField("command", t.command)
-		taskLog.Info("starting a task terminal...")
-		openRequest := &api.OpenTerminalRequest{}
-		if t.config.Env != nil {
-			openRequest.Env = make(map[string]string, len(*t.config.Env))
-			for key, value := range *t.config.Env {
-				// Required check because a string is considered valid JSON (e.g. "hello")
-				// We don't want to marshall basic strings otherwise we get a double quoted environment variable
-				// See: https://github.com/gitpod-io/gitpod/issues/5887
-				if val, ok := value.(string); ok {
-					openRequest.Env[key] = val
+	}
+
+	if tm.config.isPrebuild() && tm.reporter != nil {
+		tm.reporter.done(success)
+	}
+	successChan <- success
+}
+
+// start opens the terminal of a task and runs its commands
+func (tm *tasksManager) start(ctx context.Context, t *task) {
+	taskLog := log.WithField("command", t.command)
+	taskLog.Info("starting a task terminal...")
+	openRequest := &api.OpenTerminalRequest{}
+	if t.config.Env != nil {
+		openRequest.Env = make(map[string]string, len(*t.config.Env))
+		for key, value := range *t.config.Env {
+			// Required check because a string is considered valid JSON (e.g. "hello")
+			// We don't want to marshall basic strings otherwise we get a double quoted environment variable
+			// See: https://github.com/gitpod-io/gitpod/issues/5887
+			if val, ok := value.(string); ok {
+				openRequest.Env[key] = val
+			} else {
+				v, err := json.Marshal(value)
+				if err != nil {
+					taskLog.WithError(err).WithField("key", key).Error("cannot marshal env var")
 				} else {
-					v, err := json.Marshal(value)
-					if err != nil {
-						taskLog.WithError(err).WithField("key", key).Error("cannot marshal env var")
-					} else {
-						openRequest.Env[key] = string(v)
-					}
+					openRequest.Env[key] = string(v)
 				}
 			}
 		}
-		resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, terminal.TermOptions{
-			ReadTimeout: 5 * time.Second,
-			Title:       t.title,
-		})
+	}
+	resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, terminal.TermOptions{
+		ReadTimeout: 5 * time.Second,
+		Title:       t.title,
+		History:     t.history,
+	})
+	if err != nil {
+		taskLog.WithError(err).Error("cannot open new task terminal")
+		t.successChan <- taskFailed("cannot open new task terminal")
+		t.markReady(taskFailed("cannot open new task terminal"))
+		tm.setTaskState(t, api.TaskState_closed)
+		return
+	}
+
+	taskLog = taskLog.WithField("terminal", resp.Terminal.Alias)
+	term, ok := tm.terminalService.Mux.Get(resp.Terminal.Alias)
+	if !ok {
+		taskLog.Error("cannot find a task terminal")
+		t.successChan <- taskFailed("cannot find a task terminal")
+		t.markReady(taskFailed("cannot find a task terminal"))
+		tm.setTaskState(t, api.TaskState_closed)
+		return
+	}
+
+	taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
+	taskLog.Info("task terminal has been started")
+	tm.updateState(func() bool {
+		t.Terminal = resp.Terminal.Alias
+		t.State = api.TaskState_running
+		return true
+	})
+
+	go func(t *task, term *terminal.Term) {
+		var result taskSuccess
+		state, err := term.Wait()
+		if state != nil {
+			if state.Success() {
+				result = taskSuccessful
+			} else {
+				result = taskFailed(state.String())
+			}
+		} else if err != nil {
+			result = taskSuccessful
+		} else {
+			msg := "cannot wait for task"
+			if err != nil {
+				msg = err.Error()
+			}
+
+			result = taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
+		}
+		close(t.terminalClosed)
+		t.successChan <- result
+		if tm.config.isHeadless() {
+			// headless tasks are ready once their commands have terminated successfully
+			t.markReady(result)
+		} else {
+			t.markReady(taskFailed("task terminal has been closed before the task became ready"))
+		}
+		taskLog.Info("task terminal has been closed")
+		tm.setTaskState(t, api.TaskState_closed)
+	}(t, term)
+
+	tm.watch(t, term)
+
+	var exitCodes <-chan int
+	if t.exitFile != "" {
+		exitCodes, err = listenExitCodes(t)
 		if err != nil {
-			taskLog.WithError(err).Error("cannot open new task terminal")
-			t.successChan <- taskFailed("cannot open new task terminal")
-			tm.setTaskState(t, api.TaskState_closed)
-			continue
+			taskLog.WithError(err).Warn("cannot listen to the exit codes of the task command, it won't be restarted")
+			if t.config.ReadinessProbe == nil {
+				t.markReady(taskFailed("cannot listen to the exit codes of the task command"))
+			}
 		}
+	}
+
+	if t.command != "" {
+		term.PTY.Write([]byte(t.command + "\n"))
+	}
+
+	if exitCodes != nil {
+		go tm.superviseCommand(ctx, t, term, exitCodes)
+	}
 
-		taskLog = taskLog.WithField("terminal", resp.Terminal.Alias)
-		term, ok := tm.terminalService.Mux.Get(resp.Terminal.Alias)
-		if !ok {
-			taskLog.Error("cannot find a task terminal")
-			t.successChan <- taskFailed("cannot find a task terminal")
+	if !tm.config.isHeadless() {
+		go tm.awaitReadiness(ctx, t)
+	}
+}
+
+// startAfterDependencies starts a task once all its dependencies are ready.
+// If a dependency fails to become ready, the task fails without being started.
+func (tm *tasksManager) startAfterDependencies(ctx context.Context, t *task) {
+	for _, dep := range t.dependencies {
+		select {
+		case <-ctx.Done():
+			return
+		case <-dep.ready:
+		}
+
+		if dep.readiness.Failed() {
+			log.WithField("task", t.title).WithField("dependency", dep.title).WithField("reason", string(dep.readiness)).Info("task dependency failed")
+			msg := fmt.Sprintf("dependency %s failed", dep.title)
+			t.successChan <- taskFailed(fmt.Sprintf("%s: %s", msg, dep.readiness))
+			t.markReady(taskFailed(msg))
 			tm.setTaskState(t, api.TaskState_closed)
-			continue
+			return
 		}
+	}
 
-		taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
-		taskLog.Info("task terminal has been started")
-		tm.updateState(func() bool {
-			t.Terminal = resp.Terminal.Alias
-			t.State = api.TaskState_running
-			return true
-		})
+	tm.setTaskState(t, api.TaskState_opening)
+	tm.start(ctx, t)
+}
 
-		go func(t *task, term *terminal.Term) {
-			state, err := term.Wait()
-			if state != nil {
-				if state.Success() {
-					t.successChan <- taskSuccessful
-				} else {
-					t.successChan <- taskFailed(state.String())
-				}
-			} else if err != nil {
-				t.successChan <- taskSuccessful
-			} else {
-				msg := "cannot wait for task"
-				if err != nil {
-					msg = err.Error()
-				}
+const (
+	defaultReadinessTimeout      = 5 * time.Minute
+	defaultReadinessProbeTimeout = 10 * time.Second
+	readinessProbePeriod         = 1 * time.Second
+)
 
-				t.successChan <- taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
-			}
-			taskLog.Info("task terminal has been closed")
-			tm.setTaskState(t, api.TaskState_closed)
-		}(t, term)
+// awaitReadiness marks a task as ready once its readiness probe succeeds or, without a probe,
+// once its commands have terminated successfully.
+func (tm *tasksManager) awaitReadiness(ctx context.Context, t *task) {
+	if !t.hasDependents {
+		return
+	}
+
+	var (
+		probe   = t.config.ReadinessProbe
+		timeout <-chan time.Time
+		check   func(ctx context.Context) bool
+	)
+	if probe == nil {
+		if strings.TrimSpace(t.command) == "" {
+			t.markReady(taskSuccessful)
+			return
+		}
+		if t.exitFile != "" {
+			// superviseCommand marks the task as ready once its command has terminated successfully
+			return
+		}
 
-		tm.watch(t, term)
+		marker := readyMarkerFileName(t, tm.storeLocation)
+		check = func(ctx context.Context) bool {
+			_, err := os.Stat(marker)
+			return err == nil
+		}
+	} else {
+		timer := time.NewTimer(parseProbeTimeout(t, probe.Timeout, defaultReadinessTimeout))
+		defer timer.Stop()
+		timeout = timer.C
+
+		attemptTimeout := parseProbeTimeout(t, probe.AttemptTimeout, defaultReadinessProbeTimeout)
+		check = func(ctx context.Context) bool {
+			ctx, cancel := context.WithTimeout(ctx, attemptTimeout)
+			defer cancel()
+			return tm.probe(ctx, probe)
+		}
+	}
 
-		if t.command != "" {
-			term.PTY.Write([]byte(t.command + "\n"))
+	ticker := time.NewTicker(readinessProbePeriod)
+	defer ticker.Stop()
+	for {
+		if check(ctx) {
+			log.WithField("task", t.title).Info("task is ready")
+			t.markReady(taskSuccessful)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			t.markReady(taskFailed(ctx.Err().Error()))
+			return
+		case <-t.ready:
+			return
+		case <-timeout:
+			log.WithField("task", t.title).Warn("task did not become ready in time")
+			t.markReady(taskFailed("readiness probe timed out"))
+			return
+		case <-ticker.C:
 		}
 	}
+}
 
-	var success taskSuccess
-	for _, task := range tm.tasks {
+func parseProbeTimeout(t *task, value string, def time.Duration) time.Duration {
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.WithError(err).WithField("task", t.title).WithField("timeout", value).Warn("invalid readiness probe timeout, using the default")
+		return def
+	}
+	return d
+}
+
+// probe runs all checks of a readiness probe once and returns true if all of them succeed
+func (tm *tasksManager) probe(ctx context.Context, probe *gitpod.ReadinessProbe) bool {
+	if probe.Port > 0 {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", fmt.Sprintf("localhost:%d", probe.Port))
+		if err != nil {
+			return false
+		}
+		conn.Close()
+	}
+	if probe.Http != nil {
+		path := probe.Http.Path
+		if path == "" {
+			path = "/"
+		} else if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://localhost:%d%s", probe.Http.Port, path), nil)
+		if err != nil {
+			return false
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return false
+		}
+	}
+	if probe.Command != "" {
+		cmd := exec.CommandContext(ctx, tm.terminalService.DefaultShell, "-c", probe.Command)
+		if tm.terminalService.DefaultCreds != nil {
+			cmd.SysProcAttr = &syscall.SysProcAttr{Credential: tm.terminalService.DefaultCreds}
+		}
+		cmd.Dir = tm.terminalService.DefaultWorkdir
+		cmd.Env = tm.terminalService.Env
+		if cmd.Run() != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func readyMarkerFileName(task *task, storeLocation string) string {
+	return storeLocation + "/ready-" + task.Id
+}
+
+type restartPolicy string
+
+const (
+	restartNever     restartPolicy = "never"
+	restartOnFailure restartPolicy = "on-failure"
+	restartAlways    restartPolicy = "always"
+)
+
+func (p restartPolicy) restarts(exitCode int) bool {
+	switch p {
+	case restartAlways:
+		return true
+	case restartOnFailure:
+		return exitCode != 0
+	default:
+		return false
+	}
+}
+
+const (
+	defaultMaxRestarts = 10
+	minRestartBackoff  = 1 * time.Second
+	maxRestartBackoff  = 1 * time.Minute
+	// restartGracePeriod is the time a command has to terminate before it's killed on a manual restart
+	restartGracePeriod = 10 * time.Second
+)
+
+// superviseCommand restarts the command of a task according to its restart policy or when requested, and marks
+// the task as ready once the command terminated successfully if the task has no readiness probe.
+// The command is restarted in the shell it ran in, s.t. the terminal of the task stays the same.
+func (tm *tasksManager) superviseCommand(ctx context.Context, t *task, term *terminal.Term, exitCodes <-chan int) {
+	var (
+		policy      = restartNever
+		maxRestarts = defaultMaxRestarts
+		restarts    int
+		// started is when the command was last run. Commands which ran for at least maxRestartBackoff are
+		// considered stable, i.e. their restarts are counted from zero again.
+		started = time.Now()
+		// requested is true if a restart was requested while the command was running
+		requested bool
+		backoff   <-chan time.Time
+		// kill fires once the command had restartGracePeriod to terminate on a manual restart
+		kill     <-chan time.Time
+		killPgrp int
+		taskLog  = log.WithField("task", t.title)
+	)
+	if t.config.Restart != nil {
+		policy = restartPolicy(*t.config.Restart)
+	}
+	if t.config.MaxRestarts != nil {
+		maxRestarts = *t.config.MaxRestarts
+	}
+
+	for {
 		select {
 		case <-ctx.Done():
-			success = taskFailed(ctx.Err().Error())
-		case taskResult := <-task.successChan:
-			if taskResult.Failed() {
-				success = success.Fail(string(taskResult))
+			return
+		case <-t.terminalClosed:
+			return
+		case <-t.restartRequests:
+			// a manual restart re-arms automatic restarts, even if we gave up on them
+			restarts = 0
+			pgrp, err := term.ForegroundProcessGroup()
+			if err == nil && pgrp != term.Command.Process.Pid {
+				// the shell is not idle, i.e. the command still runs - we run it again once it has terminated
+				requested = true
+				backoff = nil
+				err = syscall.Kill(-pgrp, syscall.SIGTERM)
+				if err != nil {
+					taskLog.WithError(err).Warn("cannot terminate task command")
+				}
+				kill = time.After(restartGracePeriod)
+				killPgrp = pgrp
+				continue
+			}
+			backoff = nil
+			tm.rerun(t, term)
+			started = time.Now()
+		case code, ok := <-exitCodes:
+			if !ok {
+				return
 			}
+			taskLog.WithField("exitCode", code).Info("task command has terminated")
+			tm.updateState(func() bool {
+				t.LastExitCode = int32(code)
+				return true
+			})
+			if requested {
+				requested = false
+				kill = nil
+				tm.rerun(t, term)
+				started = time.Now()
+				continue
+			}
+			if time.Since(started) >= maxRestartBackoff {
+				restarts = 0
+			}
+
+			restart := policy.restarts(code) && restarts < maxRestarts
+			if t.config.ReadinessProbe == nil {
+				if code == 0 {
+					t.markReady(taskSuccessful)
+				} else if !restart {
+					t.markReady(taskFailed(fmt.Sprintf("command exited with %d", code)))
+				}
+			}
+			if !restart {
+				if policy.restarts(code) {
+					taskLog.WithField("restarts", restarts).Warn("task command has been restarted too often, giving up")
+				}
+				continue
+			}
+
+			delay := maxRestartBackoff
+			if restarts < 32 && minRestartBackoff<<restarts < maxRestartBackoff {
+				delay = minRestartBackoff << restarts
+			}
+			restarts++
+			taskLog.WithField("delay", delay).Info("restarting task command")
+			backoff = time.After(delay)
+		case <-kill:
+			kill = nil
+			taskLog.Warn("task command did not terminate in time, killing it")
+			err := syscall.Kill(-killPgrp, syscall.SIGKILL)
+			if err != nil {
+				taskLog.WithError(err).Warn("cannot kill task command")
+			}
+		case <-backoff:
+			if !shellIdle(term) {
+				// we must not type the command into a program the user runs in the terminal, or into a line they're typing
+				taskLog.Debug("terminal is in use, deferring the restart of the task command")
+				backoff = time.After(minRestartBackoff)
+				continue
+			}
+			backoff = nil
+			tm.rerun(t, term)
+			started = time.Now()
 		}
 	}
+}
 
-	if tm.config.isPrebuild() && tm.reporter != nil {
-		tm.reporter.done(success)
+// shellIdle returns true if the shell of a terminal waits for a command and the user is not typing one
+func shellIdle(term *terminal.Term) bool {
+	pgrp, err := term.ForegroundProcessGroup()
+	if err != nil || pgrp != term.Command.Process.Pid {
+		return false
 	}
-	successChan <- success
+	return !term.InputPending()
+}
+
+// rerun runs the command of a task again in its terminal
+func (tm *tasksManager) rerun(t *task, term *terminal.Term) {
+	tm.updateState(func() bool {
+		t.RestartCount++
+		return true
+	})
+	_, err := term.PTY.Write([]byte(commandWithExitReport(t) + "\n"))
+	if err != nil {
+		log.WithError(err).WithField("task", t.title).Warn("cannot restart task command")
+	}
+}
+
+var (
+	// ErrTaskNotFound means there's no task with the given ID
+	ErrTaskNotFound = errors.New("task not found")
+	// ErrTaskNotRestartable means the task has no running terminal or no command which could be restarted
+	ErrTaskNotRestartable = errors.New("task cannot be restarted")
+)
+
+// Restart restarts the command of a task. If the command still runs, it's terminated first.
+func (tm *tasksManager) Restart(id string) error {
+	tm.mu.RLock()
+	var t *task
+	for _, candidate := range tm.tasks {
+		if candidate.Id == id {
+			t = candidate
+			break
+		}
+	}
+	var restartable bool
+	if t != nil {
+		restartable = t.State == api.TaskState_running && t.exitFile != ""
+	}
+	tm.mu.RUnlock()
+
+	if t == nil {
+		return ErrTaskNotFound
+	}
+	if !restartable {
+		return ErrTaskNotRestartable
+	}
+
+	select {
+	case t.restartRequests <- struct{}{}:
+	default:
+		// a restart is pending already
+	}
+	return nil
+}
+
+// listenExitCodes creates the named pipe the terminal of a task reports the exit codes of the task's command to.
+// The returned channel is closed once the terminal of the task has been closed.
+func listenExitCodes(t *task) (<-chan int, error) {
+	_ = os.Remove(t.exitFile)
+	err := syscall.Mkfifo(t.exitFile, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	_ = os.Chown(t.exitFile, gitpodUID, gitpodGID)
+
+	// opening the pipe for reading and writing doesn't block, and we don't read EOF whenever the shell closes it
+	f, err := os.OpenFile(t.exitFile, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-t.terminalClosed
+		f.Close()
+	}()
+
+	res := make(chan int)
+	go func() {
+		defer close(res)
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			code, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+			if err != nil {
+				continue
+			}
+			select {
+			case res <- code:
+			case <-t.terminalClosed:
+				return
+			}
+		}
+	}()
+	return res, nil
+}
+
+// commandWithExitReport produces the command of a task which reports its exit code to the exit file of the task
+func commandWithExitReport(task *task) string {
+	return withExitReport(task, *task.config.Command)
+}
+
+// withExitReport wraps a command s.t. it reports its exit code to the exit file of the task
+func withExitReport(task *task, command string) string {
+	return fmt.Sprintf("{\n%s\n}; echo $? > %s", command, task.exitFile)
+}
+
+func exitFileName(task *task, storeLocation string) string {
+	return storeLocation + "/exit-" + task.Id
 }
 
 func getCommand(task *task, isHeadless bool, isPrebuild bool, contentSource csapi.WorkspaceInitSource, storeLocation string) string {
@@ -365,6 +925,10 @@ func getCommand(task *task, isHeadless bool, isPrebuild bool, contentSource csap
 		format:   "{\n%s\n}",
 		sep:      " && ",
 	})
+	if task.exitFile != "" && strings.TrimSpace(command) != "" {
+		// we report the exit code of all commands, s.t. a failing before or init command fails the task, too
+		command = withExitReport(task, command)
+	}
 
 	if isHeadless {
 		// it's important that prebuild tasks exit eventually
@@ -9,8 +9,10 @@ import (
 	"encoding/json"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sirupsen/logrus"
@@ -240,6 +242,344 @@ func TestTaskManager(t *testing.T) {
 	}
 }
 
+func TestTaskManagerDependencies(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+	p := func(v string) *string { return &v }
+	tests := []struct {
+		Desc  string
+		Tasks func(dir string) []TaskConfig
+
+		ExpectedReporter testHeadlessTaskProgressReporter
+		// ExpectedFiles are the files in dir which exist after all tasks are done
+		ExpectedFiles []string
+	}{
+		{
+			Desc: "task starts once its dependency is ready",
+			Tasks: func(dir string) []TaskConfig {
+				return []TaskConfig{
+					{Name: p("api"), Init: p("test -f " + dir + "/db && touch " + dir + "/api"), DependsOn: []string{"db"}},
+					{Name: p("db"), Init: p("sleep 0.5 && touch " + dir + "/db")},
+				}
+			},
+			ExpectedReporter: testHeadlessTaskProgressReporter{Done: true, Success: true},
+			ExpectedFiles:    []string{"api", "db"},
+		},
+		{
+			Desc: "task does not start if its dependency fails",
+			Tasks: func(dir string) []TaskConfig {
+				return []TaskConfig{
+					{Name: p("db"), Init: p(failCommand)},
+					{Name: p("migrations"), Init: p("touch " + dir + "/migrations"), DependsOn: []string{"db"}},
+					{Name: p("api"), Init: p("touch " + dir + "/api"), DependsOn: []string{"migrations"}},
+				}
+			},
+			ExpectedReporter: testHeadlessTaskProgressReporter{Done: true, Success: false},
+		},
+		{
+			Desc: "cyclic dependencies",
+			Tasks: func(dir string) []TaskConfig {
+				return []TaskConfig{
+					{Name: p("a"), Init: p("touch " + dir + "/a"), DependsOn: []string{"b"}},
+					{Name: p("b"), Init: p("touch " + dir + "/b"), DependsOn: []string{"a"}},
+					{Name: p("c"), Init: p("touch " + dir + "/c")},
+				}
+			},
+			ExpectedReporter: testHeadlessTaskProgressReporter{Done: true, Success: false},
+			ExpectedFiles:    []string{"c"},
+		},
+		{
+			Desc: "unknown dependency",
+			Tasks: func(dir string) []TaskConfig {
+				return []TaskConfig{
+					{Name: p("a"), Init: p("touch " + dir + "/a"), DependsOn: []string{"b"}},
+				}
+			},
+			ExpectedReporter: testHeadlessTaskProgressReporter{Done: true, Success: false},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			var (
+				storeLocation = t.TempDir()
+				dir           = t.TempDir()
+			)
+			gitpodTasks, err := json.Marshal(test.Tasks(dir))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				terminalService = terminal.NewMuxTerminalService(terminal.NewMux())
+				contentState    = NewInMemoryContentState("")
+				reporter        = testHeadlessTaskProgressReporter{}
+				taskManager     = newTasksManager(&Config{
+					WorkspaceConfig: WorkspaceConfig{
+						GitpodTasks:    string(gitpodTasks),
+						GitpodHeadless: "true",
+					},
+				}, terminalService, contentState, &reporter, nil, nil)
+			)
+			terminalService.DefaultWorkdir = dir
+			taskManager.storeLocation = storeLocation
+			contentState.MarkContentReady(csapi.WorkspaceInitFromOther)
+			var wg sync.WaitGroup
+			wg.Add(1)
+			tasksSuccessChan := make(chan taskSuccess, 1)
+			go taskManager.Run(context.Background(), &wg, tasksSuccessChan)
+			wg.Wait()
+			if diff := cmp.Diff(test.ExpectedReporter, reporter); diff != "" {
+				t.Errorf("unexpected output (-want +got):\n%s", diff)
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var files []string
+			for _, e := range entries {
+				files = append(files, e.Name())
+			}
+			if diff := cmp.Diff(test.ExpectedFiles, files); diff != "" {
+				t.Errorf("unexpected files (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTaskManagerRestart(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+	var (
+		storeLocation = t.TempDir()
+		dir           = t.TempDir()
+		command       = "echo run >> " + dir + "/runs; false"
+		restart       = string(restartOnFailure)
+		maxRestarts   = 2
+	)
+	gitpodTasks, err := json.Marshal([]TaskConfig{{Command: &command, Restart: &restart, MaxRestarts: &maxRestarts}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		terminalService = terminal.NewMuxTerminalService(terminal.NewMux())
+		contentState    = NewInMemoryContentState("")
+		taskManager     = newTasksManager(&Config{
+			WorkspaceConfig: WorkspaceConfig{
+				GitpodTasks:    string(gitpodTasks),
+				GitpodHeadless: "false",
+			},
+		}, terminalService, contentState, nil, nil, nil)
+	)
+	terminalService.DefaultWorkdir = dir
+	taskManager.storeLocation = storeLocation
+	contentState.MarkContentReady(csapi.WorkspaceInitFromOther)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go taskManager.Run(context.Background(), &wg, make(chan taskSuccess, 1))
+	defer func() {
+		// interactive shells ignore SIGTERM, hence they are killed once the context is done
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+		terminalService.Mux.Close(ctx)
+		wg.Wait()
+	}()
+	<-taskManager.ready
+
+	type Expectation struct {
+		Runs         int
+		RestartCount uint32
+		LastExitCode int32
+	}
+	waitFor := func(expectation Expectation) {
+		t.Helper()
+
+		var act Expectation
+		for i := 0; i < 100; i++ {
+			runs, _ := os.ReadFile(dir + "/runs")
+			// the status is updated while we read it, hence we must hold the lock
+			taskManager.mu.RLock()
+			act = Expectation{
+				Runs:         strings.Count(string(runs), "run"),
+				RestartCount: taskManager.tasks[0].RestartCount,
+				LastExitCode: taskManager.tasks[0].LastExitCode,
+			}
+			taskManager.mu.RUnlock()
+			if act == expectation {
+				return
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		t.Fatalf("unexpected restarts (-want +got):\n%s", cmp.Diff(expectation, act))
+	}
+
+	// the command fails and is restarted twice
+	waitFor(Expectation{Runs: 3, RestartCount: 2, LastExitCode: 1})
+
+	err = taskManager.Restart("0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a manual restart re-arms the automatic restarts
+	waitFor(Expectation{Runs: 6, RestartCount: 5, LastExitCode: 1})
+
+	err = taskManager.Restart("1")
+	if err != ErrTaskNotFound {
+		t.Errorf("expected ErrTaskNotFound, got %v", err)
+	}
+}
+
+func TestTaskManagerRestartWhileTyping(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+	var (
+		storeLocation = t.TempDir()
+		dir           = t.TempDir()
+		command       = "echo run >> " + dir + "/runs; sleep 0.5; false"
+		restart       = string(restartOnFailure)
+		maxRestarts   = 1
+	)
+	gitpodTasks, err := json.Marshal([]TaskConfig{{Command: &command, Restart: &restart, MaxRestarts: &maxRestarts}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		terminalService = terminal.NewMuxTerminalService(terminal.NewMux())
+		contentState    = NewInMemoryContentState("")
+		taskManager     = newTasksManager(&Config{
+			WorkspaceConfig: WorkspaceConfig{
+				GitpodTasks:    string(gitpodTasks),
+				GitpodHeadless: "false",
+			},
+		}, terminalService, contentState, nil, nil, nil)
+	)
+	terminalService.DefaultWorkdir = dir
+	taskManager.storeLocation = storeLocation
+	contentState.MarkContentReady(csapi.WorkspaceInitFromOther)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go taskManager.Run(context.Background(), &wg, make(chan taskSuccess, 1))
+	defer func() {
+		// interactive shells ignore SIGTERM, hence they are killed once the context is done
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+		terminalService.Mux.Close(ctx)
+		wg.Wait()
+	}()
+	<-taskManager.ready
+
+	runs := func() int {
+		runs, _ := os.ReadFile(dir + "/runs")
+		return strings.Count(string(runs), "run")
+	}
+	for i := 0; i < 100 && runs() == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	taskManager.mu.RLock()
+	alias := taskManager.tasks[0].Terminal
+	taskManager.mu.RUnlock()
+	term, ok := terminalService.Mux.Get(alias)
+	if !ok {
+		t.Fatal("task terminal not found")
+	}
+
+	// the user starts typing while the command runs, hence it must not be restarted
+	_, err = term.Input([]byte("echo typing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(3 * time.Second)
+	if n := runs(); n != 1 {
+		t.Fatalf("expected the restart to be deferred, got %d runs", n)
+	}
+
+	// once the user discarded the line, the command is restarted
+	_, err = term.Input([]byte{0x03})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 50 && runs() != 2; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if n := runs(); n != 2 {
+		t.Errorf("expected the command to be restarted, got %d runs", n)
+	}
+}
+
+func TestTaskManagerFailingInit(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+	p := func(v string) *string { return &v }
+	var (
+		storeLocation = t.TempDir()
+		dir           = t.TempDir()
+	)
+	gitpodTasks, err := json.Marshal([]TaskConfig{
+		{Name: p("db"), Init: p("false"), Command: p("touch " + dir + "/db")},
+		{Name: p("api"), Command: p("touch " + dir + "/api"), DependsOn: []string{"db"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		terminalService = terminal.NewMuxTerminalService(terminal.NewMux())
+		contentState    = NewInMemoryContentState("")
+		taskManager     = newTasksManager(&Config{
+			WorkspaceConfig: WorkspaceConfig{
+				GitpodTasks:    string(gitpodTasks),
+				GitpodHeadless: "false",
+			},
+		}, terminalService, contentState, nil, nil, nil)
+	)
+	terminalService.DefaultWorkdir = dir
+	taskManager.storeLocation = storeLocation
+	contentState.MarkContentReady(csapi.WorkspaceInitFromOther)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go taskManager.Run(context.Background(), &wg, make(chan taskSuccess, 1))
+	defer func() {
+		// interactive shells ignore SIGTERM, hence they are killed once the context is done
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+		terminalService.Mux.Close(ctx)
+		wg.Wait()
+	}()
+	<-taskManager.ready
+
+	// the failing init command fails the task, which releases its dependents
+	db := taskManager.tasks[0]
+	select {
+	case <-db.ready:
+	case <-time.After(10 * time.Second):
+		t.Fatal("task with a failing init command did not fail")
+	}
+	if !db.readiness.Failed() {
+		t.Errorf("expected the task to fail, got %q", db.readiness)
+	}
+	for i := 0; i < 100; i++ {
+		taskManager.mu.RLock()
+		state := taskManager.tasks[1].State
+		taskManager.mu.RUnlock()
+		if state == api.TaskState_closed {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	taskManager.mu.RLock()
+	state := taskManager.tasks[1].State
+	taskManager.mu.RUnlock()
+	if state != api.TaskState_closed {
+		t.Errorf("expected the dependent task to be closed, got %s", state)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no command to run, got %v", entries)
+	}
+}
+
 type testHeadlessTaskProgressReporter struct {
 	Done    bool
 	Success bool
@@ -267,6 +607,7 @@ func TestGetTask(t *testing.T) {
 		Task          TaskConfig
 		IsHeadless    bool
 		ContentSource csapi.WorkspaceInitSource
+		ExitFile      string
 		Expectation   string
 	}{
 		{
@@ -294,11 +635,18 @@ func TestGetTask(t *testing.T) {
 			ContentSource: csapi.WorkspaceInitFromOther,
 			Expectation:   "{\nbefore\n} && {\ninit\n} && {\ncommand\n}",
 		},
+		{
+			Name:          "with exit report",
+			Task:          allTasks,
+			ContentSource: csapi.WorkspaceInitFromOther,
+			ExitFile:      "/exit-0",
+			Expectation:   "{\n{\nbefore\n} && {\ninit\n} && {\ncommand\n}\n}; echo $? > /exit-0",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			command := getCommand(&task{config: test.Task, TaskStatus: api.TaskStatus{Id: "0"}}, test.IsHeadless, test.IsHeadless, test.ContentSource, "/")
+			command := getCommand(&task{config: test.Task, TaskStatus: api.TaskStatus{Id: "0"}, exitFile: test.ExitFile}, test.IsHeadless, test.IsHeadless, test.ContentSource, "/")
 			if diff := cmp.Diff(test.Expectation, command); diff != "" {
 				t.Errorf("unexpected getCommand() (-want +got):\n%s", diff)
 			}
@@ -127,6 +127,16 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 		cpuPercentage := int64((float64(resp.Resources.Cpu.Used) / float64(resp.Resources.Cpu.Limit)) * 100)
 		memoryPercentage := int64((float64(resp.Resources.Memory.Used) / float64(resp.Resources.Memory.Limit)) * 100)
 
+		var storage *api.ResourceStatus
+		if s := resp.Resources.Storage; s != nil && s.Limit > 0 {
+			storagePercentage := int64((float64(s.Used) / float64(s.Limit)) * 100)
+			storage = &api.ResourceStatus{
+				Limit:    s.Limit,
+				Used:     s.Used,
+				Severity: calcSeverity(storagePercentage),
+			}
+		}
+
 		return &api.ResourcesStatusResponse{
 			Memory: &api.ResourceStatus{
 				Limit:    resp.Resources.Memory.Limit,
@@ -138,6 +148,7 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 				Used:     resp.Resources.Cpu.Used,
 				Severity: calcSeverity(cpuPercentage),
 			},
+			Storage: storage,
 		}, nil
 	}
 }
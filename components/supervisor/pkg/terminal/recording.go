@@ -0,0 +1,225 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	// RecordAnnotation enables the recording of a terminal when set to "true"
+	RecordAnnotation = "gitpod.io/record"
+
+	// recordingTitleInterval is the interval in which we check a recorded terminal for title changes
+	recordingTitleInterval = 1 * time.Second
+	// recordingFlushInterval is the interval in which recorded events are written to the recording
+	recordingFlushInterval = 1 * time.Second
+	// recordingFlushSize is the amount of pending events which are written to the recording right away
+	recordingFlushSize = 64 * 1024
+)
+
+// recordingHeader is the header of an asciicast v2 file,
+// see https://docs.asciinema.org/manual/asciicast/v2/
+type recordingHeader struct {
+	Version   int               `json:"version"`
+	Width     uint16            `json:"width"`
+	Height    uint16            `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// recording writes the output, size and title changes of a terminal to a file in the asciicast v2 format.
+// Events are buffered and written to the file in the background, s.t. recording never blocks the terminal.
+type recording struct {
+	Path string
+
+	mu     sync.Mutex
+	start  time.Time
+	title  string
+	closed bool
+	// partial is the beginning of a UTF-8 sequence which was cut off at the end of the last write
+	partial []byte
+	// pending are the events which have not been written to f yet
+	pending []byte
+
+	f     *os.File
+	flush chan struct{}
+	// flushed is closed once all events have been written and f has been closed
+	flushed  chan struct{}
+	flushErr error
+
+	done chan struct{}
+}
+
+func newRecording(path string, header recordingHeader) (*recording, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create recording: %w", err)
+	}
+
+	start := time.Now()
+	header.Version = 2
+	header.Timestamp = start.Unix()
+	rec := &recording{
+		Path:    path,
+		f:       f,
+		start:   start,
+		title:   header.Title,
+		flush:   make(chan struct{}, 1),
+		flushed: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	hdr, err := json.Marshal(header)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	_, err = f.Write(append(hdr, '\n'))
+	if err != nil {
+		f.Close()
+		return nil, xerrors.Errorf("cannot write recording header: %w", err)
+	}
+	go rec.flushPeriodically()
+
+	return rec, nil
+}
+
+// flushPeriodically writes the pending events to the recording until the recording is closed.
+// We flush regularly s.t. the recording is complete even if supervisor is killed.
+func (rec *recording) flushPeriodically() {
+	defer close(rec.flushed)
+
+	t := time.NewTicker(recordingFlushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-rec.done:
+			err := rec.writePending()
+			cerr := rec.f.Close()
+			if err == nil {
+				err = cerr
+			}
+			rec.flushErr = err
+			return
+		case <-t.C:
+		case <-rec.flush:
+		}
+
+		err := rec.writePending()
+		if err != nil {
+			log.WithError(err).WithField("path", rec.Path).Warn("cannot write terminal recording")
+		}
+	}
+}
+
+// writePending writes the pending events to the recording. It must only be called by flushPeriodically.
+func (rec *recording) writePending() error {
+	rec.mu.Lock()
+	pending := rec.pending
+	rec.pending = nil
+	rec.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	_, err := rec.f.Write(pending)
+	return err
+}
+
+// Output records output of the terminal.
+func (rec *recording) Output(p []byte) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	data := append(rec.partial, p...)
+	end := len(data)
+	// keep incomplete UTF-8 sequences for the next write, JSON would mangle them otherwise
+	for i := 1; i < utf8.UTFMax && i <= len(data); i++ {
+		if !utf8.RuneStart(data[len(data)-i]) {
+			continue
+		}
+		if !utf8.FullRune(data[len(data)-i:]) {
+			end = len(data) - i
+		}
+		break
+	}
+	rec.partial = append([]byte(nil), data[end:]...)
+	if end == 0 {
+		return
+	}
+
+	rec.event("o", string(data[:end]))
+}
+
+// Resize records a size change of the terminal.
+func (rec *recording) Resize(cols, rows uint16) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	rec.event("r", fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Title records a title change of the terminal as marker.
+func (rec *recording) Title(title string) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if title == rec.title {
+		return
+	}
+	rec.title = title
+	rec.event("m", "title: "+title)
+}
+
+// event adds an event to the pending events of the recording. Callers are expected to hold mu.
+func (rec *recording) event(code, data string) {
+	if rec.closed {
+		return
+	}
+
+	ev, err := json.Marshal([]interface{}{time.Since(rec.start).Seconds(), code, data})
+	if err != nil {
+		return
+	}
+	rec.pending = append(rec.pending, ev...)
+	rec.pending = append(rec.pending, '\n')
+	if len(rec.pending) >= recordingFlushSize {
+		select {
+		case rec.flush <- struct{}{}:
+		default:
+			// a flush is pending already
+		}
+	}
+}
+
+// Close stops the recording and waits for all events to be written.
+func (rec *recording) Close() error {
+	rec.mu.Lock()
+	if rec.closed {
+		rec.mu.Unlock()
+		return nil
+	}
+	if len(rec.partial) > 0 {
+		rec.event("o", string(rec.partial))
+		rec.partial = nil
+	}
+	rec.closed = true
+	close(rec.done)
+	rec.mu.Unlock()
+
+	<-rec.flushed
+	return rec.flushErr
+}
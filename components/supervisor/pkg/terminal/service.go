@@ -207,6 +207,7 @@ func (srv *MuxTerminalService) get(alias string) (*api.Terminal, bool) {
 		Annotations:    term.GetAnnotations(),
 		Title:          title,
 		TitleSource:    titleSource,
+		Recording:      term.Recording(),
 	}, true
 }
 
@@ -298,7 +299,7 @@ func (srv *MuxTerminalService) Write(ctx context.Context, req *api.WriteTerminal
 		return nil, status.Error(codes.NotFound, "terminal not found")
 	}
 
-	n, err := term.PTY.Write(req.Stdin)
+	n, err := term.Input(req.Stdin)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -320,7 +321,7 @@ func (srv *MuxTerminalService) SetSize(ctx context.Context, req *api.SetTerminal
 		return nil, status.Error(codes.FailedPrecondition, "wrong token or force not set")
 	}
 
-	err := pty.Setsize(term.PTY, &pty.Winsize{
+	err := term.SetSize(&pty.Winsize{
 		Cols: uint16(req.Size.Cols),
 		Rows: uint16(req.Size.Rows),
 		X:    uint16(req.Size.WidthPx),
@@ -354,5 +355,45 @@ func (srv *MuxTerminalService) UpdateAnnotations(ctx context.Context, req *api.U
 		return nil, status.Error(codes.NotFound, "terminal not found")
 	}
 	term.UpdateAnnotations(req.Changed, req.Deleted)
+
+	record, changed := req.Changed[RecordAnnotation]
+	for _, k := range req.Deleted {
+		if k == RecordAnnotation {
+			changed = true
+		}
+	}
+	if changed {
+		_, err := srv.setRecording(req.Alias, record == "true")
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &api.UpdateTerminalAnnotationsResponse{}, nil
 }
+
+// SetRecording starts or stops recording a terminal.
+func (srv *MuxTerminalService) SetRecording(ctx context.Context, req *api.SetTerminalRecordingRequest) (*api.SetTerminalRecordingResponse, error) {
+	file, err := srv.setRecording(req.Alias, req.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SetTerminalRecordingResponse{File: file}, nil
+}
+
+func (srv *MuxTerminalService) setRecording(alias string, enabled bool) (file string, err error) {
+	if enabled {
+		file, err = srv.Mux.StartRecording(alias)
+	} else {
+		err = srv.Mux.StopRecording(alias)
+	}
+	if err == ErrNotFound {
+		return "", status.Error(codes.NotFound, "terminal not found")
+	}
+	if err == ErrRecordingDisabled {
+		return "", status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return file, nil
+}
@@ -12,6 +12,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"syscall"
@@ -49,6 +50,11 @@ type Mux struct {
 	aliases []string
 	terms   map[string]*Term
 	mu      sync.RWMutex
+
+	// RecordingLocation is the directory terminals are recorded to. Terminals can't be recorded if it's empty.
+	RecordingLocation string
+	// RecordingCreds own the recordings if set
+	RecordingCreds *syscall.Credential
 }
 
 // Get returns a terminal for the given alias.
@@ -80,6 +86,13 @@ func (m *Mux) Start(cmd *exec.Cmd, options TermOptions) (alias string, err error
 
 	log.WithField("alias", alias).WithField("cmd", cmd.Path).Info("started new terminal")
 
+	if options.Record || options.Annotations[RecordAnnotation] == "true" {
+		_, err := m.startRecording(alias, term)
+		if err != nil {
+			log.WithError(err).WithField("alias", alias).Warn("cannot record terminal")
+		}
+	}
+
 	go func() {
 		term.waitErr = cmd.Wait()
 		close(term.waitDone)
@@ -89,6 +102,54 @@ func (m *Mux) Start(cmd *exec.Cmd, options TermOptions) (alias string, err error
 	return alias, nil
 }
 
+// StartRecording starts recording a terminal to a new file in RecordingLocation
+// and returns the path of that file. If the terminal is recorded already, the path of
+// the current recording is returned.
+func (m *Mux) StartRecording(alias string) (string, error) {
+	term, ok := m.Get(alias)
+	if !ok {
+		return "", ErrNotFound
+	}
+	return m.startRecording(alias, term)
+}
+
+func (m *Mux) startRecording(alias string, term *Term) (string, error) {
+	if m.RecordingLocation == "" {
+		return "", ErrRecordingDisabled
+	}
+	if path := term.Recording(); path != "" {
+		return path, nil
+	}
+
+	err := os.MkdirAll(m.RecordingLocation, 0755)
+	if err != nil {
+		return "", xerrors.Errorf("cannot create recording location: %w", err)
+	}
+	if m.RecordingCreds != nil {
+		_ = os.Chown(m.RecordingLocation, int(m.RecordingCreds.Uid), int(m.RecordingCreds.Gid))
+	}
+
+	path := filepath.Join(m.RecordingLocation, fmt.Sprintf("%s-%d.cast", alias, time.Now().Unix()))
+	res, err := term.StartRecording(path)
+	if err != nil {
+		return "", err
+	}
+	if res == path && m.RecordingCreds != nil {
+		_ = os.Chown(path, int(m.RecordingCreds.Uid), int(m.RecordingCreds.Gid))
+	}
+	log.WithField("alias", alias).WithField("path", res).Info("recording terminal")
+	return res, nil
+}
+
+// StopRecording stops recording a terminal.
+func (m *Mux) StopRecording(alias string) error {
+	term, ok := m.Get(alias)
+	if !ok {
+		return ErrNotFound
+	}
+	return term.StopRecording()
+}
+
 // Close closes all terminals.
 // force kills it's processes when the context gets cancelled
 func (m *Mux) Close(ctx context.Context) {
@@ -274,11 +335,19 @@ func newTerm(alias string, cmd *exec.Cmd, options TermOptions) (*Term, error) {
 		waitDone: make(chan struct{}),
 	}
 
+	if len(options.History) > 0 {
+		_, _ = res.Stdout.Write(options.History)
+		_, _ = res.Stdout.Write([]byte(historySeparator))
+	}
+
 	//nolint:errcheck
 	go io.Copy(res.Stdout, pty)
 	return res, nil
 }
 
+// historySeparator separates the output of a previous session of a terminal from the output of its new process
+const historySeparator = "\r\n\x1b[0m\x1b[2m─── output above is from before the workspace restarted ───\x1b[0m\r\n\r\n"
+
 // NoTimeout means that listener can block read forever
 var NoTimeout time.Duration = 1<<63 - 1
 
@@ -298,6 +367,14 @@ type TermOptions struct {
 
 	// LogToStdout forwards the terminal's stdout to supervisor's stdout
 	LogToStdout bool
+
+	// Record records the terminal in the asciicast v2 format to the recording location of the mux.
+	// Terminals are recorded as well when the RecordAnnotation is "true".
+	Record bool
+
+	// History is output of a previous session of the terminal, e.g. before the workspace restarted.
+	// It's shown before the output of the process.
+	History []byte
 }
 
 // Term is a pseudo-terminal.
@@ -315,6 +392,11 @@ type Term struct {
 	defaultTitle string
 	title        string
 
+	recording *recording
+
+	// inputPending is true if the user typed an incomplete line into the terminal
+	inputPending bool
+
 	Stdout *multiWriter
 
 	waitErr  error
@@ -366,8 +448,156 @@ func (term *Term) UpdateAnnotations(changed map[string]string, deleted []string)
 	}
 }
 
+// SetSize sets the size of the terminal.
+func (term *Term) SetSize(size *_pty.Winsize) error {
+	err := _pty.Setsize(term.PTY, size)
+	if err != nil {
+		return err
+	}
+
+	term.mu.RLock()
+	rec := term.recording
+	term.mu.RUnlock()
+	if rec != nil {
+		rec.Resize(size.Cols, size.Rows)
+	}
+	return nil
+}
+
+// History returns up to size bytes of the most recent output of the terminal.
+// The history starts at a new line if possible, s.t. it doesn't start in the middle of an escape sequence.
+func (term *Term) History(size int) []byte {
+	term.Stdout.mu.Lock()
+	out := term.Stdout.recorder.Bytes()
+	truncated := len(out) > size
+	if truncated {
+		out = out[len(out)-size:]
+	}
+	// the recorder's bytes must not be retained
+	out = append([]byte(nil), out...)
+	term.Stdout.mu.Unlock()
+
+	if truncated {
+		if i := bytes.IndexByte(out, '\n'); i != -1 {
+			out = out[i+1:]
+		}
+	}
+	return out
+}
+
+// Recording returns the path of the file the terminal is recorded to, or an empty string
+// if the terminal isn't recorded.
+func (term *Term) Recording() string {
+	term.mu.RLock()
+	defer term.mu.RUnlock()
+	if term.recording == nil {
+		return ""
+	}
+	return term.recording.Path
+}
+
+// StartRecording starts recording the terminal to a file in the asciicast v2 format and returns the path
+// of the recording. If the terminal is recorded already, the path of the current recording is returned.
+func (term *Term) StartRecording(path string) (string, error) {
+	title, _, _ := term.GetTitle()
+
+	term.mu.Lock()
+	defer term.mu.Unlock()
+
+	if term.closed {
+		return "", ErrNotFound
+	}
+	if term.recording != nil {
+		return term.recording.Path, nil
+	}
+
+	size, err := _pty.GetsizeFull(term.PTY)
+	if err != nil {
+		return "", xerrors.Errorf("cannot get terminal size: %w", err)
+	}
+	header := recordingHeader{
+		Width:  size.Cols,
+		Height: size.Rows,
+		Title:  title,
+		Env:    map[string]string{"TERM": "xterm-256color"},
+	}
+	if len(term.Command.Args) > 0 {
+		header.Env["SHELL"] = term.Command.Args[0]
+	}
+	rec, err := newRecording(path, header)
+	if err != nil {
+		return "", err
+	}
+	term.recording = rec
+	term.Stdout.setRecording(rec)
+
+	go func() {
+		t := time.NewTicker(recordingTitleInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-rec.done:
+				return
+			case <-t.C:
+				title, _, _ := term.GetTitle()
+				rec.Title(title)
+			}
+		}
+	}()
+
+	return path, nil
+}
+
+// StopRecording stops recording the terminal.
+func (term *Term) StopRecording() error {
+	term.mu.Lock()
+	defer term.mu.Unlock()
+
+	return term.stopRecording()
+}
+
+// stopRecording stops recording the terminal. Callers are expected to hold mu.
+func (term *Term) stopRecording() error {
+	rec := term.recording
+	if rec == nil {
+		return nil
+	}
+	term.recording = nil
+	term.Stdout.setRecording(nil)
+	return rec.Close()
+}
+
+// Input writes input of the user to the terminal.
+func (term *Term) Input(p []byte) (int, error) {
+	if len(p) > 0 {
+		term.mu.Lock()
+		switch p[len(p)-1] {
+		case '\r', '\n', 0x03, 0x04:
+			// enter, ^C and ^D complete or discard the line
+			term.inputPending = false
+		default:
+			term.inputPending = true
+		}
+		term.mu.Unlock()
+	}
+	return term.PTY.Write(p)
+}
+
+// InputPending returns true if the user typed an incomplete line into the terminal.
+func (term *Term) InputPending() bool {
+	term.mu.RLock()
+	defer term.mu.RUnlock()
+	return term.inputPending
+}
+
+// ForegroundProcessGroup returns the ID of the process group running in the foreground of the terminal.
+// When the shell of the terminal is idle, that's the process group of the shell.
+func (term *Term) ForegroundProcessGroup() (int, error) {
+	return unix.IoctlGetInt(int(term.PTY.Fd()), unix.TIOCGPGRP)
+}
+
 func (term *Term) resolveForegroundCommand() (string, error) {
-	pgrp, err := unix.IoctlGetInt(int(term.PTY.Fd()), unix.TIOCGPGRP)
+	pgrp, err := term.ForegroundProcessGroup()
 	if err != nil {
 		return "", err
 	}
@@ -411,6 +641,7 @@ func (term *Term) Close(ctx context.Context) error {
 	}
 
 	writeErr := term.Stdout.Close()
+	recordingErr := term.stopRecording()
 
 	slaveErr := errors.New("Slave FD nil")
 	if term.pts != nil {
@@ -428,6 +659,9 @@ func (term *Term) Close(ctx context.Context) error {
 	if writeErr != nil {
 		errs = append(errs, "Multiwriter: "+writeErr.Error())
 	}
+	if recordingErr != nil {
+		errs = append(errs, "Recording: "+recordingErr.Error())
+	}
 	if slaveErr != nil {
 		errs = append(errs, "Slave: "+slaveErr.Error())
 	}
@@ -451,6 +685,8 @@ type multiWriter struct {
 	// ring buffer to record last 256kb of pty output
 	// new listener is initialized with the latest recodring first
 	recorder *RingBuffer
+	// recording records the output to a file, if the terminal is recorded
+	recording *recording
 
 	logStdout bool
 	logLabel  string
@@ -461,6 +697,8 @@ var (
 	ErrNotFound = errors.New("not found")
 	// ErrReadTimeout happens when a listener takes too long to read.
 	ErrReadTimeout = errors.New("read timeout")
+	// ErrRecordingDisabled means terminals can't be recorded because the mux has no recording location.
+	ErrRecordingDisabled = errors.New("terminal recording is disabled")
 )
 
 type multiWriterListener struct {
@@ -584,6 +822,9 @@ func (mw *multiWriter) Write(p []byte) (n int, err error) {
 	defer mw.mu.Unlock()
 
 	mw.recorder.Write(p)
+	if mw.recording != nil {
+		mw.recording.Output(p)
+	}
 	if mw.logStdout {
 		log.WithFields(logrus.Fields{
 			"terminalOutput": true,
@@ -611,6 +852,18 @@ func (mw *multiWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// setRecording starts or stops recording the output. New recordings start with
+// the output that is still in the backlog s.t. they show the current screen.
+func (mw *multiWriter) setRecording(rec *recording) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	if rec != nil {
+		rec.Output(mw.recorder.Bytes())
+	}
+	mw.recording = rec
+}
+
 func (mw *multiWriter) Close() error {
 	mw.mu.Lock()
 	defer mw.mu.Unlock()
@@ -7,9 +7,11 @@ package terminal
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -391,3 +393,101 @@ func TestWorkDirProvider(t *testing.T) {
 		expectedWorkDir: providedWorkDir,
 	})
 }
+
+func TestRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.cast")
+	rec, err := newRecording(path, recordingHeader{Width: 80, Height: 24, Title: "bash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	umlaut := []byte("ä")
+	rec.Output([]byte("hello "))
+	rec.Output(umlaut[:1])
+	rec.Output(umlaut[1:])
+	rec.Resize(120, 40)
+	rec.Title("bash")
+	rec.Title("watch")
+	rec.Output(umlaut[:1])
+	err = rec.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+
+	var header recordingHeader
+	err = json.Unmarshal([]byte(lines[0]), &header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header.Timestamp = 0
+	if diff := cmp.Diff(recordingHeader{Version: 2, Width: 80, Height: 24, Title: "bash"}, header); diff != "" {
+		t.Errorf("unexpected header (-want +got):\n%s", diff)
+	}
+
+	var events [][]string
+	for _, line := range lines[1:] {
+		var ev []interface{}
+		err = json.Unmarshal([]byte(line), &ev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, []string{ev[1].(string), ev[2].(string)})
+	}
+	expectation := [][]string{
+		{"o", "hello "},
+		{"o", "ä"},
+		{"r", "120x40"},
+		{"m", "title: watch"},
+		{"o", "�"},
+	}
+	if diff := cmp.Diff(expectation, events); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestMuxRecording(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	mux := NewMux()
+	mux.RecordingLocation = filepath.Join(t.TempDir(), "recordings")
+	defer mux.Close(ctx)
+
+	alias, err := mux.Start(exec.Command("/bin/sh", "-c", "echo hello world; sleep 1"), TermOptions{
+		Annotations: map[string]string{RecordAnnotation: "true"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	term, _ := mux.Get(alias)
+	path := term.Recording()
+	if path == "" {
+		t.Fatal("terminal is not recorded")
+	}
+	if res, err := mux.StartRecording(alias); err != nil || res != path {
+		t.Fatalf("recording the terminal again should return the current recording %s, got %s (%v)", path, res, err)
+	}
+
+	_, _ = term.Wait()
+	err = mux.StopRecording(alias)
+	if err != nil && err != ErrNotFound {
+		t.Fatal(err)
+	}
+	if term.Recording() != "" {
+		t.Error("terminal is still recorded")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "hello world") {
+		t.Errorf("recording does not contain the terminal output:\n%s", content)
+	}
+}
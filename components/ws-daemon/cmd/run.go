@@ -56,6 +56,7 @@ var runCmd = &cobra.Command{
 			baseserver.WithGRPC(&cfg.Service),
 			baseserver.WithHealthHandler(health),
 			baseserver.WithMetricsRegistry(dmn.MetricsRegistry()),
+			baseserver.WithDebugHandler("/debug/memlimit", dmn.MemoryDistributorDebug()),
 			baseserver.WithVersion(Version),
 		)
 		if err != nil {
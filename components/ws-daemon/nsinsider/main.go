@@ -597,6 +597,217 @@ func main() {
 						return xerrors.Errorf("failed to apply connection limit: %v", err)
 					}
 
+					return nil
+				},
+			},
+			{
+				Name:  "setup-egress-policy",
+				Usage: "set up the chain and sets of the workspace egress policy",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:     "allowlist",
+						Usage:    "block connections to destinations which are not allowed explicitly",
+						Required: false,
+					},
+					&cli.BoolFlag{
+						Name:     "enforce",
+						Usage:    "drop blocked connections instead of only counting them",
+						Required: false,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					const (
+						blockedStats = "ws-egress-blocked-stats"
+						blockedSet   = "egress-blocked"
+					)
+					nftcon := nftables.Conn{}
+
+					verdict := expr.VerdictAccept
+					if c.Bool("enforce") {
+						verdict = expr.VerdictDrop
+					}
+
+					// nft add table ip gitpod
+					gitpodTable := nftcon.AddTable(&nftables.Table{
+						Family: nftables.TableFamilyIPv4,
+						Name:   "gitpod",
+					})
+
+					// nft add chain ip gitpod egress-policy { type filter hook postrouting priority 0 \; }
+					// We flush the chain so that this command can be used to change an existing policy.
+					egress := nftcon.AddChain(&nftables.Chain{
+						Table:    gitpodTable,
+						Name:     "egress-policy",
+						Type:     nftables.ChainTypeFilter,
+						Hooknum:  nftables.ChainHookPostrouting,
+						Priority: nftables.ChainPriorityFilter,
+					})
+					nftcon.FlushChain(egress)
+
+					// nft add counter gitpod ws-egress-blocked-stats
+					nftcon.AddObject(&nftables.CounterObj{
+						Table: gitpodTable,
+						Name:  blockedStats,
+					})
+
+					// nft add set gitpod egress-{always,deny,allow} { type ipv4_addr; flags interval; }
+					// The elements of these sets are maintained by ws-daemon.
+					sets := make(map[string]*nftables.Set)
+					for _, name := range []string{"egress-always", "egress-deny", "egress-allow"} {
+						set := &nftables.Set{
+							Table:    gitpodTable,
+							Name:     name,
+							KeyType:  nftables.TypeIPAddr,
+							Interval: true,
+						}
+						if err := nftcon.AddSet(set, nil); err != nil {
+							return err
+						}
+						sets[name] = set
+					}
+
+					// nft add set gitpod egress-blocked { type ipv4_addr; flags timeout, dynamic; timeout 1h; }
+					blocked := &nftables.Set{
+						Table:      gitpodTable,
+						Name:       blockedSet,
+						KeyType:    nftables.TypeIPAddr,
+						Dynamic:    true,
+						HasTimeout: true,
+						Timeout:    time.Hour,
+					}
+					if err := nftcon.AddSet(blocked, nil); err != nil {
+						return err
+					}
+
+					// ip daddr
+					daddr := &expr.Payload{
+						DestRegister: 1,
+						Base:         expr.PayloadBaseNetworkHeader,
+						Offset:       uint32(16),
+						Len:          uint32(4),
+					}
+					lookup := func(name string) expr.Any {
+						return &expr.Lookup{
+							SourceRegister: 1,
+							SetName:        sets[name].Name,
+							SetID:          sets[name].ID,
+						}
+					}
+					// update @egress-blocked { ip daddr } counter name ws-egress-blocked-stats drop
+					block := []expr.Any{
+						&expr.Dynset{
+							SrcRegKey: 1,
+							SetName:   blocked.Name,
+							SetID:     blocked.ID,
+							Operation: uint32(unix.NFT_DYNSET_OP_UPDATE),
+							Timeout:   time.Hour,
+						},
+						&expr.Objref{
+							Type: 1,
+							Name: blockedStats,
+						},
+						&expr.Verdict{
+							Kind: verdict,
+						},
+					}
+
+					rules := [][]expr.Any{
+						// oifname != "eth0" accept
+						// only traffic leaving the pod is subject to the policy
+						{
+							&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+							&expr.Cmp{
+								Op:       expr.CmpOpNeq,
+								Register: 1,
+								Data:     ifname("eth0"),
+							},
+							&expr.Verdict{Kind: expr.VerdictAccept},
+						},
+						// ct state established,related accept
+						{
+							&expr.Ct{Key: expr.CtKeySTATE, Register: 1},
+							&expr.Bitwise{
+								DestRegister:   1,
+								SourceRegister: 1,
+								Len:            4,
+								Mask:           binaryutil.NativeEndian.PutUint32(expr.CtStateBitESTABLISHED | expr.CtStateBitRELATED),
+								Xor:            binaryutil.NativeEndian.PutUint32(0),
+							},
+							&expr.Cmp{
+								Op:       expr.CmpOpNeq,
+								Register: 1,
+								Data:     []byte{0, 0, 0, 0},
+							},
+							&expr.Verdict{Kind: expr.VerdictAccept},
+						},
+						// ip daddr @egress-always accept
+						{daddr, lookup("egress-always"), &expr.Verdict{Kind: expr.VerdictAccept}},
+						// ip daddr @egress-deny update @egress-blocked { ip daddr } counter name ws-egress-blocked-stats drop
+						append([]expr.Any{daddr, lookup("egress-deny")}, block...),
+						// ip daddr @egress-allow accept
+						{daddr, lookup("egress-allow"), &expr.Verdict{Kind: expr.VerdictAccept}},
+					}
+					if c.Bool("allowlist") {
+						// ip daddr update @egress-blocked { ip daddr } counter name ws-egress-blocked-stats drop
+						rules = append(rules, append([]expr.Any{daddr}, block...))
+					}
+					for _, r := range rules {
+						nftcon.AddRule(&nftables.Rule{
+							Table: gitpodTable,
+							Chain: egress,
+							Exprs: r,
+						})
+					}
+
+					// The sets only hold IPv4 addresses, hence we cannot tell which IPv6 destinations a policy allows.
+					// To keep IPv6 from bypassing the policy, we block all new IPv6 connections leaving the pod.
+					// nft add table ip6 gitpod
+					gitpodTable6 := nftcon.AddTable(&nftables.Table{
+						Family: nftables.TableFamilyIPv6,
+						Name:   "gitpod",
+					})
+					// nft add chain ip6 gitpod egress-policy { type filter hook postrouting priority 0 \; }
+					egress6 := nftcon.AddChain(&nftables.Chain{
+						Table:    gitpodTable6,
+						Name:     "egress-policy",
+						Type:     nftables.ChainTypeFilter,
+						Hooknum:  nftables.ChainHookPostrouting,
+						Priority: nftables.ChainPriorityFilter,
+					})
+					nftcon.FlushChain(egress6)
+					// nft add counter ip6 gitpod ws-egress-blocked-stats
+					nftcon.AddObject(&nftables.CounterObj{
+						Table: gitpodTable6,
+						Name:  blockedStats,
+					})
+					rules6 := [][]expr.Any{
+						// oifname != "eth0" accept
+						rules[0],
+						// ct state established,related accept
+						rules[1],
+						// counter name ws-egress-blocked-stats drop
+						{
+							&expr.Objref{
+								Type: 1,
+								Name: blockedStats,
+							},
+							&expr.Verdict{
+								Kind: verdict,
+							},
+						},
+					}
+					for _, r := range rules6 {
+						nftcon.AddRule(&nftables.Rule{
+							Table: gitpodTable6,
+							Chain: egress6,
+							Exprs: r,
+						})
+					}
+
+					if err := nftcon.Flush(); err != nil {
+						return xerrors.Errorf("failed to apply egress policy: %v", err)
+					}
+
 					return nil
 				},
 			},
@@ -610,6 +821,13 @@ func main() {
 	}
 }
 
+// ifname pads an interface name for comparison with the oifname meta key
+func ifname(n string) []byte {
+	b := make([]byte, unix.IFNAMSIZ)
+	copy(b, n)
+	return b
+}
+
 func syscallMoveMount(fromDirFD int, fromPath string, toDirFD int, toPath string, flags uintptr) error {
 	fromPathP, err := unix.BytePtrFromString(fromPath)
 	if err != nil {
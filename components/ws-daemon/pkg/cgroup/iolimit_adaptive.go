@@ -0,0 +1,293 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	ioWriteBPS = iota
+	ioReadBPS
+	ioWriteIOPS
+	ioReadIOPS
+	numIOKinds
+)
+
+var ioKindNames = [numIOKinds]string{"write_bps", "read_bps", "write_iops", "read_iops"}
+
+const (
+	// DefaultNodeIOPressureThreshold is the share of time tasks on the node stall on IO above which contending workspaces are limited
+	DefaultNodeIOPressureThreshold = 0.2
+	// DefaultWorkspaceIOPressureThreshold is the share of time a workspace must stall on IO to count as contending
+	DefaultWorkspaceIOPressureThreshold = 0.1
+	// DefaultIOLimitFactor is the factor by which limits are tightened per control period
+	DefaultIOLimitFactor = 0.5
+
+	// Limits relax only once the node pressure has fallen well below the threshold, so that they don't flap around it.
+	ioRelaxRatio = 0.5
+)
+
+// IOLimits are the io.max limits of a workspace. A zero limit means unlimited.
+type IOLimits struct {
+	WriteBytesPerSecond int64
+	ReadBytesPerSecond  int64
+	WriteIOPS           int64
+	ReadIOPS            int64
+}
+
+func (l IOLimits) values() [numIOKinds]int64 {
+	return [numIOKinds]int64{l.WriteBytesPerSecond, l.ReadBytesPerSecond, l.WriteIOPS, l.ReadIOPS}
+}
+
+func ioLimitsFromValues(v [numIOKinds]int64) IOLimits {
+	return IOLimits{
+		WriteBytesPerSecond: v[ioWriteBPS],
+		ReadBytesPerSecond:  v[ioReadBPS],
+		WriteIOPS:           v[ioWriteIOPS],
+		ReadIOPS:            v[ioReadIOPS],
+	}
+}
+
+// IOSample is a reading of the cumulative IO statistics of a workspace
+type IOSample struct {
+	ID string
+
+	// Pressure is the total time tasks of the workspace stalled on IO
+	Pressure   time.Duration
+	WriteBytes uint64
+	ReadBytes  uint64
+	WriteIOs   uint64
+	ReadIOs    uint64
+}
+
+func (s IOSample) values() [numIOKinds]uint64 {
+	return [numIOKinds]uint64{s.WriteBytes, s.ReadBytes, s.WriteIOs, s.ReadIOs}
+}
+
+// NewAdaptiveIOController creates a new adaptive IO controller which limits workspaces no further than floor
+// and no more generously than ceiling.
+func NewAdaptiveIOController(ceiling, floor IOLimits) *AdaptiveIOController {
+	return &AdaptiveIOController{
+		Ceiling:                    ceiling,
+		Floor:                      floor,
+		NodePressureThreshold:      DefaultNodeIOPressureThreshold,
+		WorkspacePressureThreshold: DefaultWorkspaceIOPressureThreshold,
+		Factor:                     DefaultIOLimitFactor,
+		workspaces:                 make(map[string]*adaptiveIOWorkspace),
+	}
+}
+
+// AdaptiveIOController tightens the IO limits of the workspaces which cause IO contention on the node,
+// and relaxes them again once the contention is gone.
+//
+// While tasks on the node stall on IO, a workspace is considered contending if its own tasks stall on IO
+// and it does more than its fair share of the IO on the node. Its limits are then lowered to a fraction of
+// its current rate, down to the floor. Once the node pressure drops, the limits are raised again step by step
+// until they reach the ceiling. Only kinds of IO with a floor are limited adaptively.
+type AdaptiveIOController struct {
+	// Ceiling are the static limits. Zero means unlimited.
+	Ceiling IOLimits
+	// Floor are the lowest limits the controller sets. Zero disables adaptive limiting for the kind of IO.
+	Floor IOLimits
+
+	// NodePressureThreshold is the share of time tasks on the node stall on IO above which limits are tightened
+	NodePressureThreshold float64
+	// WorkspacePressureThreshold is the share of time a workspace's tasks must stall on IO for it to count as contending
+	WorkspacePressureThreshold float64
+	// Factor is the factor by which limits are tightened per tick. Relaxing divides by it.
+	Factor float64
+
+	workspaces   map[string]*adaptiveIOWorkspace
+	nodePressure *time.Duration
+}
+
+type adaptiveIOWorkspace struct {
+	last *IOSample
+
+	// limits are the adaptive limits of the workspace. Zero means the workspace is not limited adaptively.
+	limits [numIOKinds]int64
+	// start is the limit the workspace had when it was first limited adaptively. Once relaxed back to it, the adaptive limit is dropped.
+	start [numIOKinds]int64
+}
+
+// AdaptiveIOTick describes the decisions of a controller tick
+type AdaptiveIOTick struct {
+	NodePressure float64
+	Workspaces   []AdaptiveIOWorkspace
+}
+
+// AdaptiveIOWorkspace describes why a workspace has its current limits
+type AdaptiveIOWorkspace struct {
+	ID string
+	// Pressure is the share of time the workspace's tasks stalled on IO
+	Pressure float64
+	// Share is the workspace's share of the IO of all workspaces on the node
+	Share      float64
+	Contending bool
+	Limits     IOLimits
+	Changed    bool
+}
+
+// Limits returns the current limits of a workspace
+func (c *AdaptiveIOController) Limits(id string) IOLimits {
+	ws, ok := c.workspaces[id]
+	if !ok {
+		return c.Ceiling
+	}
+	return c.effective(ws)
+}
+
+func (c *AdaptiveIOController) effective(ws *adaptiveIOWorkspace) IOLimits {
+	ceiling := c.Ceiling.values()
+	res := ceiling
+	for k := range res {
+		if ws.limits[k] > 0 && (ceiling[k] == 0 || ws.limits[k] < ceiling[k]) {
+			res[k] = ws.limits[k]
+		}
+	}
+	return ioLimitsFromValues(res)
+}
+
+// Tick updates the limits of all workspaces. Callers are expected to call this function repeatedly,
+// with dt time inbetween calls. nodePressure is the total time tasks on the node stalled on IO.
+func (c *AdaptiveIOController) Tick(dt time.Duration, nodePressure time.Duration, samples []IOSample) AdaptiveIOTick {
+	var res AdaptiveIOTick
+	if c.nodePressure != nil {
+		res.NodePressure = pressureShare(nodePressure-*c.nodePressure, dt)
+	}
+	c.nodePressure = &nodePressure
+
+	type usage struct {
+		Pressure float64
+		Rates    [numIOKinds]int64
+	}
+	var (
+		usages          = make(map[string]usage, len(samples))
+		totalB, totalIO int64
+	)
+	for _, s := range samples {
+		s := s
+		ws, ok := c.workspaces[s.ID]
+		if !ok {
+			ws = &adaptiveIOWorkspace{}
+			c.workspaces[s.ID] = ws
+		}
+
+		var u usage
+		if ws.last != nil {
+			u.Pressure = pressureShare(s.Pressure-ws.last.Pressure, dt)
+			cur, prev := s.values(), ws.last.values()
+			for k := range cur {
+				if cur[k] < prev[k] {
+					continue
+				}
+				u.Rates[k] = int64(float64(cur[k]-prev[k]) / dt.Seconds())
+			}
+		}
+		ws.last = &s
+		usages[s.ID] = u
+		totalB += u.Rates[ioWriteBPS] + u.Rates[ioReadBPS]
+		totalIO += u.Rates[ioWriteIOPS] + u.Rates[ioReadIOPS]
+	}
+	for id := range c.workspaces {
+		if _, ok := usages[id]; !ok {
+			delete(c.workspaces, id)
+		}
+	}
+
+	ids := make([]string, 0, len(usages))
+	for id := range usages {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var (
+		ceiling  = c.Ceiling.values()
+		floor    = c.Floor.values()
+		fairness = 1 / float64(len(ids))
+	)
+	for _, id := range ids {
+		var (
+			ws    = c.workspaces[id]
+			u     = usages[id]
+			prev  = c.effective(ws)
+			share float64
+		)
+		if totalB > 0 {
+			share = float64(u.Rates[ioWriteBPS]+u.Rates[ioReadBPS]) / float64(totalB)
+		}
+		if totalIO > 0 {
+			if s := float64(u.Rates[ioWriteIOPS]+u.Rates[ioReadIOPS]) / float64(totalIO); s > share {
+				share = s
+			}
+		}
+		contending := res.NodePressure >= c.NodePressureThreshold &&
+			u.Pressure >= c.WorkspacePressureThreshold &&
+			share > 0 && share >= fairness
+
+		cur := prev.values()
+		switch {
+		case contending:
+			for k := range cur {
+				if floor[k] == 0 {
+					continue
+				}
+
+				// we tighten based on what the workspace actually does, not on a limit it doesn't reach
+				base := cur[k]
+				if r := u.Rates[k]; r > 0 && (base == 0 || r < base) {
+					base = r
+				}
+				if base == 0 {
+					continue
+				}
+				limit := int64(float64(base) * c.Factor)
+				if limit < floor[k] {
+					limit = floor[k]
+				}
+				if ws.limits[k] == 0 {
+					ws.start[k] = ceiling[k]
+					if ws.start[k] == 0 || base < ws.start[k] {
+						ws.start[k] = base
+					}
+				}
+				ws.limits[k] = limit
+			}
+		case res.NodePressure < c.NodePressureThreshold*ioRelaxRatio:
+			for k := range cur {
+				if ws.limits[k] == 0 {
+					continue
+				}
+
+				limit := int64(float64(ws.limits[k]) / c.Factor)
+				if limit >= ws.start[k] {
+					limit = 0
+				}
+				ws.limits[k] = limit
+			}
+		}
+
+		limits := c.effective(ws)
+		res.Workspaces = append(res.Workspaces, AdaptiveIOWorkspace{
+			ID:         id,
+			Pressure:   u.Pressure,
+			Share:      share,
+			Contending: contending,
+			Limits:     limits,
+			Changed:    limits != prev,
+		})
+	}
+
+	return res
+}
+
+func pressureShare(stalled, dt time.Duration) float64 {
+	if stalled <= 0 || dt <= 0 {
+		return 0
+	}
+	return float64(stalled) / float64(dt)
+}
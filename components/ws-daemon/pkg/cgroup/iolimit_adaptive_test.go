@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
+)
+
+const mb = 1000 * 1000
+
+func TestAdaptiveIOController(t *testing.T) {
+	const dt = 10 * time.Second
+
+	type tick struct {
+		// NodePressure, Pressure are the share of dt tasks of the node and of the heavy workspace stalled on IO
+		NodePressure float64
+		Pressure     float64
+		// Rate is the write rate of the heavy workspace in MB/s. The light workspace writes 10 MB/s.
+		Rate        uint64
+		Expectation cgroup.IOLimits
+	}
+	tests := []struct {
+		Name    string
+		Ceiling cgroup.IOLimits
+		Floor   cgroup.IOLimits
+		Ticks   []tick
+	}{
+		{
+			Name:    "no contention",
+			Ceiling: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb},
+			Floor:   cgroup.IOLimits{WriteBytesPerSecond: 10 * mb},
+			Ticks: []tick{
+				{Rate: 80, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb}},
+				{Rate: 80, NodePressure: 0.1, Pressure: 0.5, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb}},
+			},
+		},
+		{
+			Name:    "tightens contenders and relaxes once the contention is gone",
+			Ceiling: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb, ReadBytesPerSecond: 50 * mb},
+			Floor:   cgroup.IOLimits{WriteBytesPerSecond: 10 * mb},
+			Ticks: []tick{
+				{Rate: 80, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb, ReadBytesPerSecond: 50 * mb}},
+				{Rate: 80, NodePressure: 0.5, Pressure: 0.3, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 40 * mb, ReadBytesPerSecond: 50 * mb}},
+				{Rate: 40, NodePressure: 0.5, Pressure: 0.3, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 20 * mb, ReadBytesPerSecond: 50 * mb}},
+				{Rate: 20, NodePressure: 0.5, Pressure: 0.3, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 10 * mb, ReadBytesPerSecond: 50 * mb}},
+				{Rate: 10, NodePressure: 0.5, Pressure: 0.3, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 10 * mb, ReadBytesPerSecond: 50 * mb}},
+				// between the relax and the tighten threshold the limits stay as they are
+				{Rate: 10, NodePressure: 0.15, Pressure: 0.3, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 10 * mb, ReadBytesPerSecond: 50 * mb}},
+				{Rate: 10, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 20 * mb, ReadBytesPerSecond: 50 * mb}},
+				{Rate: 20, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 40 * mb, ReadBytesPerSecond: 50 * mb}},
+				{Rate: 40, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb, ReadBytesPerSecond: 50 * mb}},
+			},
+		},
+		{
+			Name:  "unlimited ceiling",
+			Floor: cgroup.IOLimits{WriteBytesPerSecond: 10 * mb},
+			Ticks: []tick{
+				{Rate: 80, Expectation: cgroup.IOLimits{}},
+				{Rate: 80, NodePressure: 0.5, Pressure: 0.3, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 40 * mb}},
+				{Rate: 40, Expectation: cgroup.IOLimits{}},
+			},
+		},
+		{
+			Name:    "workspace not stalling on IO is no contender",
+			Ceiling: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb},
+			Floor:   cgroup.IOLimits{WriteBytesPerSecond: 10 * mb},
+			Ticks: []tick{
+				{Rate: 80, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb}},
+				{Rate: 80, NodePressure: 0.5, Pressure: 0.01, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb}},
+			},
+		},
+		{
+			Name:    "workspace below its fair share is no contender",
+			Ceiling: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb},
+			Floor:   cgroup.IOLimits{WriteBytesPerSecond: 1 * mb},
+			Ticks: []tick{
+				{Rate: 5, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb}},
+				{Rate: 5, NodePressure: 0.5, Pressure: 0.3, Expectation: cgroup.IOLimits{WriteBytesPerSecond: 100 * mb}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				ctrl         = cgroup.NewAdaptiveIOController(test.Ceiling, test.Floor)
+				nodePressure time.Duration
+				heavy        = cgroup.IOSample{ID: "heavy"}
+				light        = cgroup.IOSample{ID: "light"}
+			)
+			for i, tick := range test.Ticks {
+				if i > 0 {
+					nodePressure += time.Duration(tick.NodePressure * float64(dt))
+					heavy.Pressure += time.Duration(tick.Pressure * float64(dt))
+					heavy.WriteBytes += tick.Rate * mb * uint64(dt/time.Second)
+					light.WriteBytes += 10 * mb * uint64(dt/time.Second)
+				}
+
+				res := ctrl.Tick(dt, nodePressure, []cgroup.IOSample{heavy, light})
+				if diff := cmp.Diff(tick.Expectation, ctrl.Limits("heavy")); diff != "" {
+					t.Errorf("unexpected limits in tick %d (-want +got):\n%s", i, diff)
+				}
+				if diff := cmp.Diff(test.Ceiling, ctrl.Limits("light")); diff != "" {
+					t.Errorf("light workspace was limited in tick %d (-want +got):\n%s", i, diff)
+				}
+				if len(res.Workspaces) != 2 {
+					t.Errorf("expected two workspaces in tick %d, got %d", i, len(res.Workspaces))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
+	cgroupsv2 "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+)
+
+const (
+	defaultIOControlPeriod  = 10 * time.Second
+	defaultNodePressurePath = "/proc/pressure/io"
+)
+
+// AdaptiveIOLimitConfig configures adaptive IO limiting. The static IO limits remain the ceiling
+// of the adaptive limits. Changes to this configuration require a restart.
+type AdaptiveIOLimitConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Floor are the lowest limits set on contending workspaces. Only kinds of IO with a floor are limited adaptively.
+	Floor IOLimitFloorConfig `json:"floor"`
+
+	// NodePressureThreshold is the share of time tasks on the node stall on IO above which the limits of contending workspaces are tightened. Defaults to 0.2.
+	NodePressureThreshold float64 `json:"nodePressureThreshold,omitempty"`
+	// WorkspacePressureThreshold is the share of time a workspace's tasks must stall on IO for it to count as contending. Defaults to 0.1.
+	WorkspacePressureThreshold float64 `json:"workspacePressureThreshold,omitempty"`
+	// Factor is the factor by which limits are tightened per control period. Relaxing divides by it. Defaults to 0.5.
+	Factor float64 `json:"factor,omitempty"`
+
+	// ControlPeriod is the interval at which limits are adjusted. Defaults to 10 seconds.
+	ControlPeriod util.Duration `json:"controlPeriod,omitempty"`
+	// NodePressurePath is the file containing the IO pressure of the node. Defaults to /proc/pressure/io.
+	NodePressurePath string `json:"nodePressurePath,omitempty"`
+}
+
+type IOLimitFloorConfig struct {
+	WriteBWPerSecond resource.Quantity `json:"writeBandwidthPerSecond"`
+	ReadBWPerSecond  resource.Quantity `json:"readBandwidthPerSecond"`
+	WriteIOPS        int64             `json:"writeIOPS"`
+	ReadIOPS         int64             `json:"readIOPS"`
+}
+
+func newAdaptiveIOLimiter(cfg AdaptiveIOLimitConfig, ceiling IOLimits) *adaptiveIOLimiter {
+	ctrl := NewAdaptiveIOController(ceiling, IOLimits{
+		WriteBytesPerSecond: cfg.Floor.WriteBWPerSecond.Value(),
+		ReadBytesPerSecond:  cfg.Floor.ReadBWPerSecond.Value(),
+		WriteIOPS:           cfg.Floor.WriteIOPS,
+		ReadIOPS:            cfg.Floor.ReadIOPS,
+	})
+	if cfg.NodePressureThreshold > 0 {
+		ctrl.NodePressureThreshold = cfg.NodePressureThreshold
+	}
+	if cfg.WorkspacePressureThreshold > 0 {
+		ctrl.WorkspacePressureThreshold = cfg.WorkspacePressureThreshold
+	}
+	if cfg.Factor > 0 && cfg.Factor < 1 {
+		ctrl.Factor = cfg.Factor
+	}
+
+	period := time.Duration(cfg.ControlPeriod)
+	if period <= 0 {
+		period = defaultIOControlPeriod
+	}
+	nodePressurePath := cfg.NodePressurePath
+	if nodePressurePath == "" {
+		nodePressurePath = defaultNodePressurePath
+	}
+
+	return &adaptiveIOLimiter{
+		controller:       ctrl,
+		period:           period,
+		nodePressurePath: nodePressurePath,
+		workspaces:       make(map[string]*adaptiveIOTarget),
+
+		nodePressure: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "iolimit_node_io_pressure",
+			Help: "Share of time tasks on the node stalled on IO during the last control period",
+		}),
+		workspacePressure: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "iolimit_workspace_io_pressure",
+			Help: "Share of time tasks of a workspace stalled on IO during the last control period",
+		}, []string{"workspace"}),
+		workspaceShare: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "iolimit_workspace_io_share",
+			Help: "Share of a workspace in the IO of all workspaces on the node during the last control period",
+		}, []string{"workspace"}),
+		workspaceContending: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "iolimit_workspace_contending",
+			Help: "1 if a workspace was considered to cause IO contention during the last control period",
+		}, []string{"workspace"}),
+		workspaceLimit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "iolimit_workspace_limit",
+			Help: "Current IO limit of a workspace, zero meaning unlimited",
+		}, []string{"workspace", "kind"}),
+		adjustments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "iolimit_adjustments_total",
+			Help: "Number of adaptive IO limit adjustments",
+		}, []string{"direction"}),
+	}
+}
+
+// adaptiveIOLimiter drives an AdaptiveIOController using the IO statistics of the workspace cgroups
+type adaptiveIOLimiter struct {
+	controller       *AdaptiveIOController
+	period           time.Duration
+	nodePressurePath string
+
+	workspaces map[string]*adaptiveIOTarget
+	mu         sync.Mutex
+
+	nodePressure        prometheus.Gauge
+	workspacePressure   *prometheus.GaugeVec
+	workspaceShare      *prometheus.GaugeVec
+	workspaceContending *prometheus.GaugeVec
+	workspaceLimit      *prometheus.GaugeVec
+	adjustments         *prometheus.CounterVec
+}
+
+type adaptiveIOTarget struct {
+	Path   string
+	Update chan<- struct{}
+}
+
+// Add starts adaptive limiting of a workspace. Whenever its limits change, we send on update.
+func (a *adaptiveIOLimiter) Add(instanceID, cgroupPath string, update chan<- struct{}) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.workspaces[instanceID] = &adaptiveIOTarget{Path: cgroupPath, Update: update}
+}
+
+// Remove stops adaptive limiting of a workspace
+func (a *adaptiveIOLimiter) Remove(instanceID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.workspaces, instanceID)
+	a.workspacePressure.DeleteLabelValues(instanceID)
+	a.workspaceShare.DeleteLabelValues(instanceID)
+	a.workspaceContending.DeleteLabelValues(instanceID)
+	for _, kind := range ioKindNames {
+		a.workspaceLimit.DeleteLabelValues(instanceID, kind)
+	}
+}
+
+// Limits returns the current limits of a workspace
+func (a *adaptiveIOLimiter) Limits(instanceID string) IOLimits {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.controller.Limits(instanceID)
+}
+
+// SetCeiling changes the static limits which the adaptive limits never exceed
+func (a *adaptiveIOLimiter) SetCeiling(ceiling IOLimits) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.controller.Ceiling = ceiling
+}
+
+// Run adjusts the limits until the context is canceled
+func (a *adaptiveIOLimiter) Run(ctx context.Context) {
+	t := time.NewTicker(a.period)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			a.tick()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *adaptiveIOLimiter) tick() {
+	node, err := cgroups.ReadPSIValue(a.nodePressurePath)
+	if err != nil {
+		log.WithError(err).WithField("path", a.nodePressurePath).Warn("cannot read node IO pressure")
+		return
+	}
+
+	a.mu.Lock()
+	targets := make(map[string]string, len(a.workspaces))
+	for id, ws := range a.workspaces {
+		targets[id] = ws.Path
+	}
+	a.mu.Unlock()
+
+	// reading the cgroup files can take a while, hence we do that without holding the lock
+	samples := make([]IOSample, 0, len(targets))
+	for id, path := range targets {
+		s, err := readIOSample(id, path)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				log.WithError(err).WithFields(log.OWI("", "", id)).Warn("cannot read workspace IO statistics")
+			}
+			continue
+		}
+		samples = append(samples, s)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	res := a.controller.Tick(a.period, time.Duration(node.Some)*time.Microsecond, samples)
+	a.nodePressure.Set(res.NodePressure)
+	for _, ws := range res.Workspaces {
+		target, ok := a.workspaces[ws.ID]
+		if !ok {
+			// the workspace has gone away inbetween
+			continue
+		}
+
+		a.workspacePressure.WithLabelValues(ws.ID).Set(ws.Pressure)
+		a.workspaceShare.WithLabelValues(ws.ID).Set(ws.Share)
+		contending := 0.0
+		if ws.Contending {
+			contending = 1
+		}
+		a.workspaceContending.WithLabelValues(ws.ID).Set(contending)
+		for k, v := range ws.Limits.values() {
+			a.workspaceLimit.WithLabelValues(ws.ID, ioKindNames[k]).Set(float64(v))
+		}
+		if !ws.Changed {
+			continue
+		}
+
+		direction := "relax"
+		if ws.Contending {
+			direction = "tighten"
+		}
+		a.adjustments.WithLabelValues(direction).Inc()
+		log.WithFields(log.OWI("", "", ws.ID)).WithField("limits", ws.Limits).WithField("nodePressure", res.NodePressure).WithField("pressure", ws.Pressure).WithField("share", ws.Share).Infof("adaptive IO limits: %s", direction)
+
+		select {
+		case target.Update <- struct{}{}:
+		default:
+			// an update is already pending
+		}
+	}
+}
+
+func readIOSample(instanceID, path string) (IOSample, error) {
+	io := cgroupsv2.NewIOController(path)
+	psi, err := io.PSI()
+	if err != nil {
+		return IOSample{}, err
+	}
+	stats, err := io.Stat()
+	if err != nil {
+		return IOSample{}, err
+	}
+
+	res := IOSample{
+		ID:       instanceID,
+		Pressure: time.Duration(psi.Some) * time.Microsecond,
+	}
+	for _, dev := range stats {
+		res.WriteBytes += dev.WriteBytes
+		res.ReadBytes += dev.ReadBytes
+		res.WriteIOs += dev.WriteIOs
+		res.ReadIOs += dev.ReadIOs
+	}
+	return res, nil
+}
+
+// Describe implements Collector.
+func (a *adaptiveIOLimiter) Describe(ch chan<- *prometheus.Desc) {
+	a.nodePressure.Describe(ch)
+	a.workspacePressure.Describe(ch)
+	a.workspaceShare.Describe(ch)
+	a.workspaceContending.Describe(ch)
+	a.workspaceLimit.Describe(ch)
+	a.adjustments.Describe(ch)
+}
+
+// Collect implements Collector.
+func (a *adaptiveIOLimiter) Collect(ch chan<- prometheus.Metric) {
+	a.nodePressure.Collect(ch)
+	a.workspacePressure.Collect(ch)
+	a.workspaceShare.Collect(ch)
+	a.workspaceContending.Collect(ch)
+	a.workspaceLimit.Collect(ch)
+	a.adjustments.Collect(ch)
+}
@@ -6,6 +6,7 @@ package cgroup
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -14,32 +15,72 @@ import (
 
 	v2 "github.com/containerd/cgroups/v2"
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type IOLimiterV2 struct {
-	limits *v2.Resources
+	limits IOLimits
 
 	cond *sync.Cond
 
 	devices []string
+
+	// adaptive is nil unless adaptive IO limiting is enabled
+	adaptive *adaptiveIOLimiter
 }
 
-func NewIOLimiterV2(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64) (*IOLimiterV2, error) {
+var _ prometheus.Collector = &IOLimiterV2{}
+
+func NewIOLimiterV2(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64, adaptive AdaptiveIOLimitConfig) (*IOLimiterV2, error) {
 	devices := buildDevices()
 	log.WithField("devices", devices).Debug("io limiting devices")
-	return &IOLimiterV2{
-		limits: buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs, devices),
+	res := &IOLimiterV2{
+		limits: IOLimits{
+			WriteBytesPerSecond: writeBytesPerSecond,
+			ReadBytesPerSecond:  readBytesPerSecond,
+			WriteIOPS:           writeIOPs,
+			ReadIOPS:            readIOPs,
+		},
 
 		cond:    sync.NewCond(&sync.Mutex{}),
 		devices: devices,
-	}, nil
+	}
+	if adaptive.Enabled {
+		res.adaptive = newAdaptiveIOLimiter(adaptive, IOLimits{
+			WriteBytesPerSecond: writeBytesPerSecond,
+			ReadBytesPerSecond:  readBytesPerSecond,
+			WriteIOPS:           writeIOPs,
+			ReadIOPS:            readIOPs,
+		})
+		go res.adaptive.Run(context.Background())
+	}
+	return res, nil
 }
 
 func (c *IOLimiterV2) Name() string  { return "iolimiter-v2" }
 func (c *IOLimiterV2) Type() Version { return Version2 }
 
+// Describe implements Collector.
+func (c *IOLimiterV2) Describe(ch chan<- *prometheus.Desc) {
+	if c.adaptive != nil {
+		c.adaptive.Describe(ch)
+	}
+}
+
+// Collect implements Collector.
+func (c *IOLimiterV2) Collect(ch chan<- prometheus.Metric) {
+	if c.adaptive != nil {
+		c.adaptive.Collect(ch)
+	}
+}
+
 func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
 	update := make(chan struct{}, 1)
+	// adaptiveUpdate is separate from update because the latter gets closed once the workspace is gone
+	adaptiveUpdate := make(chan struct{}, 1)
+	if c.adaptive != nil {
+		c.adaptive.Add(opts.InstanceId, filepath.Join(opts.BasePath, opts.CgroupPath), adaptiveUpdate)
+	}
 	go func() {
 		// TODO(cw): this Go-routine will leak per workspace, until we update config or restart ws-daemon
 		defer close(update)
@@ -60,23 +101,34 @@ func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
 	go func() {
 		log.WithFields(log.OWI("", "", opts.InstanceId)).WithField("cgroupPath", opts.CgroupPath).Debug("starting io limiting")
 
-		_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), c.limits)
+		limits := c.workspaceLimits(opts.InstanceId)
+		err := c.writeLimits(opts.BasePath, opts.CgroupPath, limits)
 		if err != nil {
-			log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.limits).Warn("cannot write IO limits")
+			log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", limits).Warn("cannot write IO limits")
 		}
 
+		write := func() {
+			limits := c.workspaceLimits(opts.InstanceId)
+			err := c.writeLimits(opts.BasePath, opts.CgroupPath, limits)
+			if err != nil {
+				log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", limits).Error("cannot write IO limits")
+			}
+		}
 		for {
 			select {
 			case <-update:
-				_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), c.limits)
-				if err != nil {
-					log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.limits).Error("cannot write IO limits")
-				}
+				write()
+			case <-adaptiveUpdate:
+				write()
 			case <-ctx.Done():
+				if c.adaptive != nil {
+					c.adaptive.Remove(opts.InstanceId)
+				}
+
 				// Prior to shutting down though, we need to reset the IO limits to ensure we don't have
 				// processes stuck in the uninterruptable "D" (disk sleep) state. This would prevent the
 				// workspace pod from shutting down.
-				_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), &v2.Resources{})
+				err := c.writeLimits(opts.BasePath, opts.CgroupPath, IOLimits{})
 				if err != nil {
 					log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("cgroupPath", opts.CgroupPath).Error("cannot write IO limits")
 				}
@@ -89,21 +141,62 @@ func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
 	return nil
 }
 
+// Update changes the static limits. With adaptive IO limiting they become the ceiling of the adaptive limits.
 func (c *IOLimiterV2) Update(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64) {
 	c.cond.L.Lock()
 	defer c.cond.L.Unlock()
 
-	c.limits = buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs, c.devices)
-	log.WithField("limits", c.limits.IO).Info("updating I/O cgroups v2 limits")
+	c.limits = IOLimits{
+		WriteBytesPerSecond: writeBytesPerSecond,
+		ReadBytesPerSecond:  readBytesPerSecond,
+		WriteIOPS:           writeIOPs,
+		ReadIOPS:            readIOPs,
+	}
+	if c.adaptive != nil {
+		c.adaptive.SetCeiling(IOLimits{
+			WriteBytesPerSecond: writeBytesPerSecond,
+			ReadBytesPerSecond:  readBytesPerSecond,
+			WriteIOPS:           writeIOPs,
+			ReadIOPS:            readIOPs,
+		})
+	}
+	log.WithField("limits", c.limits).Info("updating I/O cgroups v2 limits")
 
 	c.cond.Broadcast()
 }
 
-func buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64, devices []string) *v2.Resources {
-	resources := &v2.Resources{
-		IO: &v2.IO{},
+// workspaceLimits returns the limits of a workspace
+func (c *IOLimiterV2) workspaceLimits(instanceID string) IOLimits {
+	c.cond.L.Lock()
+	defer c.cond.L.Unlock()
+
+	if c.adaptive == nil {
+		return c.limits
+	}
+	return c.adaptive.Limits(instanceID)
+}
+
+// writeLimits writes the limits of all devices to io.max of a cgroup
+func (c *IOLimiterV2) writeLimits(basePath, cgroupPath string, limits IOLimits) error {
+	// we only use the manager to create the cgroup and to enable the io controller
+	_, err := v2.NewManager(basePath, filepath.Join("/", cgroupPath), &v2.Resources{IO: &v2.IO{}})
+	if err != nil {
+		return err
+	}
+	return writeIOMax(filepath.Join(basePath, cgroupPath), buildIOMax(limits, c.devices))
+}
+
+// buildIOMax returns the io.max entries of the limits. Unlimited values are written explicitly as "max",
+// because io.max keeps the previous value of every key that is not written.
+func buildIOMax(limits IOLimits, devices []string) []string {
+	value := func(v int64) string {
+		if v <= 0 {
+			return "max"
+		}
+		return strconv.FormatInt(v, 10)
 	}
 
+	var entries []string
 	for _, device := range devices {
 		majmin := strings.Split(device, ":")
 		if len(majmin) != 2 {
@@ -111,38 +204,36 @@ func buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs
 			continue
 		}
 
-		major, err := strconv.ParseInt(majmin[0], 10, 64)
+		_, err := strconv.ParseInt(majmin[0], 10, 64)
 		if err != nil {
 			log.WithError(err).Error("invalid major device")
 			continue
 		}
 
-		minor, err := strconv.ParseInt(majmin[1], 10, 64)
+		_, err = strconv.ParseInt(majmin[1], 10, 64)
 		if err != nil {
 			log.WithError(err).Error("invalid minor device")
 			continue
 		}
 
-		if readBytesPerSecond > 0 {
-			resources.IO.Max = append(resources.IO.Max, v2.Entry{Major: major, Minor: minor, Type: v2.ReadBPS, Rate: uint64(readBytesPerSecond)})
-		}
+		entries = append(entries, fmt.Sprintf("%s rbps=%s wbps=%s riops=%s wiops=%s", device,
+			value(limits.ReadBytesPerSecond), value(limits.WriteBytesPerSecond), value(limits.ReadIOPS), value(limits.WriteIOPS)))
+	}
 
-		if readIOPs > 0 {
-			resources.IO.Max = append(resources.IO.Max, v2.Entry{Major: major, Minor: minor, Type: v2.ReadIOPS, Rate: uint64(readIOPs)})
-		}
+	log.WithField("entries", entries).Debug("cgroups v2 limits")
 
-		if writeBytesPerSecond > 0 {
-			resources.IO.Max = append(resources.IO.Max, v2.Entry{Major: major, Minor: minor, Type: v2.WriteBPS, Rate: uint64(writeBytesPerSecond)})
-		}
+	return entries
+}
 
-		if writeIOPs > 0 {
-			resources.IO.Max = append(resources.IO.Max, v2.Entry{Major: major, Minor: minor, Type: v2.WriteIOPS, Rate: uint64(writeIOPs)})
+// writeIOMax writes the io.max entries of a cgroup. The kernel only parses a single entry per write.
+func writeIOMax(cgroupPath string, entries []string) error {
+	for _, entry := range entries {
+		err := os.WriteFile(filepath.Join(cgroupPath, "io.max"), []byte(entry), 0644)
+		if err != nil {
+			return err
 		}
 	}
-
-	log.WithField("resources", resources).Debug("cgroups v2 limits")
-
-	return resources
+	return nil
 }
 
 // TODO: enable custom configuration
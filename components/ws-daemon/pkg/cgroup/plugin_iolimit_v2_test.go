@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIOLimiterV2RelaxLimits(t *testing.T) {
+	basePath := t.TempDir()
+	err := os.WriteFile(filepath.Join(basePath, "cgroup.subtree_control"), nil, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	limiter := &IOLimiterV2{devices: []string{"8:0"}}
+
+	err = limiter.writeLimits(basePath, "workspace", IOLimits{WriteBytesPerSecond: 10 * 1000 * 1000, ReadIOPS: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectIOMax(t, filepath.Join(basePath, "workspace"), "8:0 rbps=max wbps=10000000 riops=100 wiops=max")
+
+	// relaxing the limits must lift them, instead of leaving the previous ones in place
+	err = limiter.writeLimits(basePath, "workspace", IOLimits{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectIOMax(t, filepath.Join(basePath, "workspace"), "8:0 rbps=max wbps=max riops=max wiops=max")
+}
+
+func expectIOMax(t *testing.T, cgroupPath string, expectation string) {
+	t.Helper()
+
+	act, err := os.ReadFile(filepath.Join(cgroupPath, "io.max"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(act) != expectation {
+		t.Errorf("unexpected io.max: want %q, got %q", expectation, act)
+	}
+}
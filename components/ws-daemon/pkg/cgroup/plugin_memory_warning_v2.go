@@ -0,0 +1,343 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shirou/gopsutil/process"
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/supervisor"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	defaultMemoryPressureThreshold = 0.2
+	defaultMemoryWarningInterval   = 10 * time.Minute
+	memoryWarningPeriod            = 10 * time.Second
+	memoryTopConsumers             = 3
+)
+
+// MemoryWarningConfig configures the warnings users receive when their workspace runs low on memory
+type MemoryWarningConfig struct {
+	Enabled bool `json:"enabled"`
+	// PressureThreshold is the share of time the workspace's tasks must stall on memory for the user to be warned. Defaults to 0.2.
+	PressureThreshold float64 `json:"pressureThreshold,omitempty"`
+	// Interval is the time before the user of a workspace is warned again. OOM kills are always reported. Defaults to 10 minutes.
+	Interval util.Duration `json:"interval,omitempty"`
+	// SupervisorPort is the port of the supervisor API we send notifications to. Defaults to 22999.
+	SupervisorPort int `json:"supervisorPort,omitempty"`
+}
+
+// NewMemoryWarningV2 creates a plugin which warns users when their workspace runs low on memory,
+// and records OOM kills on the workspace resource.
+func NewMemoryWarningV2(cfg MemoryWarningConfig, clnt client.Client, namespace string) *MemoryWarningV2 {
+	res := &MemoryWarningV2{
+		Enabled:           cfg.Enabled,
+		PressureThreshold: cfg.PressureThreshold,
+		Interval:          time.Duration(cfg.Interval),
+
+		warningsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "memory_warnings_total",
+			Help: "Number of warnings users received because their workspace ran low on memory",
+		}, []string{"reason"}),
+		oomKillsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "memory_oom_kills_total",
+			Help: "Number of workspace processes killed because their workspace ran out of memory",
+		}),
+	}
+	if res.PressureThreshold <= 0 {
+		res.PressureThreshold = defaultMemoryPressureThreshold
+	}
+	if res.Interval <= 0 {
+		res.Interval = defaultMemoryWarningInterval
+	}
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	res.Notify = func(ctx context.Context, instanceID string, level supervisor.NotificationLevel, message string) error {
+		if clnt == nil {
+			return xerrors.Errorf("no workspace client available")
+		}
+
+		// the pod IP is looked up for every notification as it may not have been known when the workspace was added
+		var ws workspacev1.Workspace
+		err := clnt.Get(ctx, types.NamespacedName{Namespace: namespace, Name: instanceID}, &ws)
+		if err != nil {
+			return err
+		}
+		if ws.Status.Runtime == nil {
+			return xerrors.Errorf("workspace %s has no runtime status", instanceID)
+		}
+
+		return supervisor.Notify(ctx, httpClient, ws.Status.Runtime.PodIP, cfg.SupervisorPort, level, message)
+	}
+	res.RecordOOMKill = func(ctx context.Context, instanceID, message string) error {
+		if clnt == nil {
+			return nil
+		}
+
+		return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			var ws workspacev1.Workspace
+			err := clnt.Get(ctx, types.NamespacedName{Namespace: namespace, Name: instanceID}, &ws)
+			if err != nil {
+				return err
+			}
+
+			ws.Status.SetCondition(workspacev1.NewWorkspaceConditionOOMKilled(message))
+			return clnt.Status().Update(ctx, &ws)
+		})
+	}
+
+	return res
+}
+
+// MemoryWarningV2 watches the memory pressure and memory.events of workspaces. When a workspace
+// runs low on memory, its user receives a warning naming the processes using the most memory.
+type MemoryWarningV2 struct {
+	Enabled           bool
+	PressureThreshold float64
+	Interval          time.Duration
+
+	// Notify shows a notification to the user of a workspace
+	Notify func(ctx context.Context, instanceID string, level supervisor.NotificationLevel, message string) error
+	// RecordOOMKill records an OOM kill as condition of the workspace resource
+	RecordOOMKill func(ctx context.Context, instanceID, message string) error
+
+	warningsTotal *prometheus.CounterVec
+	oomKillsTotal prometheus.Counter
+}
+
+var _ prometheus.Collector = &MemoryWarningV2{}
+
+func (c *MemoryWarningV2) Name() string  { return "memory-warning-v2" }
+func (c *MemoryWarningV2) Type() Version { return Version2 }
+
+// Describe implements Collector.
+func (c *MemoryWarningV2) Describe(ch chan<- *prometheus.Desc) {
+	c.warningsTotal.Describe(ch)
+	c.oomKillsTotal.Describe(ch)
+}
+
+// Collect implements Collector.
+func (c *MemoryWarningV2) Collect(ch chan<- prometheus.Metric) {
+	c.warningsTotal.Collect(ch)
+	c.oomKillsTotal.Collect(ch)
+}
+
+type memoryWarningState struct {
+	// pressure is nil until the first check
+	pressure    *time.Duration
+	oom         uint64
+	oomKill     uint64
+	lastWarning time.Time
+}
+
+func (c *MemoryWarningV2) Apply(ctx context.Context, opts *PluginOptions) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var (
+		fullPath = filepath.Join(opts.BasePath, opts.CgroupPath)
+		state    memoryWarningState
+	)
+	t := time.NewTicker(memoryWarningPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+
+		err := c.check(ctx, opts, fullPath, &state, memoryWarningPeriod)
+		if errors.Is(err, fs.ErrNotExist) {
+			// the target cgroup/workspace has gone
+			return nil
+		}
+		if err != nil {
+			log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("path", fullPath).Warn("cannot check workspace memory")
+		}
+	}
+}
+
+func (c *MemoryWarningV2) check(ctx context.Context, opts *PluginOptions, path string, state *memoryWarningState, dt time.Duration) error {
+	psi, err := cgroups.ReadPSIValue(filepath.Join(path, "memory.pressure"))
+	if err != nil {
+		return err
+	}
+	events, err := cgroups.ReadFlatKeyedFile(filepath.Join(path, "memory.events"))
+	if err != nil {
+		return err
+	}
+
+	pressure := time.Duration(psi.Some) * time.Microsecond
+	if state.pressure == nil {
+		state.pressure = &pressure
+		state.oom, state.oomKill = events["oom"], events["oom_kill"]
+		return nil
+	}
+
+	var (
+		share   = pressureShare(pressure-*state.pressure, dt)
+		oom     = events["oom"] > state.oom
+		oomKill = events["oom_kill"]
+		killed  uint64
+	)
+	if oomKill > state.oomKill {
+		killed = oomKill - state.oomKill
+	}
+	state.pressure = &pressure
+	state.oom, state.oomKill = events["oom"], oomKill
+
+	owi := log.OWI("", "", opts.InstanceId)
+	switch {
+	case killed > 0:
+		c.oomKillsTotal.Add(float64(killed))
+		log.WithFields(owi).WithField("killed", killed).Info("workspace processes were OOM killed")
+
+		err := c.RecordOOMKill(ctx, opts.InstanceId, fmt.Sprintf("%d processes were killed because the workspace ran out of memory", killed))
+		if err != nil {
+			log.WithError(err).WithFields(owi).Warn("cannot record OOM kill on workspace")
+		}
+
+		msg := "A process in your workspace was killed because the workspace ran out of memory."
+		if killed > 1 {
+			msg = fmt.Sprintf("%d processes in your workspace were killed because the workspace ran out of memory.", killed)
+		}
+		c.warn(ctx, opts, state, supervisor.NotificationLevelError, "oom_kill", msg+c.describeConsumers(path))
+	case oom || share >= c.PressureThreshold:
+		if time.Since(state.lastWarning) < c.Interval {
+			return nil
+		}
+
+		reason := "pressure"
+		if oom {
+			reason = "oom"
+		}
+		log.WithFields(owi).WithField("pressure", share).WithField("reason", reason).Info("workspace is running low on memory")
+		c.warn(ctx, opts, state, supervisor.NotificationLevelWarning, reason, "Your workspace is running low on memory and processes may soon be killed."+c.describeConsumers(path))
+	}
+
+	return nil
+}
+
+func (c *MemoryWarningV2) warn(ctx context.Context, opts *PluginOptions, state *memoryWarningState, level supervisor.NotificationLevel, reason, message string) {
+	err := c.Notify(ctx, opts.InstanceId, level, message)
+	if err != nil {
+		log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).Debug("cannot notify workspace about its memory")
+		return
+	}
+	state.lastWarning = time.Now()
+	c.warningsTotal.WithLabelValues(reason).Inc()
+}
+
+func (c *MemoryWarningV2) describeConsumers(path string) string {
+	consumers := topMemoryConsumers(path, memoryTopConsumers)
+	if len(consumers) == 0 {
+		return ""
+	}
+
+	desc := make([]string, 0, len(consumers))
+	for _, p := range consumers {
+		desc = append(desc, p.String())
+	}
+	return " Top memory consumers: " + strings.Join(desc, ", ") + "."
+}
+
+var processTypeLabels = map[ProcessType]string{
+	ProcessWorkspaceKit:     "Gitpod",
+	ProcessSupervisor:       "Gitpod",
+	ProcessIDE:              "IDE",
+	ProcessWebIDEHelper:     "IDE",
+	ProcessCodeServer:       "VS Code Server",
+	ProcessCodeServerHelper: "VS Code extensions",
+}
+
+type memoryConsumer struct {
+	Name string
+	Type ProcessType
+	RSS  uint64
+}
+
+func (m memoryConsumer) String() string {
+	if label, ok := processTypeLabels[m.Type]; ok {
+		return fmt.Sprintf("%s (%s) %s", m.Name, label, formatMemory(m.RSS))
+	}
+	return fmt.Sprintf("%s %s", m.Name, formatMemory(m.RSS))
+}
+
+// topMemoryConsumers returns up to n processes of a cgroup and its children using the most memory
+func topMemoryConsumers(cgroupPath string, n int) []memoryConsumer {
+	var res []memoryConsumer
+	_ = filepath.WalkDir(cgroupPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "cgroup.procs" {
+			// cgroups can disappear while we walk - we still want to know about everything else
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			pid, err := strconv.ParseInt(strings.TrimSpace(line), 10, 32)
+			if err != nil {
+				continue
+			}
+			proc, err := process.NewProcess(int32(pid))
+			if err != nil {
+				continue
+			}
+			mem, err := proc.MemoryInfo()
+			if err != nil {
+				continue
+			}
+
+			name, _ := proc.Name()
+			if cmd := extractCommand(proc); len(cmd) > 0 {
+				name = filepath.Base(cmd[0])
+			}
+			res = append(res, memoryConsumer{Name: name, Type: determineProcessType(proc), RSS: mem.RSS})
+		}
+		return nil
+	})
+
+	sort.Slice(res, func(i, j int) bool { return res[i].RSS > res[j].RSS })
+	if len(res) > n {
+		res = res[:n]
+	}
+	return res
+}
+
+func formatMemory(bytes uint64) string {
+	const (
+		mi = 1 << 20
+		gi = 1 << 30
+	)
+	if bytes >= gi {
+		return fmt.Sprintf("%.1f GiB", float64(bytes)/gi)
+	}
+	return fmt.Sprintf("%d MiB", bytes/mi)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/supervisor"
+)
+
+func TestMemoryWarningCheck(t *testing.T) {
+	const dt = 10 * time.Second
+
+	type sample struct {
+		// Pressure is the total memory pressure in microseconds
+		Pressure uint64
+		OOM      uint64
+		OOMKill  uint64
+	}
+	tests := []struct {
+		Name          string
+		Samples       []sample
+		Notifications []supervisor.NotificationLevel
+		Conditions    []string
+	}{
+		{
+			Name:    "no pressure",
+			Samples: []sample{{}, {Pressure: 100}, {Pressure: 200}},
+		},
+		{
+			Name:          "pressure",
+			Samples:       []sample{{}, {Pressure: 5_000_000}, {Pressure: 10_000_000}},
+			Notifications: []supervisor.NotificationLevel{supervisor.NotificationLevelWarning},
+		},
+		{
+			Name:          "oom without kill",
+			Samples:       []sample{{}, {OOM: 1}},
+			Notifications: []supervisor.NotificationLevel{supervisor.NotificationLevelWarning},
+		},
+		{
+			Name:          "oom kills are always reported",
+			Samples:       []sample{{OOM: 1, OOMKill: 1}, {Pressure: 5_000_000, OOM: 2, OOMKill: 1}, {Pressure: 10_000_000, OOM: 3, OOMKill: 3}},
+			Notifications: []supervisor.NotificationLevel{supervisor.NotificationLevelWarning, supervisor.NotificationLevelError},
+			Conditions:    []string{"2 processes were killed because the workspace ran out of memory"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				path          = t.TempDir()
+				notifications []supervisor.NotificationLevel
+				conditions    []string
+				state         memoryWarningState
+			)
+			c := NewMemoryWarningV2(MemoryWarningConfig{Enabled: true}, nil, "")
+			c.Notify = func(ctx context.Context, instanceID string, level supervisor.NotificationLevel, message string) error {
+				notifications = append(notifications, level)
+				return nil
+			}
+			c.RecordOOMKill = func(ctx context.Context, instanceID, message string) error {
+				conditions = append(conditions, message)
+				return nil
+			}
+
+			for _, s := range test.Samples {
+				err := os.WriteFile(filepath.Join(path, "memory.pressure"), []byte(fmt.Sprintf("some avg10=0.00 avg60=0.00 avg300=0.00 total=%d\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=0", s.Pressure)), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = os.WriteFile(filepath.Join(path, "memory.events"), []byte(fmt.Sprintf("low 0\nhigh 0\nmax 0\noom %d\noom_kill %d\n", s.OOM, s.OOMKill)), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = c.check(context.Background(), &PluginOptions{InstanceId: "foobar"}, path, &state, dt)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if diff := cmp.Diff(test.Notifications, notifications); diff != "" {
+				t.Errorf("unexpected notifications (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.Conditions, conditions); diff != "" {
+				t.Errorf("unexpected conditions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
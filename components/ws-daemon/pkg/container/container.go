@@ -6,6 +6,7 @@ package container
 
 import (
 	"context"
+	"time"
 
 	"golang.org/x/xerrors"
 )
@@ -47,6 +48,10 @@ type Runtime interface {
 
 	// IsContainerdReady returns is the status of containerd.
 	IsContainerdReady(ctx context.Context) (bool, error)
+
+	// PruneImages removes all images which are not used by any container and have not been pulled within minAge.
+	// It returns the size of the removed images' content.
+	PruneImages(ctx context.Context, minAge time.Duration) (freed uint64, err error)
 }
 
 var (
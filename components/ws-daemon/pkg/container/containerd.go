@@ -20,6 +20,7 @@ import (
 	"github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
 	"github.com/containerd/typeurl/v2"
 	ocispecs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opentracing/opentracing-go"
@@ -481,6 +482,68 @@ func (s *Containerd) IsContainerdReady(ctx context.Context) (bool, error) {
 	return s.Client.IsServing(ctx)
 }
 
+// PruneImages removes all images which are not used by any container and have not been pulled within minAge.
+func (s *Containerd) PruneImages(ctx context.Context, minAge time.Duration) (freed uint64, err error) {
+	cs, err := s.Client.ContainerService().List(ctx)
+	if err != nil {
+		return 0, xerrors.Errorf("cannot list containers: %w", err)
+	}
+	imgs, err := s.Client.ImageService().List(ctx)
+	if err != nil {
+		return 0, xerrors.Errorf("cannot list images: %w", err)
+	}
+
+	// containers reference their image by one of possibly many names, hence we compare the image digests
+	byName := make(map[string]images.Image, len(imgs))
+	for _, img := range imgs {
+		byName[img.Name] = img
+	}
+	used := make(map[string]struct{}, len(cs))
+	for _, c := range cs {
+		if img, ok := byName[c.Image]; ok {
+			used[img.Target.Digest.String()] = struct{}{}
+		}
+	}
+
+	var (
+		sized = make(map[string]struct{})
+		errs  []string
+	)
+	for _, img := range imgs {
+		dgst := img.Target.Digest.String()
+		if _, inUse := used[dgst]; inUse {
+			continue
+		}
+		if time.Since(img.UpdatedAt) < minAge {
+			continue
+		}
+
+		var size int64
+		if _, done := sized[dgst]; !done {
+			size, err = containerd.NewImage(s.Client, img).Size(ctx)
+			if err != nil {
+				log.WithError(err).WithField("image", img.Name).Debug("cannot determine image size")
+			}
+		}
+
+		err = s.Client.ImageService().Delete(ctx, img.Name, images.SynchronousDelete())
+		if err != nil && !errdefs.IsNotFound(err) {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if _, done := sized[dgst]; !done && size > 0 {
+			freed += uint64(size)
+		}
+		sized[dgst] = struct{}{}
+		log.WithField("image", img.Name).Debug("removed unused image")
+	}
+	if len(errs) > 0 {
+		return freed, xerrors.Errorf("cannot remove all unused images: %s", strings.Join(errs, "; "))
+	}
+
+	return freed, nil
+}
+
 var kubepodsQoSRegexp = regexp.MustCompile(`([^/]+)-([^/]+)-pod`)
 var kubepodsRegexp = regexp.MustCompile(`([^/]+)-pod`)
 
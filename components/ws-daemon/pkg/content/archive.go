@@ -20,19 +20,40 @@ import (
 
 // BuildTarbal creates an OCI compatible tar file dst from the folder src, expecting the overlay whiteout format
 func BuildTarbal(ctx context.Context, src string, dst string, opts ...carchive.TarOption) (err error) {
-	var cfg carchive.TarConfig
-	for _, opt := range opts {
-		opt(&cfg)
-	}
-
 	//nolint:staticcheck,ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "buildTarbal")
 	span.LogKV("src", src, "dst", dst)
 	defer tracing.FinishSpan(span, &err)
 
+	tarReader, err := StreamTarbal(ctx, src, opts...)
+	if err != nil {
+		return
+	}
+	defer tarReader.Close()
+
+	tarFile, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY, 0755)
+	if err != nil {
+		return xerrors.Errorf("Unable to create tar file: %v", err.Error())
+	}
+
+	_, err = io.Copy(tarFile, tarReader)
+	if err != nil {
+		return xerrors.Errorf("Unable create tar file: %v", err.Error())
+	}
+
+	return
+}
+
+// StreamTarbal produces an OCI compatible tar stream of the folder src, expecting the overlay whiteout format
+func StreamTarbal(ctx context.Context, src string, opts ...carchive.TarOption) (io.ReadCloser, error) {
+	var cfg carchive.TarConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	// ensure the src actually exists before trying to tar it
 	if _, err := os.Stat(src); err != nil {
-		return xerrors.Errorf("Unable to tar files: %v", err.Error())
+		return nil, xerrors.Errorf("Unable to tar files: %v", err.Error())
 	}
 
 	uidMaps := make([]idtools.IDMap, len(cfg.UIDMaps))
@@ -52,25 +73,9 @@ func BuildTarbal(ctx context.Context, src string, dst string, opts ...carchive.T
 		}
 	}
 
-	tarReader, err := archive.TarWithOptions(src, &archive.TarOptions{
+	return archive.TarWithOptions(src, &archive.TarOptions{
 		UIDMaps:     uidMaps,
 		GIDMaps:     gidMaps,
 		Compression: archive.Uncompressed,
 	})
-	if err != nil {
-		return
-	}
-	defer tarReader.Close()
-
-	tarFile, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY, 0755)
-	if err != nil {
-		return xerrors.Errorf("Unable to create tar file: %v", err.Error())
-	}
-
-	_, err = io.Copy(tarFile, tarReader)
-	if err != nil {
-		return xerrors.Errorf("Unable create tar file: %v", err.Error())
-	}
-
-	return
 }
@@ -52,6 +52,19 @@ type BackupConfig struct {
 
 	// Period is the time between regular workspace backups
 	Period util.Duration `json:"period"`
+
+	// Chunked enables incremental backups: the workspace archive is split into content-defined
+	// chunks and only chunks which are not yet in remote storage are uploaded. Workspaces which
+	// have a chunked backup keep being backed up in chunks, even if this is disabled later.
+	Chunked bool `json:"chunked,omitempty"`
+
+	// Compress enables zstd compression of non-chunked backups
+	Compress bool `json:"compress,omitempty"`
+
+	// History is the number of backup generations we keep per workspace. Older generations are
+	// garbage-collected. If zero, every backup replaces the previous one. Workspaces which have
+	// backup history keep at least one generation, even if this is disabled later.
+	History int `json:"history,omitempty"`
 }
 
 type UserNamespacesConfig struct {
@@ -9,7 +9,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -67,8 +69,23 @@ var (
 func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps storage.PresignedAccess, workspaceOwner string, initializer *csapi.WorkspaceInitializer) (rc map[string]storage.DownloadInfo, err error) {
 	rc = make(map[string]storage.DownloadInfo)
 
-	backup, err := ps.SignDownload(ctx, rs.Bucket(workspaceOwner), rs.BackupObject(storage.DefaultBackup), &storage.SignedURLOptions{})
-	if err == storage.ErrNotFound {
+	// The content initializer always restores the backup it finds as storage.DefaultBackup,
+	// hence we make the backup generation we restore available under that name.
+	backupName, err := resolveBackupName(ctx, rs, initializer)
+	if err != nil {
+		return nil, err
+	}
+	hasChunkedBackup, err := collectChunkedContent(ctx, rs, ps, rc, rs.Bucket(workspaceOwner), rs.BackupObject(backupName), backupName)
+	if err != nil {
+		return nil, err
+	}
+	if hasChunkedBackup && backupName != storage.DefaultBackup {
+		rc[storage.ChunkIndexName(storage.DefaultBackup)] = rc[storage.ChunkIndexName(backupName)]
+		delete(rc, storage.ChunkIndexName(backupName))
+	}
+
+	backup, err := ps.SignDownload(ctx, rs.Bucket(workspaceOwner), rs.BackupObject(backupName), &storage.SignedURLOptions{})
+	if err == storage.ErrNotFound || hasChunkedBackup {
 		// no backup found - that's fine
 	} else if err != nil {
 		return nil, err
@@ -93,23 +110,33 @@ func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 		if err != nil {
 			return nil, err
 		}
-		info, err := ps.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
-		if err == storage.ErrNotFound {
-			return nil, errCannotFindSnapshot
-		}
+		chunked, err := collectChunkedContent(ctx, rs, ps, rc, bkt, obj, si.Snapshot)
 		if err != nil {
 			return nil, xerrors.Errorf("cannot find snapshot: %w", err)
 		}
+		if !chunked {
+			info, err := ps.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
+			if err == storage.ErrNotFound {
+				return nil, errCannotFindSnapshot
+			}
+			if err != nil {
+				return nil, xerrors.Errorf("cannot find snapshot: %w", err)
+			}
 
-		rc[si.Snapshot] = *info
+			rc[si.Snapshot] = *info
+		}
 	}
 	if pi != nil && pi.Prebuild != nil && pi.Prebuild.Snapshot != "" {
 		bkt, obj, err := storage.ParseSnapshotName(pi.Prebuild.Snapshot)
 		if err != nil {
 			return nil, err
 		}
+		chunked, err := collectChunkedContent(ctx, rs, ps, rc, bkt, obj, pi.Prebuild.Snapshot)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot find prebuild: %w", err)
+		}
 		info, err := ps.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
-		if err == storage.ErrNotFound {
+		if err == storage.ErrNotFound || chunked {
 			// no prebuild found - that's fine
 		} else if err != nil {
 			return nil, xerrors.Errorf("cannot find prebuild: %w", err)
@@ -118,9 +145,67 @@ func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 		}
 	}
 
+	// the content initializer has no access to the key encryption keys, hence we hand it the data keys it needs
+	if ers, ok := rs.(*storage.EncryptedDirectAccess); ok {
+		err = ers.ResolveDataKeys(ctx, rc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return rc, nil
 }
 
+// resolveBackupName finds the name of the backup to restore. Workspaces with backup history restore the generation
+// selected by the backup initializer, or the latest one. All other workspaces restore storage.DefaultBackup.
+func resolveBackupName(ctx context.Context, rs storage.DirectDownloader, initializer *csapi.WorkspaceInitializer) (string, error) {
+	var backupID string
+	if bi := initializer.GetBackup(); bi != nil {
+		backupID = bi.BackupId
+	}
+	if ci := initializer.GetComposite(); ci != nil {
+		for _, c := range ci.Initializer {
+			if bi := c.GetBackup(); bi != nil {
+				backupID = bi.BackupId
+			}
+		}
+	}
+
+	mf, err := storage.DownloadBackupManifest(ctx, rs)
+	if err == storage.ErrNotFound {
+		if backupID != "" {
+			return "", xerrors.Errorf("cannot find backup %s: workspace has no backup history", backupID)
+		}
+		return storage.DefaultBackup, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	backup, err := storage.ResolveBackup(mf, backupID)
+	if err != nil {
+		return "", xerrors.Errorf("cannot find backup: %w", err)
+	}
+	return backup.Name, nil
+}
+
+// collectChunkedContent adds the chunk index of a backup and all chunks it references to rc.
+// Returns false if the backup has no chunk index.
+func collectChunkedContent(ctx context.Context, rs storage.DirectDownloader, ps storage.PresignedAccess, rc map[string]storage.DownloadInfo, bkt, obj, name string) (found bool, err error) {
+	infos, err := storage.SignChunkedDownload(ctx, rs, ps, bkt, obj, name)
+	if err == storage.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for k, v := range infos {
+		rc[k] = v
+	}
+	return true, nil
+}
+
 // RunInitializer runs a content initializer in a user, PID and mount namespace to isolate it from ws-daemon
 func RunInitializer(ctx context.Context, destination string, initializer *csapi.WorkspaceInitializer, remoteContent map[string]storage.DownloadInfo, opts RunInitializerOpts) (err error) {
 	//nolint:ineffassign,staticcheck
@@ -424,8 +509,13 @@ func (rs *remoteContentStorage) Download(ctx context.Context, destination string
 	defer os.Remove(tempFile.Name())
 	defer tempFile.Close()
 
+	content, err := storage.DecryptWithDataKey(tempFile, info.DataKey)
+	if err != nil {
+		return true, xerrors.Errorf("cannot decrypt %s: %w", name, err)
+	}
+
 	extractStart := time.Now()
-	err = archive.ExtractTarbal(ctx, tempFile, destination, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+	err = archive.ExtractTarbal(ctx, content, destination, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
 	if err != nil {
 		return true, xerrors.Errorf("tar %s: %s", destination, err.Error())
 	}
@@ -440,6 +530,37 @@ func (rs *remoteContentStorage) DownloadSnapshot(ctx context.Context, destinatio
 	return rs.Download(ctx, destination, name, mappings)
 }
 
+// DownloadObject streams the raw content of an object using its presigned URL
+func (rs *remoteContentStorage) DownloadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	info, exists := rs.RemoteContent[name]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, info.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("cannot download %s: status %d", name, resp.StatusCode)
+	}
+
+	content, err := storage.DecryptWithDataKey(resp.Body, info.DataKey)
+	if err != nil {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("cannot decrypt %s: %w", name, err)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{content, resp.Body}, nil
+}
+
 // ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
 func (rs *remoteContentStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
 	return []string{}, nil
@@ -460,6 +581,11 @@ func (rs *remoteContentStorage) UploadInstance(ctx context.Context, source strin
 	return "", "", xerrors.Errorf("not implemented")
 }
 
+// NewMultipartUpload does nothing
+func (rs *remoteContentStorage) NewMultipartUpload(ctx context.Context, name string, opts ...storage.UploadOption) (storage.MultipartUpload, error) {
+	return nil, xerrors.Errorf("not implemented")
+}
+
 // Bucket returns an empty string
 func (rs *remoteContentStorage) Bucket(string) string {
 	return ""
@@ -83,6 +83,20 @@ func (mr *MockWorkspaceOperationsMockRecorder) InitWorkspace(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitWorkspace", reflect.TypeOf((*MockWorkspaceOperations)(nil).InitWorkspace), arg0, arg1)
 }
 
+// ResizeQuota mocks base method.
+func (m *MockWorkspaceOperations) ResizeQuota(arg0 context.Context, arg1 string, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeQuota", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeQuota indicates an expected call of ResizeQuota.
+func (mr *MockWorkspaceOperationsMockRecorder) ResizeQuota(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeQuota", reflect.TypeOf((*MockWorkspaceOperations)(nil).ResizeQuota), arg0, arg1, arg2)
+}
+
 // SetupWorkspace mocks base method.
 func (m *MockWorkspaceOperations) SetupWorkspace(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ package controller
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -44,6 +46,8 @@ var retryParams = wait.Backoff{
 	Jitter:   0.2,
 }
 
+const quotaResizeRetryInterval = 1 * time.Minute
+
 type WorkspaceControllerOpts struct {
 	NodeName         string
 	ContentConfig    content.Config
@@ -223,7 +227,18 @@ func (wsc *WorkspaceController) handleWorkspaceRunning(ctx context.Context, ws *
 	log := log.FromContext(ctx)
 	log.Info("handling running workspace")
 
-	return ctrl.Result{}, wsc.operations.SetupWorkspace(ctx, ws.Name)
+	err = wsc.operations.SetupWorkspace(ctx, ws.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = wsc.operations.ResizeQuota(ctx, ws.Name, ws.Spec.StorageQuota)
+	if stderrors.Is(err, quota.ErrUsageExceedsQuota) {
+		// the workspace may free up space later, in which case we can still shrink its quota
+		wsc.emitEvent(ws, "Storage quota resize", err)
+		return ctrl.Result{RequeueAfter: quotaResizeRetryInterval}, nil
+	}
+	return ctrl.Result{}, err
 }
 
 func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request) (result ctrl.Result, err error) {
@@ -22,6 +22,7 @@ import (
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -72,11 +73,14 @@ type WorkspaceOperations interface {
 	Snapshot(ctx context.Context, instanceID, snapshotName string) (err error)
 	// Setup ensures that the workspace has been setup
 	SetupWorkspace(ctx context.Context, instanceID string) error
+	// ResizeQuota changes the storage quota of a running workspace
+	ResizeQuota(ctx context.Context, instanceID string, storageQuota int) error
 }
 
 type DefaultWorkspaceOperations struct {
 	config                 content.Config
 	provider               *WorkspaceProvider
+	xfs                    *quota.XFS
 	backupWorkspaceLimiter chan struct{}
 	metrics                *Metrics
 }
@@ -103,7 +107,7 @@ type BackupOptions struct {
 	SnapshotName    string
 }
 
-func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider, reg prometheus.Registerer) (WorkspaceOperations, error) {
+func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider, xfs *quota.XFS, reg prometheus.Registerer) (WorkspaceOperations, error) {
 	waitingTimeHist, waitingTimeoutCounter, err := registerConcurrentBackupMetrics(reg, "_mk2")
 	if err != nil {
 		return nil, err
@@ -112,6 +116,7 @@ func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider,
 	return &DefaultWorkspaceOperations{
 		config:   config,
 		provider: provider,
+		xfs:      xfs,
 		metrics: &Metrics{
 			BackupWaitingTimeHist:       waitingTimeHist,
 			BackupWaitingTimeoutCounter: waitingTimeoutCounter,
@@ -219,6 +224,37 @@ func (wso *DefaultWorkspaceOperations) SetupWorkspace(ctx context.Context, insta
 	return nil
 }
 
+// ResizeQuota grows or shrinks the storage quota of a running workspace. Shrinking fails with quota.ErrUsageExceedsQuota
+// if the workspace already uses more space than the new quota permits.
+func (wso *DefaultWorkspaceOperations) ResizeQuota(ctx context.Context, instanceID string, storageQuota int) error {
+	ws, err := wso.provider.GetAndConnect(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("cannot find workspace %s during ResizeQuota: %w", instanceID, err)
+	}
+
+	if storageQuota == 0 || ws.StorageQuota == storageQuota {
+		return nil
+	}
+	if wso.xfs == nil || ws.XFSProjectID == 0 {
+		return fmt.Errorf("workspace %s has no storage quota", instanceID)
+	}
+
+	err = wso.xfs.SetLimit(ws.XFSProjectID, quota.Size(storageQuota))
+	if err != nil {
+		return fmt.Errorf("cannot resize storage quota of workspace %s: %w", instanceID, err)
+	}
+	glog.WithFields(ws.OWI()).WithField("from", quota.Size(ws.StorageQuota)).WithField("to", quota.Size(storageQuota)).Info("resized workspace storage quota")
+
+	// the ready hooks install the quota again whenever we connect to the workspace, hence they must use the new quota from now on
+	ws.StorageQuota = storageQuota
+	err = ws.Persist()
+	if err != nil {
+		return fmt.Errorf("cannot persist workspace %s after resizing its storage quota: %w", instanceID, err)
+	}
+
+	return nil
+}
+
 func (wso *DefaultWorkspaceOperations) BackupWorkspace(ctx context.Context, opts BackupOptions) (*csapi.GitStatus, error) {
 	ws, err := wso.provider.GetAndConnect(ctx, opts.Meta.InstanceID)
 	if err != nil {
@@ -229,15 +265,17 @@ func (wso *DefaultWorkspaceOperations) BackupWorkspace(ctx context.Context, opts
 		return nil, fmt.Errorf("workspace has no remote storage")
 	}
 
-	if opts.BackupLogs {
-		err := wso.uploadWorkspaceLogs(ctx, opts, ws.Location)
-		if err != nil {
-			// we do not fail the workspace yet because we still might succeed with its content!
-			glog.WithError(err).WithFields(ws.OWI()).Error("log backup failed")
-		}
+	err = wso.uploadWorkspaceLogs(ctx, opts, ws.Location)
+	if err != nil {
+		// we do not fail the workspace yet because we still might succeed with its content!
+		glog.WithError(err).WithFields(ws.OWI()).Error("log backup failed")
 	}
 
-	err = wso.uploadWorkspaceContent(ctx, ws, opts.SnapshotName)
+	if opts.SnapshotName == storage.DefaultBackup {
+		err = wso.uploadWorkspaceBackup(ctx, ws)
+	} else {
+		err = wso.uploadWorkspaceContent(ctx, ws, opts.SnapshotName)
+	}
 	if err != nil {
 		glog.WithError(err).WithFields(ws.OWI()).Error("final backup failed for workspace")
 		return nil, fmt.Errorf("final backup failed for workspace %s", opts.Meta.InstanceID)
@@ -345,11 +383,21 @@ func ensureCleanSlate(location string) error {
 }
 
 func (wso *DefaultWorkspaceOperations) uploadWorkspaceLogs(ctx context.Context, opts BackupOptions, location string) (err error) {
-	// currently we're only uploading prebuild log files
-	logFiles, err := logs.ListPrebuildLogFiles(ctx, location)
+	// prebuild logs are only uploaded for prebuilds, terminal recordings for all workspaces which have some
+	var logFiles []string
+	if opts.BackupLogs {
+		logFiles, err = logs.ListPrebuildLogFiles(ctx, location)
+		if err != nil {
+			return err
+		}
+	}
+	recordings, err := logs.ListTerminalRecordings(ctx, location)
 	if err != nil {
 		return err
 	}
+	if len(logFiles) == 0 && len(recordings) == 0 {
+		return nil
+	}
 
 	rs, err := storage.NewDirectAccess(&wso.config.Storage)
 	if err != nil {
@@ -386,7 +434,120 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceLogs(ctx context.Context,
 			return xerrors.Errorf("cannot upload workspace logs: %w", err)
 		}
 	}
-	return err
+
+	owi := glog.OWI(opts.Meta.Owner, opts.Meta.WorkspaceID, opts.Meta.InstanceID)
+	for _, absRecordingPath := range recordings {
+		name := logs.UploadedTerminalRecordingPath(filepath.Base(absRecordingPath))
+		uploaded, err := recordingUploaded(ctx, rs, opts.Meta.InstanceID, name)
+		if err != nil {
+			glog.WithError(err).WithFields(owi).WithField("recording", name).Warn("cannot check if terminal recording was uploaded already")
+		}
+		if !uploaded {
+			err = retryIfErr(ctx, 5, glog.WithField("op", "upload terminal recording").WithFields(owi), func(ctx context.Context) (err error) {
+				_, _, err = rs.UploadInstance(ctx, absRecordingPath, name)
+				return
+			})
+			if err != nil {
+				return xerrors.Errorf("cannot upload terminal recordings: %w", err)
+			}
+		}
+
+		// uploaded recordings are removed s.t. they are neither part of the workspace backup nor uploaded again
+		err = os.Remove(absRecordingPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			glog.WithError(err).WithFields(owi).WithField("recording", name).Warn("cannot remove uploaded terminal recording")
+		}
+	}
+	return nil
+}
+
+// recordingUploaded returns true if a terminal recording was uploaded to the instance's storage already
+func recordingUploaded(ctx context.Context, rs storage.DirectAccess, instanceID, name string) (bool, error) {
+	ar, ok := rs.(storage.ObjectAttributesReader)
+	if !ok {
+		return false, nil
+	}
+	_, err := ar.ObjectAttributes(ctx, storage.InstanceObjectName(instanceID, name))
+	if errors.Is(err, storage.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// uploadWorkspaceBackup uploads the regular backup of a workspace. With backup history, every backup
+// is uploaded as a new generation and generations beyond the configured history are garbage-collected.
+func (wso *DefaultWorkspaceOperations) uploadWorkspaceBackup(ctx context.Context, sess *session.Workspace) error {
+	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
+	if rs == nil || !ok {
+		return xerrors.Errorf("no remote storage configured")
+	}
+
+	// Once a workspace has backup history, restores use its latest generation. We must keep
+	// producing generations, otherwise a restore would pick up a stale backup.
+	keep := wso.config.Backup.History
+	mf, err := storage.DownloadBackupManifest(ctx, rs)
+	if errors.Is(err, storage.ErrNotFound) {
+		if keep == 0 {
+			return wso.uploadWorkspaceContent(ctx, sess, storage.DefaultBackup)
+		}
+		mf = &csapi.WorkspaceBackupManifest{}
+	} else if err != nil {
+		return xerrors.Errorf("cannot download backup manifest: %w", err)
+	}
+
+	backup := storage.NewBackupGeneration(time.Now(), sess.InstanceID)
+	err = wso.uploadWorkspaceContent(ctx, sess, backup.Name)
+	if err != nil {
+		return err
+	}
+	_, err = storage.DownloadChunkIndex(ctx, rs, backup.Name)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return xerrors.Errorf("cannot download chunk index: %w", err)
+	}
+	backup.Chunked = err == nil
+
+	removed := storage.AddBackupGeneration(mf, backup, keep)
+	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload backup manifest"), func(ctx context.Context) error {
+		return storage.UploadBackupManifest(ctx, rs, mf, wso.config.TmpDir)
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot upload backup manifest: %w", err)
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+	ps, err := storage.NewPresignedAccess(&wso.config.Storage)
+	if err != nil {
+		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot garbage-collect backup generations")
+		return nil
+	}
+	var chunked bool
+	for _, b := range removed {
+		// the manifest no longer references the generation - failing to delete it only leaves garbage behind
+		err = storage.DeleteBackupGeneration(ctx, ps, rs.Bucket(sess.Owner), rs.BackupObject(b.Name))
+		if err != nil {
+			glog.WithError(err).WithFields(sess.OWI()).WithField("backup", b.ID).Warn("cannot delete backup generation")
+		}
+		chunked = chunked || b.Chunked
+	}
+	glog.WithField("removed", len(removed)).WithField("backups", len(mf.Backups)).WithFields(sess.OWI()).Debug("garbage-collected backup generations")
+
+	if !chunked {
+		return nil
+	}
+	// chunks are shared between generations, hence only those no remaining generation references can go
+	deleted, err := storage.GarbageCollectChunks(ctx, rs, ps)
+	if err != nil {
+		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot garbage-collect backup chunks")
+		return nil
+	}
+	glog.WithField("deleted", deleted).WithFields(sess.OWI()).Debug("garbage-collected backup chunks")
+
+	return nil
 }
 
 func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName string) error {
@@ -433,75 +594,77 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 	}
 
 	var (
-		tmpf     *os.File
-		tmpfSize int64
-	)
-
-	defer func() {
-		if tmpf != nil {
-			os.Remove(tmpf.Name())
+		tarOpts  []archive.TarOption
+		mappings = []archive.IDMapping{
+			{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
+			{ContainerID: 1, HostID: 100000, Size: 65534},
 		}
-	}()
+	)
+	tarOpts = append(tarOpts,
+		archive.WithUIDMapping(mappings),
+		archive.WithGIDMapping(mappings),
+	)
 
-	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "create archive"), func(ctx context.Context) (err error) {
-		tmpf, err = os.CreateTemp(wso.config.TmpDir, fmt.Sprintf("wsbkp-%s-*.tar", sess.InstanceID))
-		if err != nil {
-			return
+	// Once a workspace has a chunked backup we must keep producing chunked backups,
+	// otherwise a restore would pick up the stale chunk index.
+	chunked := wso.config.Backup.Chunked
+	if !chunked {
+		_, err := storage.DownloadChunkIndex(ctx, rs, backupName)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			// we cannot tell whether to upload a chunked backup - uploading the wrong kind would break restores
+			return xerrors.Errorf("cannot download chunk index: %w", err)
 		}
-
-		defer func() {
-			tmpf.Close()
+		chunked = err == nil
+	}
+	if chunked {
+		err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload chunked"), func(ctx context.Context) (err error) {
+			tarReader, err := content.StreamTarbal(ctx, loc, tarOpts...)
 			if err != nil {
-				os.Remove(tmpf.Name())
+				return
 			}
-		}()
-
-		var opts []archive.TarOption
-		opts = append(opts)
-		mappings := []archive.IDMapping{
-			{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
-			{ContainerID: 1, HostID: 100000, Size: 65534},
-		}
-		opts = append(opts,
-			archive.WithUIDMapping(mappings),
-			archive.WithGIDMapping(mappings),
-		)
+			defer tarReader.Close()
 
-		err = content.BuildTarbal(ctx, loc, tmpf.Name(), opts...)
-		if err != nil {
-			return
-		}
-		err = tmpf.Sync()
-		if err != nil {
-			return
-		}
-		_, err = tmpf.Seek(0, 0)
-		if err != nil {
+			idx, err := storage.UploadChunked(ctx, rs, tarReader, backupName, storage.ChunkedUploadOptions{
+				TmpDir:     wso.config.TmpDir,
+				InstanceID: sess.InstanceID,
+			})
+			if err != nil {
+				return
+			}
+			glog.WithField("size", idx.Size).WithField("chunks", len(idx.Chunks)).WithFields(sess.OWI()).Debug("uploaded chunked workspace backup")
 			return
-		}
-
-		stat, err := tmpf.Stat()
+		})
 		if err != nil {
-			return
+			return xerrors.Errorf("cannot upload workspace content: %w", err)
 		}
-		tmpfSize = stat.Size()
-		glog.WithField("size", tmpfSize).WithField("location", tmpf.Name()).WithFields(sess.OWI()).Debug("created temp file for workspace backup upload")
 
-		return
-	})
-	if err != nil {
-		return xerrors.Errorf("cannot create archive: %w", err)
+		return nil
 	}
 
+	// We stream the archive straight to remote storage in parts, rather than building it in a temp file first.
+	// If an attempt fails, the next one resumes after the last part that was uploaded.
+	upload := storage.NewStreamUpload(rs, backupName, storage.StreamUploadOptions{
+		Compress: wso.config.Backup.Compress,
+		Options:  opts,
+	})
 	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload layer"), func(ctx context.Context) (err error) {
-		_, _, err = rs.Upload(ctx, tmpf.Name(), backupName, opts...)
+		tarReader, err := content.StreamTarbal(ctx, loc, tarOpts...)
 		if err != nil {
 			return
 		}
+		defer tarReader.Close()
 
+		res, err := upload.Run(ctx, tarReader)
+		if err != nil {
+			return
+		}
+		glog.WithField("size", res.Size).WithField("parts", res.Parts).WithField("digest", res.Digest).WithFields(sess.OWI()).Debug("uploaded workspace backup")
 		return
 	})
 	if err != nil {
+		if aerr := upload.Abort(context.Background()); aerr != nil {
+			glog.WithError(aerr).WithFields(sess.OWI()).Warn("cannot abort workspace backup upload")
+		}
 		return xerrors.Errorf("cannot upload workspace content: %w", err)
 	}
 
@@ -7,13 +7,11 @@ package cpulimit
 import (
 	"context"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/limiter"
 	"github.com/sirupsen/logrus"
-	"golang.org/x/xerrors"
-	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 type Workspace struct {
@@ -23,6 +21,8 @@ type Workspace struct {
 	Usage       CPUTime
 	QoS         int
 	Annotations map[string]string
+	// Group is the group (e.g. team) the workspace belongs to when distributing bandwidth hierarchically
+	Group string
 }
 
 type WorkspaceHistory struct {
@@ -50,6 +50,24 @@ func (h *WorkspaceHistory) Update(w Workspace) {
 	h.LastUpdate = &w
 }
 
+// WorkspaceID implements limiter.Workspace
+func (h *WorkspaceHistory) WorkspaceID() string {
+	return h.ID
+}
+
+// WorkspaceClass implements limiter.Workspace. Workspace classes play no role in CPU limiting.
+func (h *WorkspaceHistory) WorkspaceClass() string {
+	return ""
+}
+
+// WorkspaceAnnotations implements limiter.Workspace
+func (h *WorkspaceHistory) WorkspaceAnnotations() map[string]string {
+	if h.LastUpdate == nil {
+		return nil
+	}
+	return h.LastUpdate.Annotations
+}
+
 func (h *WorkspaceHistory) Throttled() bool {
 	if h.LastUpdate == nil || h.ThrottleLag == 0 {
 		return false
@@ -218,51 +236,19 @@ func (d *Distributor) Reset() {
 }
 
 // ResourceLimiter implements a strategy to limit the resurce use of a workspace
-type ResourceLimiter interface {
-	Limit(wsh *WorkspaceHistory) (Bandwidth, error)
-}
+type ResourceLimiter = limiter.ResourceLimiter[*WorkspaceHistory, Bandwidth]
 
-var _ ResourceLimiter = (*fixedLimiter)(nil)
-var _ ResourceLimiter = (*annotationLimiter)(nil)
 var _ ResourceLimiter = (*BucketLimiter)(nil)
 var _ ResourceLimiter = (*ClampingBucketLimiter)(nil)
-var _ ResourceLimiter = (*compositeLimiter)(nil)
 
 // FixedLimiter returns a fixed limit
 func FixedLimiter(limit Bandwidth) ResourceLimiter {
-	return fixedLimiter{limit}
-}
-
-type fixedLimiter struct {
-	FixedLimit Bandwidth
-}
-
-func (f fixedLimiter) Limit(wsh *WorkspaceHistory) (Bandwidth, error) {
-	return f.FixedLimit, nil
+	return limiter.Fixed[*WorkspaceHistory](limit)
 }
 
+// AnnotationLimiter returns the limit found in an annotation of the workspace
 func AnnotationLimiter(annotation string) ResourceLimiter {
-	return annotationLimiter{
-		Annotation: annotation,
-	}
-}
-
-type annotationLimiter struct {
-	Annotation string
-}
-
-func (a annotationLimiter) Limit(wsh *WorkspaceHistory) (Bandwidth, error) {
-	value, ok := wsh.LastUpdate.Annotations[a.Annotation]
-	if !ok {
-		return 0, xerrors.Errorf("no annotation named %s found on workspace %s", a.Annotation, wsh.ID)
-	}
-
-	limit, err := resource.ParseQuantity(value)
-	if err != nil {
-		return 0, xerrors.Errorf("failed to parse %s for workspace %s", limit, wsh.ID)
-	}
-
-	return BandwidthFromQuantity(limit), nil
+	return limiter.Annotation[*WorkspaceHistory](annotation, BandwidthFromQuantity)
 }
 
 // Bucket describes a "pot of CPU time" which can be spent at a particular rate.
@@ -344,33 +330,9 @@ func (bl *ClampingBucketLimiter) Limit(wsh *WorkspaceHistory) (Bandwidth, error)
 	return 0, nil
 }
 
-type compositeLimiter struct {
-	limiters []ResourceLimiter
-}
-
+// CompositeLimiter returns the limit of the first limiter which is able to provide one
 func CompositeLimiter(limiters ...ResourceLimiter) ResourceLimiter {
-	return &compositeLimiter{
-		limiters: limiters,
-	}
-}
-
-func (cl *compositeLimiter) Limit(wsh *WorkspaceHistory) (Bandwidth, error) {
-	var errs []error
-	for _, limiter := range cl.limiters {
-		limit, err := limiter.Limit(wsh)
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-
-		return limit, nil
-	}
-
-	allerr := make([]string, len(errs))
-	for i, err := range errs {
-		allerr[i] = err.Error()
-	}
-	return 0, xerrors.Errorf("no limiter was able to provide a limit", strings.Join(allerr, ", "))
+	return limiter.Composite(limiters...)
 }
 
 type CFSController interface {
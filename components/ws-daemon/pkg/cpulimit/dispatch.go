@@ -33,6 +33,19 @@ type Config struct {
 
 	ControlPeriod  util.Duration `json:"controlPeriod"`
 	CGroupBasePath string        `json:"cgroupBasePath"`
+
+	// FairShare splits the total bandwidth across teams before distributing it across their workspaces
+	FairShare FairShareConfig `json:"fairShare"`
+}
+
+// FairShareConfig configures the hierarchical distribution of CPU bandwidth. Workspaces are grouped
+// by their team, tenant or - if they have neither - their owner.
+type FairShareConfig struct {
+	Enabled bool `json:"enabled"`
+	// DefaultWeight is the weight of groups without an entry in Weights. Defaults to 1.
+	DefaultWeight uint64 `json:"defaultWeight,omitempty"`
+	// Weights configures the weight of individual groups, keyed by "team/<id>", "tenant/<id>" or "owner/<id>"
+	Weights map[string]uint64 `json:"weights,omitempty"`
 }
 
 // NewDispatchListener creates a new resource governer dispatch listener
@@ -65,12 +78,24 @@ func NewDispatchListener(cfg *Config, prom prometheus.Registerer) *DispatchListe
 	}
 
 	if cfg.Enabled {
-		dist := NewDistributor(d.source, d.sink,
-			CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuMinLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.Limit))),
-			CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuBurstLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.BurstLimit))),
-			BandwidthFromQuantity(d.Config.TotalBandwidth),
+		var (
+			limiter      = CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuMinLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.Limit)))
+			burstLimiter = CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuBurstLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.BurstLimit)))
 		)
-		go dist.Run(context.Background(), time.Duration(d.Config.ControlPeriod))
+		if cfg.FairShare.Enabled {
+			dist := NewHierarchicalDistributor(d.source, d.sink, limiter, burstLimiter,
+				BandwidthFromQuantity(d.Config.TotalBandwidth),
+				FixedGroupWeights(cfg.FairShare.Weights, cfg.FairShare.DefaultWeight),
+			)
+			dist.Log = log.WithField("component", "cpulimit")
+			go dist.Run(context.Background(), time.Duration(d.Config.ControlPeriod))
+		} else {
+			dist := NewDistributor(d.source, d.sink, limiter, burstLimiter,
+				BandwidthFromQuantity(d.Config.TotalBandwidth),
+			)
+			dist.Log = log.WithField("component", "cpulimit")
+			go dist.Run(context.Background(), time.Duration(d.Config.ControlPeriod))
+		}
 	}
 
 	prom.MustRegister(
@@ -104,6 +129,7 @@ type workspace struct {
 	OWI         logrus.Fields
 	HardLimit   ResourceLimiter
 	Annotations map[string]string
+	Group       string
 
 	lastThrottled uint64
 }
@@ -143,6 +169,7 @@ func (d *DispatchListener) source(context.Context) ([]Workspace, error) {
 			NrThrottled: throttled,
 			Usage:       usage,
 			Annotations: w.Annotations,
+			Group:       w.Group,
 		})
 	}
 	return res, nil
@@ -193,6 +220,7 @@ func (d *DispatchListener) WorkspaceAdded(ctx context.Context, ws *dispatch.Work
 		CFS:         controller,
 		OWI:         ws.OWI(),
 		Annotations: ws.Pod.Annotations,
+		Group:       workspaceGroup(ws),
 	}
 	go func() {
 		<-ctx.Done()
@@ -222,6 +250,16 @@ func (d *DispatchListener) WorkspaceUpdated(ctx context.Context, ws *dispatch.Wo
 	return nil
 }
 
+// workspaceGroup returns the group a workspace belongs to for fair-share distribution
+func workspaceGroup(ws *dispatch.Workspace) string {
+	for _, label := range []string{kubernetes.TeamLabel, kubernetes.TenantLabel} {
+		if group := ws.Pod.Labels[label]; group != "" {
+			return label + "/" + group
+		}
+	}
+	return kubernetes.OwnerLabel + "/" + ws.Pod.Labels[kubernetes.OwnerLabel]
+}
+
 func newCFSController(basePath, cgroupPath string) (CFSController, error) {
 	unified, err := cgroups.IsUnifiedCgroupSetup()
 	if err != nil {
@@ -0,0 +1,245 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cpulimit
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// GroupWeights returns the weight of a group of workspaces. Groups receive a share of the total
+// bandwidth proportional to their weight.
+type GroupWeights func(group string) uint64
+
+// FixedGroupWeights returns the weight configured for a group, and defaultWeight for all others
+func FixedGroupWeights(weights map[string]uint64, defaultWeight uint64) GroupWeights {
+	if defaultWeight == 0 {
+		defaultWeight = 1
+	}
+	return func(group string) uint64 {
+		if w, ok := weights[group]; ok && w > 0 {
+			return w
+		}
+		return defaultWeight
+	}
+}
+
+func NewHierarchicalDistributor(source DistributorSource, sink DistributorSink, limiter ResourceLimiter, burstLimiter ResourceLimiter, totalBandwidth Bandwidth, weights GroupWeights) *HierarchicalDistributor {
+	return &HierarchicalDistributor{
+		Source:         source,
+		Sink:           sink,
+		Limiter:        limiter,
+		BurstLimiter:   burstLimiter,
+		TotalBandwidth: totalBandwidth,
+		Weights:        weights,
+		Groups:         make(map[string]*Distributor),
+	}
+}
+
+// HierarchicalDistributor first splits the total bandwidth across groups of workspaces (e.g. teams)
+// according to their weights, and then across the workspaces within each group. Each group is governed
+// by its own Distributor, hence the burst semantics apply within the group's share.
+//
+// If the limits of all workspaces exceed the total bandwidth, groups which need less than their share
+// keep their limits, and the remaining bandwidth is split across the other groups by weight.
+type HierarchicalDistributor struct {
+	Source DistributorSource
+	Sink   DistributorSink
+
+	Groups       map[string]*Distributor
+	Limiter      ResourceLimiter
+	BurstLimiter ResourceLimiter
+	Weights      GroupWeights
+
+	// TotalBandwidth is the total CPU bandwidth available to all groups
+	TotalBandwidth Bandwidth
+
+	// Log is used (if not nil) to log out errors. If log is nil, no logging happens.
+	Log *logrus.Entry
+}
+
+type HierarchicalDistributorDebug struct {
+	DistributorDebug
+	Groups map[string]GroupDebug
+}
+
+type GroupDebug struct {
+	DistributorDebug
+	Weight uint64
+	// BandwidthLimited is the sum of limits of the group's workspaces after fair-share was applied
+	BandwidthLimited Bandwidth
+}
+
+type groupLimit struct {
+	ID    string
+	Limit Bandwidth
+	Burst bool
+}
+
+// Run starts a ticker which repeatedly calls Tick until the context is canceled.
+// This function does not return until the context is canceled.
+func (d *HierarchicalDistributor) Run(ctx context.Context, dt time.Duration) {
+	t := time.NewTicker(dt)
+	defer t.Stop()
+
+	go func() {
+		for range t.C {
+			_, err := d.Tick(dt)
+			if err != nil && d.Log != nil {
+				d.Log.WithError(err).Warn("cannot advance CPU limit distributor")
+			}
+		}
+	}()
+	<-ctx.Done()
+}
+
+// Tick drives the distributor and pushes out new limits.
+// Callers are expected to call this function repeatedly, with dt time inbetween calls.
+func (d *HierarchicalDistributor) Tick(dt time.Duration) (HierarchicalDistributorDebug, error) {
+	ws, err := d.Source(context.Background())
+	if err != nil {
+		return HierarchicalDistributorDebug{}, err
+	}
+
+	members := make(map[string][]Workspace)
+	for _, w := range ws {
+		members[w.Group] = append(members[w.Group], w)
+	}
+	for group := range d.Groups {
+		if _, found := members[group]; !found {
+			delete(d.Groups, group)
+		}
+	}
+
+	groups := make([]string, 0, len(members))
+	weights := make(map[string]uint64, len(members))
+	var totalWeight uint64
+	for group := range members {
+		groups = append(groups, group)
+		weights[group] = d.Weights(group)
+		if weights[group] == 0 {
+			weights[group] = 1
+		}
+		totalWeight += weights[group]
+	}
+	sort.Strings(groups)
+
+	var (
+		dbg = HierarchicalDistributorDebug{
+			DistributorDebug: DistributorDebug{BandwidthAvail: d.TotalBandwidth},
+			Groups:           make(map[string]GroupDebug, len(groups)),
+		}
+		limits = make(map[string][]groupLimit, len(groups))
+		errs   []error
+	)
+	for _, group := range groups {
+		group := group
+		dist, ok := d.Groups[group]
+		if !ok {
+			dist = NewDistributor(nil, nil, d.Limiter, d.BurstLimiter, 0)
+			d.Groups[group] = dist
+		}
+		dist.Source = func(context.Context) ([]Workspace, error) { return members[group], nil }
+		dist.Sink = func(id string, limit Bandwidth, burst bool) {
+			limits[group] = append(limits[group], groupLimit{ID: id, Limit: limit, Burst: burst})
+		}
+		dist.TotalBandwidth = Bandwidth(uint64(d.TotalBandwidth) * weights[group] / totalWeight)
+
+		gdbg, err := dist.Tick(dt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		dbg.Groups[group] = GroupDebug{DistributorDebug: gdbg, Weight: weights[group]}
+		dbg.BandwidthUsed += gdbg.BandwidthUsed
+		dbg.BandwidthBurst += gdbg.BandwidthBurst
+	}
+
+	demand := make(map[string]Bandwidth, len(limits))
+	for group, ls := range limits {
+		for _, l := range ls {
+			demand[group] += l.Limit
+		}
+	}
+	alloc := fairShare(d.TotalBandwidth, demand, weights)
+	for _, group := range groups {
+		var limited Bandwidth
+		for _, l := range limits[group] {
+			limit := l.Limit
+			if alloc[group] < demand[group] {
+				limit = Bandwidth(uint64(limit) * uint64(alloc[group]) / uint64(demand[group]))
+			}
+			limited += limit
+			d.Sink(l.ID, limit, l.Burst)
+		}
+
+		gdbg := dbg.Groups[group]
+		gdbg.BandwidthLimited = limited
+		dbg.Groups[group] = gdbg
+	}
+
+	if len(errs) > 0 {
+		return dbg, errs[0]
+	}
+	return dbg, nil
+}
+
+func (d *HierarchicalDistributor) Reset() {
+	d.Groups = make(map[string]*Distributor)
+}
+
+// fairShare allocates total to groups by weight, such that no group receives more than it demands
+// and bandwidth a group does not need is split across the others. If the total demand fits, every
+// group receives what it demands.
+func fairShare(total Bandwidth, demand map[string]Bandwidth, weights map[string]uint64) map[string]Bandwidth {
+	res := make(map[string]Bandwidth, len(demand))
+
+	var totalDemand Bandwidth
+	for group, dmd := range demand {
+		totalDemand += dmd
+		res[group] = dmd
+	}
+	if totalDemand <= total {
+		return res
+	}
+
+	remaining := make(map[string]struct{}, len(demand))
+	for group := range demand {
+		remaining[group] = struct{}{}
+	}
+	avail := total
+	for len(remaining) > 0 {
+		var totalWeight uint64
+		for group := range remaining {
+			totalWeight += weights[group]
+		}
+		if totalWeight == 0 {
+			break
+		}
+
+		// groups which demand less than their share are satisfied, and we split what's left across the others
+		var satisfied []string
+		for group := range remaining {
+			if demand[group] <= Bandwidth(uint64(avail)*weights[group]/totalWeight) {
+				satisfied = append(satisfied, group)
+			}
+		}
+		if len(satisfied) == 0 {
+			for group := range remaining {
+				res[group] = Bandwidth(uint64(avail) * weights[group] / totalWeight)
+			}
+			break
+		}
+
+		for _, group := range satisfied {
+			res[group] = demand[group]
+			avail -= demand[group]
+			delete(remaining, group)
+		}
+	}
+	return res
+}
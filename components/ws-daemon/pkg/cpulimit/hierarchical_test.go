@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cpulimit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
+)
+
+func TestHierarchicalDistributor(t *testing.T) {
+	type tick struct {
+		// Throttled is the NrThrottled of the single workspace in the small group.
+		// The distributor needs two ticks of usage history before it sets limits.
+		Throttled   uint64
+		Expectation map[string]cpulimit.Bandwidth
+	}
+	tests := []struct {
+		Name      string
+		LargeSize int
+		Weights   map[string]uint64
+		Ticks     []tick
+	}{
+		{
+			Name:      "no contention",
+			LargeSize: 4,
+			Ticks: []tick{
+				{Expectation: map[string]cpulimit.Bandwidth{}},
+				{Expectation: map[string]cpulimit.Bandwidth{}},
+				{Expectation: map[string]cpulimit.Bandwidth{"large": 2000, "small": 2000}},
+			},
+		},
+		{
+			Name:      "large group is scaled to its share",
+			LargeSize: 8,
+			Ticks: []tick{
+				{Expectation: map[string]cpulimit.Bandwidth{}},
+				{Expectation: map[string]cpulimit.Bandwidth{}},
+				{Throttled: 1, Expectation: map[string]cpulimit.Bandwidth{"large": 1250, "small": 2000}},
+				{Throttled: 2, Expectation: map[string]cpulimit.Bandwidth{"large": 750, "small": 6000}},
+			},
+		},
+		{
+			Name:      "weights",
+			LargeSize: 8,
+			Weights:   map[string]uint64{"large": 3},
+			Ticks: []tick{
+				{Expectation: map[string]cpulimit.Bandwidth{}},
+				{Expectation: map[string]cpulimit.Bandwidth{}},
+				{Throttled: 1, Expectation: map[string]cpulimit.Bandwidth{"large": 1250, "small": 2000}},
+				{Throttled: 2, Expectation: map[string]cpulimit.Bandwidth{"large": 1125, "small": 3000}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				current []cpulimit.Workspace
+				act     map[string]cpulimit.Bandwidth
+			)
+			dist := cpulimit.NewHierarchicalDistributor(
+				func(ctx context.Context) ([]cpulimit.Workspace, error) { return current, nil },
+				func(id string, limit cpulimit.Bandwidth, burst bool) {
+					group := "small"
+					if id != "small" {
+						group = "large"
+					}
+					if l, exists := act[group]; exists && l != limit {
+						t.Errorf("workspaces of group %s received different limits: %d and %d", group, l, limit)
+					}
+					act[group] = limit
+				},
+				defaultLimit, defaultBreakoutLimit,
+				totalCapacity,
+				cpulimit.FixedGroupWeights(test.Weights, 1),
+			)
+
+			for i, tick := range test.Ticks {
+				usage := cpulimit.CPUTime(time.Duration(i+1) * time.Second)
+				current = []cpulimit.Workspace{{ID: "small", Group: "small", Usage: usage, NrThrottled: tick.Throttled}}
+				for j := 0; j < test.LargeSize; j++ {
+					current = append(current, cpulimit.Workspace{ID: fmt.Sprintf("large-%d", j), Group: "large", Usage: usage})
+				}
+
+				act = make(map[string]cpulimit.Bandwidth)
+				_, err := dist.Tick(testDt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tick.Expectation, act); diff != "" {
+					t.Errorf("unexpected limits in tick %d (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
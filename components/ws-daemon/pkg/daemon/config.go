@@ -13,6 +13,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -21,15 +22,17 @@ import (
 type Config struct {
 	Runtime RuntimeConfig `json:"runtime"`
 
-	Content             content.Config            `json:"content"`
-	Uidmapper           iws.UidmapperConfig       `json:"uidmapper"`
-	CPULimit            cpulimit.Config           `json:"cpulimit"`
-	IOLimit             IOLimitConfig             `json:"ioLimit"`
-	ProcLimit           int64                     `json:"procLimit"`
-	NetLimit            netlimit.Config           `json:"netlimit"`
-	OOMScores           cgroup.OOMScoreAdjConfig  `json:"oomScores"`
-	DiskSpaceGuard      diskguard.Config          `json:"disk"`
-	WorkspaceController WorkspaceControllerConfig `json:"workspaceController"`
+	Content             content.Config             `json:"content"`
+	Uidmapper           iws.UidmapperConfig        `json:"uidmapper"`
+	CPULimit            cpulimit.Config            `json:"cpulimit"`
+	MemLimit            memlimit.Config            `json:"memlimit"`
+	IOLimit             IOLimitConfig              `json:"ioLimit"`
+	ProcLimit           int64                      `json:"procLimit"`
+	NetLimit            netlimit.Config            `json:"netlimit"`
+	OOMScores           cgroup.OOMScoreAdjConfig   `json:"oomScores"`
+	MemoryWarnings      cgroup.MemoryWarningConfig `json:"memoryWarnings"`
+	DiskSpaceGuard      diskguard.Config           `json:"disk"`
+	WorkspaceController WorkspaceControllerConfig  `json:"workspaceController"`
 }
 
 type WorkspaceControllerConfig struct {
@@ -50,6 +53,9 @@ type IOLimitConfig struct {
 	ReadBWPerSecond  resource.Quantity `json:"readBandwidthPerSecond"`
 	WriteIOPS        int64             `json:"writeIOPS"`
 	ReadIOPS         int64             `json:"readIOPS"`
+
+	// Adaptive tightens the limits of workspaces which cause IO contention, using the limits above as ceiling
+	Adaptive cgroup.AdaptiveIOLimitConfig `json:"adaptive"`
 }
 
 type ConfigReloader interface {
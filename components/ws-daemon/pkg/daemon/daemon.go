@@ -7,6 +7,7 @@ package daemon
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 )
@@ -89,12 +91,26 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, xerrors.Errorf("NODENAME env var isn't set")
 	}
 
+	var mgr manager.Manager
+
+	mgr, err = ctrl.NewManager(restCfg, ctrl.Options{
+		Scheme:                 scheme,
+		Port:                   9443,
+		Namespace:              config.Runtime.KubernetesNamespace,
+		HealthProbeBindAddress: "0",
+		MetricsBindAddress:     "0", // Metrics are exposed through baseserver.
+		NewCache:               cache.MultiNamespacedCacheBuilder([]string{config.Runtime.KubernetesNamespace, config.Runtime.SecretsNamespace}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	markUnmountFallback, err := NewMarkUnmountFallback(wrappedReg)
 	if err != nil {
 		return nil, err
 	}
 
-	cgroupV2IOLimiter, err := cgroup.NewIOLimiterV2(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS)
+	cgroupV2IOLimiter, err := cgroup.NewIOLimiterV2(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS, config.IOLimit.Adaptive)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +146,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		},
 		procV2Plugin,
 		cgroup.NewPSIMetrics(wrappedReg),
+		cgroup.NewMemoryWarningV2(config.MemoryWarnings, mgr.GetClient(), config.Runtime.KubernetesNamespace),
 	)
 	if err != nil {
 		return nil, err
@@ -150,11 +167,35 @@ func NewDaemon(config Config) (*Daemon, error) {
 		cgroupPlugins,
 	}
 
+	memoryDistributor := memlimit.NewDispatchListener(&config.MemLimit, wrappedReg)
+	if config.MemLimit.Enabled {
+		listener = append(listener, memoryDistributor)
+	}
+
 	netlimiter := netlimit.NewConnLimiter(config.NetLimit, wrappedReg)
 	if config.NetLimit.Enabled {
 		listener = append(listener, netlimiter)
 	}
 
+	// the bandwidth limiter is always registered so that it can start and stop shaping when its config is reloaded
+	bandwidthLimiter := netlimit.NewBandwidthLimiter(config.NetLimit.Bandwidth, wrappedReg)
+	listener = append(listener, bandwidthLimiter)
+
+	egressFilter := netlimit.NewEgressFilter(config.NetLimit.Egress, wrappedReg)
+	if config.NetLimit.Egress.Enabled {
+		listener = append(listener, egressFilter)
+	}
+
+	xfs, err := quota.NewXFS(config.Content.WorkingArea)
+	if err != nil {
+		return nil, err
+	}
+
+	diskWorkspaces := diskguard.NewWorkspaces(config.Content.WorkingArea, config.DiskSpaceGuard.SupervisorPort, xfs)
+	if config.DiskSpaceGuard.Enabled {
+		listener = append(listener, diskWorkspaces)
+	}
+
 	var configReloader CompositeConfigReloader
 	configReloader = append(configReloader, ConfigReloaderFunc(func(ctx context.Context, config *Config) error {
 		cgroupV2IOLimiter.Update(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS)
@@ -162,30 +203,15 @@ func NewDaemon(config Config) (*Daemon, error) {
 		if config.NetLimit.Enabled {
 			netlimiter.Update(config.NetLimit)
 		}
+		bandwidthLimiter.Update(config.NetLimit.Bandwidth)
+		if config.NetLimit.Egress.Enabled {
+			egressFilter.Update(config.NetLimit.Egress)
+		}
 		return nil
 	}))
 
-	var mgr manager.Manager
-
-	mgr, err = ctrl.NewManager(restCfg, ctrl.Options{
-		Scheme:                 scheme,
-		Port:                   9443,
-		Namespace:              config.Runtime.KubernetesNamespace,
-		HealthProbeBindAddress: "0",
-		MetricsBindAddress:     "0", // Metrics are exposed through baseserver.
-		NewCache:               cache.MultiNamespacedCacheBuilder([]string{config.Runtime.KubernetesNamespace, config.Runtime.SecretsNamespace}),
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	contentCfg := config.Content
 
-	xfs, err := quota.NewXFS(contentCfg.WorkingArea)
-	if err != nil {
-		return nil, err
-	}
-
 	hooks := content.WorkspaceLifecycleHooks(
 		contentCfg,
 		config.Runtime.WorkspaceCIDR,
@@ -194,7 +220,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		config.CPULimit.CGroupBasePath,
 	)
 
-	workspaceOps, err := controller.NewWorkspaceOperations(contentCfg, controller.NewWorkspaceProvider(contentCfg.WorkingArea, hooks), wrappedReg)
+	workspaceOps, err := controller.NewWorkspaceOperations(contentCfg, controller.NewWorkspaceProvider(contentCfg.WorkingArea, hooks), xfs, wrappedReg)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +250,13 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
-	dsk := diskguard.FromConfig(config.DiskSpaceGuard, clientset, nodename)
+	dsk := diskguard.FromConfig(config.DiskSpaceGuard, clientset, nodename, &diskguard.Environment{
+		WorkingArea: contentCfg.WorkingArea,
+		Namespace:   config.Runtime.KubernetesNamespace,
+		Client:      mgr.GetClient(),
+		Runtime:     containerRuntime,
+		Workspaces:  diskWorkspaces,
+	}, wrappedReg)
 
 	return &Daemon{
 		Config:          config,
@@ -233,6 +265,8 @@ func NewDaemon(config Config) (*Daemon, error) {
 		configReloader:  configReloader,
 		mgr:             mgr,
 		metricsRegistry: registry,
+
+		memoryDistributor: memoryDistributor,
 	}, nil
 }
 
@@ -254,6 +288,8 @@ type Daemon struct {
 	mgr             ctrl.Manager
 	metricsRegistry *prometheus.Registry
 
+	memoryDistributor *memlimit.DispatchListener
+
 	cancel context.CancelFunc
 }
 
@@ -325,3 +361,8 @@ func (d *Daemon) ReadinessProbe() func() error {
 func (d *Daemon) MetricsRegistry() *prometheus.Registry {
 	return d.metricsRegistry
 }
+
+// MemoryDistributorDebug serves the state of the memory distributor
+func (d *Daemon) MemoryDistributorDebug() http.Handler {
+	return d.memoryDistributor
+}
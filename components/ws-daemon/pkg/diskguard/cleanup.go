@@ -0,0 +1,163 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const workspaceStateFileSuffix = ".workspace.json"
+
+// StaleContentCleanup removes content from the working area which belongs to workspaces that are gone.
+//
+// Housekeeping only removes content directories without a state file once they are an hour old.
+// Under disk pressure we go further: we also remove content and state files of workspaces
+// which have been stopped and backed up, but whose content was left behind. Content of workspaces
+// whose resource is gone altogether is only removed once the resource has been gone for the grace period,
+// because we cannot tell whether their backup succeeded.
+type StaleContentCleanup struct {
+	Location    string
+	MinAge      time.Duration
+	GracePeriod time.Duration
+
+	// InstanceState returns the state of the workspace instance
+	InstanceState func(ctx context.Context, instanceID string) (InstanceState, error)
+
+	// gone is the time we first found the workspace resource of an instance to be gone
+	gone map[string]time.Time
+}
+
+// InstanceState is the state of a workspace instance as far as removing its content is concerned
+type InstanceState int
+
+const (
+	// InstanceActive means the workspace resource exists and its content has not been backed up yet
+	InstanceActive InstanceState = iota
+	// InstanceBackedUp means the workspace resource exists and its content has been backed up
+	InstanceBackedUp
+	// InstanceGone means there is no workspace resource for the instance anymore
+	InstanceGone
+)
+
+// Run removes stale content
+func (c *StaleContentCleanup) Run(ctx context.Context) (freed uint64, err error) {
+	entries, err := os.ReadDir(c.Location)
+	if err != nil {
+		return 0, xerrors.Errorf("cannot list working area: %w", err)
+	}
+
+	// all entries of an instance have to be stale before we remove any of them
+	instances := make(map[string][]fs.DirEntry)
+	for _, e := range entries {
+		name := strings.TrimSuffix(e.Name(), workspaceStateFileSuffix)
+		name = strings.TrimSuffix(name, "-daemon")
+		if _, err := uuid.Parse(name); err != nil {
+			// not workspace content - we leave it alone
+			continue
+		}
+		instances[name] = append(instances[name], e)
+	}
+
+	if c.gone == nil {
+		c.gone = make(map[string]time.Time)
+	}
+	for instanceID := range c.gone {
+		if _, ok := instances[instanceID]; !ok {
+			delete(c.gone, instanceID)
+		}
+	}
+
+	var errs []string
+	for instanceID, entries := range instances {
+		if ctx.Err() != nil {
+			return freed, ctx.Err()
+		}
+		if !c.isStale(entries) {
+			continue
+		}
+
+		state, err := c.InstanceState(ctx, instanceID)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		switch state {
+		case InstanceActive:
+			delete(c.gone, instanceID)
+			continue
+		case InstanceGone:
+			since, ok := c.gone[instanceID]
+			if !ok {
+				since = time.Now()
+				c.gone[instanceID] = since
+			}
+			if time.Since(since) < c.GracePeriod {
+				continue
+			}
+		}
+
+		for _, e := range entries {
+			fn := filepath.Join(c.Location, e.Name())
+			size, _ := diskUsage(fn)
+			err := os.RemoveAll(fn)
+			if err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			freed += size
+		}
+		delete(c.gone, instanceID)
+		log.WithField("instanceId", instanceID).Info("removed stale workspace content to free disk space")
+	}
+	if len(errs) > 0 {
+		return freed, xerrors.Errorf("cannot remove all stale content: %s", strings.Join(errs, "; "))
+	}
+
+	return freed, nil
+}
+
+func (c *StaleContentCleanup) isStale(entries []fs.DirEntry) bool {
+	for _, e := range entries {
+		nfo, err := e.Info()
+		if err != nil {
+			return false
+		}
+		if time.Since(nfo.ModTime()) < c.MinAge {
+			return false
+		}
+	}
+	return true
+}
+
+// diskUsage returns the number of bytes used by all files under path
+func diskUsage(path string) (uint64, error) {
+	var res uint64
+	err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// files can disappear while we walk - we still want to know about everything else
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		nfo, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		res += uint64(nfo.Size())
+		return nil
+	})
+	return res, err
+}
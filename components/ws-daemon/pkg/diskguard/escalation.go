@@ -0,0 +1,244 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	StepCleanupStaleContent = "cleanupStaleContent"
+	StepPruneImages         = "pruneImages"
+	StepNotifyWorkspaces    = "notifyWorkspaces"
+	StepStopWorkspaces      = "stopWorkspaces"
+
+	defaultStaleContentAge   = 10 * time.Minute
+	defaultStaleContentGrace = 1 * time.Hour
+	defaultImageAge          = 1 * time.Hour
+	defaultNotifyInterval    = 10 * time.Minute
+	defaultStopGracePeriod   = 30 * time.Second
+	defaultAffectedWorkspace = 1
+	defaultNotifyMessage     = "This node is running out of disk space. Please free up space in your workspace, e.g. by removing build artifacts or caches, or your workspace may be stopped."
+)
+
+// EscalationConfig configures the steps a guard takes to free space once it runs low.
+// Each step is taken when the available bytes fall to or below its threshold, starting with the highest threshold.
+// A step with a zero threshold is disabled.
+type EscalationConfig struct {
+	// CleanupStaleContent removes content in the working area which no longer belongs to a workspace
+	CleanupStaleContent CleanupConfig `json:"cleanupStaleContent"`
+	// PruneImages removes container images no container on this node uses
+	PruneImages PruneImagesConfig `json:"pruneImages"`
+	// NotifyWorkspaces asks the users of the workspaces using the most space to free some of it
+	NotifyWorkspaces NotifyConfig `json:"notifyWorkspaces"`
+	// StopWorkspaces gracefully stops the workspaces using the most space. Their content is backed up as usual.
+	StopWorkspaces StopConfig `json:"stopWorkspaces"`
+}
+
+type CleanupConfig struct {
+	MinBytesAvail uint64 `json:"minBytesAvail"`
+	// MinAge is the time content must have been left untouched before we remove it. Defaults to 10 minutes.
+	MinAge util.Duration `json:"minAge,omitempty"`
+	// GracePeriod is the time the workspace resource must have been gone before we remove content
+	// which we do not know to be backed up. Defaults to one hour.
+	GracePeriod util.Duration `json:"gracePeriod,omitempty"`
+}
+
+type PruneImagesConfig struct {
+	MinBytesAvail uint64 `json:"minBytesAvail"`
+	// MinAge is the time since an image was last pulled before we remove it. Defaults to one hour.
+	MinAge util.Duration `json:"minAge,omitempty"`
+}
+
+type NotifyConfig struct {
+	MinBytesAvail uint64 `json:"minBytesAvail"`
+	// Workspaces is the number of workspaces we notify, heaviest first. Defaults to one.
+	Workspaces int `json:"workspaces,omitempty"`
+	// Interval is the time before we notify the same workspace again. Defaults to 10 minutes.
+	Interval util.Duration `json:"interval,omitempty"`
+	Message  string        `json:"message,omitempty"`
+}
+
+type StopConfig struct {
+	MinBytesAvail uint64 `json:"minBytesAvail"`
+	// Workspaces is the number of workspaces we stop at once, heaviest first. Defaults to one.
+	Workspaces int `json:"workspaces,omitempty"`
+	// GracePeriod is the time workspaces get to shut down. Defaults to 30 seconds.
+	GracePeriod util.Duration `json:"gracePeriod,omitempty"`
+}
+
+// Step is a single step of a guard's escalation policy
+type Step struct {
+	Name          string
+	MinBytesAvail uint64
+	Action        Action
+}
+
+// Action frees disk space
+type Action interface {
+	// Run takes the action and returns the number of bytes it freed, as far as that is known.
+	Run(ctx context.Context) (freed uint64, err error)
+}
+
+// ActionFunc turns a function into an action
+type ActionFunc func(ctx context.Context) (freed uint64, err error)
+
+// Run calls the function
+func (f ActionFunc) Run(ctx context.Context) (freed uint64, err error) {
+	return f(ctx)
+}
+
+// Environment provides what guards need to take their escalation steps
+type Environment struct {
+	// WorkingArea is the directory containing the workspace content
+	WorkingArea string
+	// Namespace is the namespace of the workspace resources
+	Namespace  string
+	Client     client.Client
+	Runtime    container.Runtime
+	Workspaces *Workspaces
+}
+
+// instanceState looks up the workspace resource of the instance to find out if its content has been backed up
+func (env *Environment) instanceState(ctx context.Context, instanceID string) (InstanceState, error) {
+	var ws workspacev1.Workspace
+	err := env.Client.Get(ctx, types.NamespacedName{Namespace: env.Namespace, Name: instanceID}, &ws)
+	if errors.IsNotFound(err) {
+		return InstanceGone, nil
+	}
+	if err != nil {
+		return InstanceActive, err
+	}
+	if ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete) {
+		return InstanceBackedUp, nil
+	}
+	return InstanceActive, nil
+}
+
+func (cfg *EscalationConfig) steps(env *Environment, m *metrics) []Step {
+	var res []Step
+	if c := cfg.CleanupStaleContent; c.MinBytesAvail > 0 {
+		res = append(res, Step{
+			Name:          StepCleanupStaleContent,
+			MinBytesAvail: c.MinBytesAvail,
+			Action: &StaleContentCleanup{
+				Location:      env.WorkingArea,
+				MinAge:        durationOrDefault(c.MinAge, defaultStaleContentAge),
+				GracePeriod:   durationOrDefault(c.GracePeriod, defaultStaleContentGrace),
+				InstanceState: env.instanceState,
+			},
+		})
+	}
+	if c := cfg.PruneImages; c.MinBytesAvail > 0 && env.Runtime != nil {
+		minAge := durationOrDefault(c.MinAge, defaultImageAge)
+		res = append(res, Step{
+			Name:          StepPruneImages,
+			MinBytesAvail: c.MinBytesAvail,
+			Action: ActionFunc(func(ctx context.Context) (uint64, error) {
+				return env.Runtime.PruneImages(ctx, minAge)
+			}),
+		})
+	}
+	if c := cfg.NotifyWorkspaces; c.MinBytesAvail > 0 && env.Workspaces != nil {
+		msg := c.Message
+		if msg == "" {
+			msg = defaultNotifyMessage
+		}
+		res = append(res, Step{
+			Name:          StepNotifyWorkspaces,
+			MinBytesAvail: c.MinBytesAvail,
+			Action: &WorkspaceNotifier{
+				Workspaces: env.Workspaces,
+				Count:      countOrDefault(c.Workspaces),
+				Interval:   durationOrDefault(c.Interval, defaultNotifyInterval),
+				Message:    msg,
+				Notify:     env.Workspaces.notify,
+				affected:   m.workspacesTotal.WithLabelValues(StepNotifyWorkspaces),
+			},
+		})
+	}
+	if c := cfg.StopWorkspaces; c.MinBytesAvail > 0 && env.Workspaces != nil && env.Client != nil {
+		res = append(res, Step{
+			Name:          StepStopWorkspaces,
+			MinBytesAvail: c.MinBytesAvail,
+			Action: &WorkspaceStopper{
+				Workspaces:  env.Workspaces,
+				Count:       countOrDefault(c.Workspaces),
+				GracePeriod: durationOrDefault(c.GracePeriod, defaultStopGracePeriod),
+				Stop:        env.stopWorkspace,
+				affected:    m.workspacesTotal.WithLabelValues(StepStopWorkspaces),
+			},
+		})
+	}
+	return res
+}
+
+func durationOrDefault(d util.Duration, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return time.Duration(d)
+}
+
+func countOrDefault(c int) int {
+	if c <= 0 {
+		return defaultAffectedWorkspace
+	}
+	return c
+}
+
+type metrics struct {
+	availableBytes  *prometheus.GaugeVec
+	stepsTotal      *prometheus.CounterVec
+	freedBytesTotal *prometheus.CounterVec
+	workspacesTotal *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		availableBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "diskguard_available_bytes",
+			Help: "Bytes available on a guarded location",
+		}, []string{"path"}),
+		stepsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "diskguard_escalation_steps_total",
+			Help: "Number of escalation steps taken to free disk space",
+		}, []string{"path", "step", "outcome"}),
+		freedBytesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "diskguard_escalation_freed_bytes_total",
+			Help: "Bytes freed by escalation steps, as far as the step can tell",
+		}, []string{"path", "step"}),
+		workspacesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "diskguard_escalation_workspaces_total",
+			Help: "Number of workspaces notified or stopped to free disk space",
+		}, []string{"step"}),
+	}
+}
+
+// Describe implements Collector.
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.availableBytes.Describe(ch)
+	m.stepsTotal.Describe(ch)
+	m.freedBytesTotal.Describe(ch)
+	m.workspacesTotal.Describe(ch)
+}
+
+// Collect implements Collector.
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.availableBytes.Collect(ch)
+	m.stepsTotal.Collect(ch)
+	m.freedBytesTotal.Collect(ch)
+	m.workspacesTotal.Collect(ch)
+}
@@ -6,9 +6,11 @@ package diskguard
 
 import (
 	"context"
+	"sort"
 	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/xerrors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -29,27 +31,46 @@ type Config struct {
 	Enabled   bool             `json:"enabled"`
 	Interval  util.Duration    `json:"interval"`
 	Locations []LocationConfig `json:"locations"`
+
+	// SupervisorPort is the port of the supervisor API we send notifications to. Defaults to 22999.
+	SupervisorPort int `json:"supervisorPort,omitempty"`
 }
 
 type LocationConfig struct {
 	Path          string `json:"path"`
 	MinBytesAvail uint64 `json:"minBytesAvail"`
+
+	// Escalation configures the steps taken to free space on this location
+	Escalation *EscalationConfig `json:"escalation,omitempty"`
 }
 
 // FromConfig produces a set of disk space guards from the configuration
-func FromConfig(cfg Config, clientset kubernetes.Interface, nodeName string) []*Guard {
+func FromConfig(cfg Config, clientset kubernetes.Interface, nodeName string, env *Environment, prom prometheus.Registerer) []*Guard {
 	if !cfg.Enabled {
 		return nil
 	}
 
+	metrics := newMetrics()
+	prom.MustRegister(metrics)
+
 	res := make([]*Guard, len(cfg.Locations))
 	for i, loc := range cfg.Locations {
+		var steps []Step
+		if loc.Escalation != nil && env != nil {
+			steps = loc.Escalation.steps(env, metrics)
+		}
+		sort.SliceStable(steps, func(i, j int) bool { return steps[i].MinBytesAvail > steps[j].MinBytesAvail })
+
 		res[i] = &Guard{
 			Path:          loc.Path,
 			MinBytesAvail: loc.MinBytesAvail,
 			Interval:      time.Duration(cfg.Interval),
 			Clientset:     clientset,
 			Nodename:      nodeName,
+			Steps:         steps,
+
+			metrics:        metrics,
+			availableBytes: getAvailableBytes,
 		}
 	}
 
@@ -60,32 +81,76 @@ func FromConfig(cfg Config, clientset kubernetes.Interface, nodeName string) []*
 // If the percentage of used space goes above a certain threshold,
 // we'll label the node accordingly - and remove the label once that condition
 // subsides.
+//
+// If the available space keeps falling, the guard escalates: it takes its steps
+// in order of their thresholds until enough space is available again.
 type Guard struct {
 	Path          string
 	MinBytesAvail uint64
 	Interval      time.Duration
 	Clientset     kubernetes.Interface
 	Nodename      string
+	Steps         []Step
+
+	metrics        *metrics
+	availableBytes func(path string) (uint64, error)
 }
 
 // Start starts the disk guard
 func (g *Guard) Start() {
 	t := time.NewTicker(g.Interval)
 	for {
-		bvail, err := getAvailableBytes(g.Path)
-		if err != nil {
-			log.WithError(err).WithField("path", g.Path).Error("cannot check how much space is available")
+		g.check(context.Background())
+		<-t.C
+	}
+}
+
+func (g *Guard) check(ctx context.Context) {
+	bvail, err := g.availableBytes(g.Path)
+	if err != nil {
+		log.WithError(err).WithField("path", g.Path).Error("cannot check how much space is available")
+		return
+	}
+	log.WithField("bvail", bvail).WithField("minBytesAvail", g.MinBytesAvail).Debug("checked for available disk space")
+	g.metrics.availableBytes.WithLabelValues(g.Path).Set(float64(bvail))
+
+	addLabel := bvail <= g.MinBytesAvail
+	err = g.setLabel(LabelDiskPressure, addLabel)
+	if err != nil {
+		log.WithError(err).Error("cannot update node label")
+	}
+
+	g.escalate(ctx, bvail)
+}
+
+// escalate takes all steps whose threshold the available space has fallen to. Steps are expected
+// to be sorted by their threshold in descending order, so that we take the least invasive steps first.
+// After each step we check the available space again, and stop once it has recovered.
+func (g *Guard) escalate(ctx context.Context, bvail uint64) {
+	for _, step := range g.Steps {
+		if bvail > step.MinBytesAvail {
 			continue
 		}
-		log.WithField("bvail", bvail).WithField("minBytesAvail", g.MinBytesAvail).Debug("checked for available disk space")
 
-		addLabel := bvail <= g.MinBytesAvail
-		err = g.setLabel(LabelDiskPressure, addLabel)
+		stepLog := log.WithField("path", g.Path).WithField("step", step.Name).WithField("bvail", bvail).WithField("minBytesAvail", step.MinBytesAvail)
+		stepLog.Info("disk space is low - taking escalation step")
+
+		freed, err := step.Action.Run(ctx)
 		if err != nil {
-			log.WithError(err).Error("cannot update node label")
+			stepLog.WithError(err).Error("disk space escalation step failed")
+			g.metrics.stepsTotal.WithLabelValues(g.Path, step.Name, "failure").Inc()
+		} else {
+			stepLog.WithField("freed", freed).Info("disk space escalation step done")
+			g.metrics.stepsTotal.WithLabelValues(g.Path, step.Name, "success").Inc()
 		}
+		g.metrics.freedBytesTotal.WithLabelValues(g.Path, step.Name).Add(float64(freed))
 
-		<-t.C
+		bvail, err = g.availableBytes(g.Path)
+		if err != nil {
+			log.WithError(err).WithField("path", g.Path).Error("cannot check how much space is available")
+			return
+		}
+		g.metrics.availableBytes.WithLabelValues(g.Path).Set(float64(bvail))
 	}
 }
 
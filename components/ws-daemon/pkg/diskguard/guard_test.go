@@ -0,0 +1,267 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+func TestEscalate(t *testing.T) {
+	tests := []struct {
+		Name string
+		// Avail is the available space before the escalation
+		Avail uint64
+		// Freed is the space each step frees
+		Freed       map[string]uint64
+		Expectation []string
+	}{
+		{
+			Name:        "enough space",
+			Avail:       200,
+			Expectation: nil,
+		},
+		{
+			Name:        "first step suffices",
+			Avail:       90,
+			Freed:       map[string]uint64{"cleanup": 50},
+			Expectation: []string{"cleanup"},
+		},
+		{
+			Name:        "escalates until space recovers",
+			Avail:       40,
+			Freed:       map[string]uint64{"cleanup": 5, "prune": 20},
+			Expectation: []string{"cleanup", "prune"},
+		},
+		{
+			Name:        "last resort",
+			Avail:       5,
+			Expectation: []string{"cleanup", "prune", "notify", "stop"},
+		},
+		{
+			Name:        "skips steps above their threshold",
+			Avail:       30,
+			Freed:       map[string]uint64{"cleanup": 0, "prune": 0, "notify": 0},
+			Expectation: []string{"cleanup", "prune", "notify"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				avail = test.Avail
+				act   []string
+			)
+			step := func(name string, threshold uint64) Step {
+				return Step{
+					Name:          name,
+					MinBytesAvail: threshold,
+					Action: ActionFunc(func(ctx context.Context) (uint64, error) {
+						act = append(act, name)
+						avail += test.Freed[name]
+						return test.Freed[name], nil
+					}),
+				}
+			}
+			g := &Guard{
+				Path: "/test",
+				Steps: []Step{
+					step("cleanup", 100),
+					step("prune", 50),
+					step("notify", 30),
+					step("stop", 10),
+				},
+				metrics:        newMetrics(),
+				availableBytes: func(string) (uint64, error) { return avail, nil },
+			}
+
+			g.escalate(context.Background(), avail)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected steps (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStaleContentCleanup(t *testing.T) {
+	const (
+		stale    = "00000000-0000-0000-0000-000000000001"
+		running  = "00000000-0000-0000-0000-000000000002"
+		recent   = "00000000-0000-0000-0000-000000000003"
+		backedUp = "00000000-0000-0000-0000-000000000004"
+	)
+	loc := t.TempDir()
+	old := time.Now().Add(-1 * time.Hour)
+	for _, fn := range []string{stale, stale + "-daemon", running, recent, backedUp, "lost+found"} {
+		err := os.MkdirAll(filepath.Join(loc, fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(loc, fn, "content"), []byte("hello world"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, fn := range []string{stale + ".workspace.json", running + ".workspace.json", backedUp + ".workspace.json"} {
+		err := os.WriteFile(filepath.Join(loc, fn), []byte("{}"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, fn := range []string{stale, stale + "-daemon", stale + ".workspace.json", running, running + ".workspace.json", backedUp, backedUp + ".workspace.json", "lost+found"} {
+		err := os.Chtimes(filepath.Join(loc, fn), old, old)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := &StaleContentCleanup{
+		Location:    loc,
+		MinAge:      10 * time.Minute,
+		GracePeriod: 1 * time.Hour,
+		InstanceState: func(ctx context.Context, instanceID string) (InstanceState, error) {
+			switch instanceID {
+			case running:
+				return InstanceActive, nil
+			case backedUp:
+				return InstanceBackedUp, nil
+			default:
+				return InstanceGone, nil
+			}
+		},
+	}
+	remaining := func() []string {
+		entries, err := os.ReadDir(loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var act []string
+		for _, e := range entries {
+			act = append(act, e.Name())
+		}
+		return act
+	}
+
+	// content which is backed up is removed right away, content whose resource just disappeared is kept
+	freed, err := c.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if freed != 11+2 {
+		t.Errorf("unexpected freed bytes: %d", freed)
+	}
+	expectation := []string{stale, stale + "-daemon", stale + ".workspace.json", running, running + ".workspace.json", recent, "lost+found"}
+	if diff := cmp.Diff(expectation, remaining()); diff != "" {
+		t.Errorf("unexpected remaining content (-want +got):\n%s", diff)
+	}
+
+	// once the resource has been gone for the grace period, its content is removed too
+	c.gone[stale] = time.Now().Add(-2 * time.Hour)
+	freed, err = c.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if freed != 2*11+2 {
+		t.Errorf("unexpected freed bytes: %d", freed)
+	}
+	expectation = []string{running, running + ".workspace.json", recent, "lost+found"}
+	if diff := cmp.Diff(expectation, remaining()); diff != "" {
+		t.Errorf("unexpected remaining content (-want +got):\n%s", diff)
+	}
+}
+
+func TestHeaviestFromXFSProjects(t *testing.T) {
+	var walked []string
+	wss := &Workspaces{
+		workspaces: map[string]*workspace{
+			"small":      {OWI: logrus.Fields{}},
+			"large":      {OWI: logrus.Fields{}},
+			"no-project": {OWI: logrus.Fields{}},
+		},
+		usage: func(instanceID string) (uint64, error) {
+			walked = append(walked, instanceID)
+			return 5, nil
+		},
+		projectUsage: func() (map[int]uint64, error) {
+			return map[int]uint64{0: 100, 1000: 1, 1001: 10}, nil
+		},
+		projectID: func(instanceID string) int {
+			return map[string]int{"small": 1000, "large": 1001}[instanceID]
+		},
+	}
+
+	act := wss.Heaviest(3)
+	expectation := []WorkspaceUsage{{InstanceID: "large", Bytes: 10}, {InstanceID: "no-project", Bytes: 5}, {InstanceID: "small", Bytes: 1}}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected heaviest workspaces (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"no-project"}, walked); diff != "" {
+		t.Errorf("unexpected walked workspaces (-want +got):\n%s", diff)
+	}
+	if prj := wss.workspaces["large"].XFSProjectID; prj != 1001 {
+		t.Errorf("XFS project was not cached: %d", prj)
+	}
+}
+
+func TestWorkspaceStopper(t *testing.T) {
+	wss := &Workspaces{
+		workspaces: map[string]*workspace{
+			"small":  {OWI: logrus.Fields{}},
+			"large":  {OWI: logrus.Fields{}},
+			"medium": {OWI: logrus.Fields{}},
+		},
+		usage: func(instanceID string) (uint64, error) {
+			return map[string]uint64{"small": 1, "medium": 5, "large": 10}[instanceID], nil
+		},
+	}
+
+	var stopped []string
+	s := &WorkspaceStopper{
+		Workspaces: wss,
+		Count:      2,
+		Stop: func(ctx context.Context, instanceID string, gracePeriod time.Duration) error {
+			stopped = append(stopped, instanceID)
+			return nil
+		},
+		affected: prometheus.NewCounter(prometheus.CounterOpts{Name: "test"}),
+	}
+
+	freed, err := s.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"large", "medium"}, stopped); diff != "" {
+		t.Errorf("unexpected stopped workspaces (-want +got):\n%s", diff)
+	}
+	if freed != 15 {
+		t.Errorf("unexpected freed bytes: %d", freed)
+	}
+
+	// we don't stop more workspaces while the stopped ones are still around
+	_, err = s.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stopped) != 2 {
+		t.Errorf("stopped workspaces while others were still stopping: %v", stopped)
+	}
+
+	delete(wss.workspaces, "large")
+	delete(wss.workspaces, "medium")
+	_, err = s.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"large", "medium", "small"}, stopped); diff != "" {
+		t.Errorf("unexpected stopped workspaces (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,398 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const defaultSupervisorPort = 22999
+
+// NewWorkspaces creates a new workspace tracker. If xfs is not nil, the disk usage of workspaces
+// with a storage quota is taken from the XFS project accounting instead of walking their content.
+func NewWorkspaces(workingArea string, supervisorPort int, xfs *quota.XFS) *Workspaces {
+	if supervisorPort <= 0 {
+		supervisorPort = defaultSupervisorPort
+	}
+
+	res := &Workspaces{
+		WorkingArea:    workingArea,
+		SupervisorPort: supervisorPort,
+		workspaces:     make(map[string]*workspace),
+		usage: func(instanceID string) (uint64, error) {
+			return diskUsage(filepath.Join(workingArea, instanceID))
+		},
+		projectID: func(instanceID string) int {
+			ws, err := session.LoadWorkspace(context.Background(), filepath.Join(workingArea, instanceID+".workspace.json"))
+			if err != nil {
+				return 0
+			}
+			return ws.XFSProjectID
+		},
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+	if xfs != nil {
+		res.projectUsage = func() (map[int]uint64, error) {
+			usage, err := xfs.ProjectUsage()
+			if err != nil {
+				return nil, err
+			}
+			projects := make(map[int]uint64, len(usage))
+			for prj, size := range usage {
+				projects[prj] = uint64(size)
+			}
+			return projects, nil
+		}
+	}
+	return res
+}
+
+// Workspaces keeps track of the workspaces on this node, so that guards can find the ones using the most space.
+type Workspaces struct {
+	WorkingArea    string
+	SupervisorPort int
+
+	workspaces map[string]*workspace
+	mu         sync.RWMutex
+
+	// usage walks the content of a workspace, which is expensive. We only do that for workspaces without an XFS project.
+	usage func(instanceID string) (uint64, error)
+	// projectUsage returns the space used by all XFS projects, nil if there's no XFS quota support
+	projectUsage func() (map[int]uint64, error)
+	// projectID returns the XFS project of a workspace, or 0 if it has none (yet)
+	projectID func(instanceID string) int
+	client    *http.Client
+}
+
+type workspace struct {
+	PodIP string
+	OWI   logrus.Fields
+
+	// XFSProjectID caches the XFS project of the workspace once it has one
+	XFSProjectID int
+}
+
+// WorkspaceUsage is the disk space used by a workspace
+type WorkspaceUsage struct {
+	InstanceID string
+	Bytes      uint64
+}
+
+// WorkspaceAdded starts tracking a workspace
+func (w *Workspaces) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.workspaces[ws.InstanceID] = &workspace{
+		PodIP: ws.Pod.Status.PodIP,
+		OWI:   ws.OWI(),
+	}
+	go func() {
+		<-ctx.Done()
+
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.workspaces, ws.InstanceID)
+	}()
+
+	return nil
+}
+
+// WorkspaceUpdated updates the address of a workspace
+func (w *Workspaces) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wsinfo, ok := w.workspaces[ws.InstanceID]
+	if !ok {
+		return xerrors.Errorf("received update for a workspace we haven't seen before: %s", ws.InstanceID)
+	}
+
+	wsinfo.PodIP = ws.Pod.Status.PodIP
+	return nil
+}
+
+// Exists returns true if the workspace is still on this node
+func (w *Workspaces) Exists(instanceID string) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	_, ok := w.workspaces[instanceID]
+	return ok
+}
+
+// Heaviest returns up to n workspaces using the most disk space, heaviest first
+func (w *Workspaces) Heaviest(n int) []WorkspaceUsage {
+	w.mu.RLock()
+	ids := make([]string, 0, len(w.workspaces))
+	for id := range w.workspaces {
+		ids = append(ids, id)
+	}
+	w.mu.RUnlock()
+
+	var projects map[int]uint64
+	if w.projectUsage != nil {
+		var err error
+		projects, err = w.projectUsage()
+		if err != nil {
+			log.WithError(err).Warn("cannot get the disk usage of XFS projects - computing it from the workspace content")
+		}
+	}
+
+	// computing the usage may walk the workspace content, hence we do that without holding the lock
+	res := make([]WorkspaceUsage, 0, len(ids))
+	for _, id := range ids {
+		var (
+			usage uint64
+			ok    bool
+		)
+		if projects != nil {
+			// project 0 accounts for everything which is not part of another project
+			if prj := w.xfsProjectID(id); prj != 0 {
+				usage, ok = projects[prj]
+			}
+		}
+		if !ok {
+			var err error
+			usage, err = w.usage(id)
+			if err != nil {
+				log.WithError(err).WithField("instanceId", id).Debug("cannot compute workspace disk usage")
+				continue
+			}
+		}
+		res = append(res, WorkspaceUsage{InstanceID: id, Bytes: usage})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Bytes == res[j].Bytes {
+			return res[i].InstanceID < res[j].InstanceID
+		}
+		return res[i].Bytes > res[j].Bytes
+	})
+	if len(res) > n {
+		res = res[:n]
+	}
+	return res
+}
+
+// xfsProjectID returns the XFS project of a workspace, or 0 if it has none
+func (w *Workspaces) xfsProjectID(instanceID string) int {
+	w.mu.RLock()
+	ws, ok := w.workspaces[instanceID]
+	var prj int
+	if ok {
+		prj = ws.XFSProjectID
+	}
+	w.mu.RUnlock()
+	if !ok || prj != 0 || w.projectID == nil {
+		return prj
+	}
+
+	// the project is set up when the workspace content is initialized, i.e. after we've started tracking the workspace
+	prj = w.projectID(instanceID)
+	if prj != 0 {
+		w.mu.Lock()
+		if ws, ok := w.workspaces[instanceID]; ok {
+			ws.XFSProjectID = prj
+		}
+		w.mu.Unlock()
+	}
+	return prj
+}
+
+func (w *Workspaces) owi(instanceID string) logrus.Fields {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if ws, ok := w.workspaces[instanceID]; ok {
+		return ws.OWI
+	}
+	return log.OWI("", "", instanceID)
+}
+
+// notify shows a warning to the user of the workspace using the supervisor notification API
+func (w *Workspaces) notify(ctx context.Context, instanceID, message string) error {
+	w.mu.RLock()
+	ws, ok := w.workspaces[instanceID]
+	var podIP string
+	if ok {
+		podIP = ws.PodIP
+	}
+	w.mu.RUnlock()
+	if podIP == "" {
+		return xerrors.Errorf("workspace %s has no address", instanceID)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"level":   "WARNING",
+		"message": message,
+	})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("http://%s/_supervisor/v1/notification/notify", net.JoinHostPort(podIP, strconv.Itoa(w.SupervisorPort)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return xerrors.Errorf("cannot notify workspace %s: %w", instanceID, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("cannot notify workspace %s: supervisor responded with %d", instanceID, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// WorkspaceNotifier asks the users of the workspaces using the most disk space to free some of it
+type WorkspaceNotifier struct {
+	Workspaces *Workspaces
+	Count      int
+	Interval   time.Duration
+	Message    string
+	Notify     func(ctx context.Context, instanceID, message string) error
+
+	affected prometheus.Counter
+	notified map[string]time.Time
+	mu       sync.Mutex
+}
+
+// Run notifies the heaviest workspaces unless they've been notified recently
+func (n *WorkspaceNotifier) Run(ctx context.Context) (freed uint64, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.notified == nil {
+		n.notified = make(map[string]time.Time)
+	}
+	for id := range n.notified {
+		if !n.Workspaces.Exists(id) {
+			delete(n.notified, id)
+		}
+	}
+
+	var errs []string
+	for _, ws := range n.Workspaces.Heaviest(n.Count) {
+		if t, ok := n.notified[ws.InstanceID]; ok && time.Since(t) < n.Interval {
+			continue
+		}
+
+		err := n.Notify(ctx, ws.InstanceID, n.Message)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		n.notified[ws.InstanceID] = time.Now()
+		if n.affected != nil {
+			n.affected.Inc()
+		}
+		log.WithFields(n.Workspaces.owi(ws.InstanceID)).WithField("usage", ws.Bytes).Info("asked workspace to free disk space")
+	}
+	if len(errs) > 0 {
+		return 0, xerrors.Errorf("cannot notify all workspaces: %s", strings.Join(errs, "; "))
+	}
+
+	// notifications don't free space themselves
+	return 0, nil
+}
+
+// WorkspaceStopper gracefully stops the workspaces using the most disk space. Stopping a workspace
+// backs up its content, which frees the space only once the backup is done. We don't stop more
+// workspaces while the ones we stopped before are still on this node.
+type WorkspaceStopper struct {
+	Workspaces  *Workspaces
+	Count       int
+	GracePeriod time.Duration
+	Stop        func(ctx context.Context, instanceID string, gracePeriod time.Duration) error
+
+	affected prometheus.Counter
+	stopping map[string]struct{}
+	mu       sync.Mutex
+}
+
+// Run stops the heaviest workspaces
+func (s *WorkspaceStopper) Run(ctx context.Context) (freed uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopping == nil {
+		s.stopping = make(map[string]struct{})
+	}
+	for id := range s.stopping {
+		if !s.Workspaces.Exists(id) {
+			delete(s.stopping, id)
+		}
+	}
+	if len(s.stopping) > 0 {
+		log.WithField("stopping", len(s.stopping)).Debug("waiting for stopped workspaces to go away before stopping more")
+		return 0, nil
+	}
+
+	var errs []string
+	for _, ws := range s.Workspaces.Heaviest(s.Count) {
+		err := s.Stop(ctx, ws.InstanceID, s.GracePeriod)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		s.stopping[ws.InstanceID] = struct{}{}
+		if s.affected != nil {
+			s.affected.Inc()
+		}
+		log.WithFields(s.Workspaces.owi(ws.InstanceID)).WithField("usage", ws.Bytes).Warn("stopping workspace to free disk space")
+
+		// the space is freed once the workspace is backed up and its content removed
+		freed += ws.Bytes
+	}
+	if len(errs) > 0 {
+		return freed, xerrors.Errorf("cannot stop all workspaces: %s", strings.Join(errs, "; "))
+	}
+
+	return freed, nil
+}
+
+// stopWorkspace stops a workspace the same way a StopWorkspace request does
+func (env *Environment) stopWorkspace(ctx context.Context, instanceID string, gracePeriod time.Duration) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var ws workspacev1.Workspace
+		err := env.Client.Get(ctx, types.NamespacedName{Namespace: env.Namespace, Name: instanceID}, &ws)
+		if err != nil {
+			return err
+		}
+		if ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) {
+			return nil
+		}
+
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionStoppedByRequest(gracePeriod.String()))
+		return env.Client.Status().Update(ctx, &ws)
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package limiter
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Workspace is what limiters know about the workspace they decide a limit for
+type Workspace interface {
+	WorkspaceID() string
+	WorkspaceClass() string
+	WorkspaceAnnotations() map[string]string
+}
+
+// ResourceLimiter implements a strategy to limit the use of a resource (e.g. CPU or memory) by a workspace
+type ResourceLimiter[W Workspace, L any] interface {
+	Limit(ws W) (L, error)
+}
+
+var _ ResourceLimiter[Workspace, int] = (*fixedLimiter[Workspace, int])(nil)
+var _ ResourceLimiter[Workspace, int] = (*annotationLimiter[Workspace, int])(nil)
+var _ ResourceLimiter[Workspace, int] = (*classLimiter[Workspace, int])(nil)
+var _ ResourceLimiter[Workspace, int] = (*compositeLimiter[Workspace, int])(nil)
+
+// Fixed returns a fixed limit
+func Fixed[W Workspace, L any](limit L) ResourceLimiter[W, L] {
+	return fixedLimiter[W, L]{limit}
+}
+
+type fixedLimiter[W Workspace, L any] struct {
+	FixedLimit L
+}
+
+func (f fixedLimiter[W, L]) Limit(ws W) (L, error) {
+	return f.FixedLimit, nil
+}
+
+// Annotation returns the limit found in an annotation of the workspace. fromQuantity converts the annotation value to a limit.
+func Annotation[W Workspace, L any](annotation string, fromQuantity func(resource.Quantity) L) ResourceLimiter[W, L] {
+	return annotationLimiter[W, L]{
+		Annotation:   annotation,
+		FromQuantity: fromQuantity,
+	}
+}
+
+type annotationLimiter[W Workspace, L any] struct {
+	Annotation   string
+	FromQuantity func(resource.Quantity) L
+}
+
+func (a annotationLimiter[W, L]) Limit(ws W) (res L, err error) {
+	value, ok := ws.WorkspaceAnnotations()[a.Annotation]
+	if !ok {
+		return res, xerrors.Errorf("no annotation named %s found on workspace %s", a.Annotation, ws.WorkspaceID())
+	}
+
+	limit, err := resource.ParseQuantity(value)
+	if err != nil {
+		return res, xerrors.Errorf("failed to parse %s for workspace %s", value, ws.WorkspaceID())
+	}
+
+	return a.FromQuantity(limit), nil
+}
+
+// Class returns the limit of the workspace's class
+func Class[W Workspace, L any](limits map[string]L) ResourceLimiter[W, L] {
+	return classLimiter[W, L]{
+		Limits: limits,
+	}
+}
+
+type classLimiter[W Workspace, L any] struct {
+	Limits map[string]L
+}
+
+func (c classLimiter[W, L]) Limit(ws W) (L, error) {
+	limit, ok := c.Limits[ws.WorkspaceClass()]
+	if !ok {
+		return limit, xerrors.Errorf("no limit for class %s of workspace %s", ws.WorkspaceClass(), ws.WorkspaceID())
+	}
+
+	return limit, nil
+}
+
+// Composite returns the limit of the first limiter which is able to provide one
+func Composite[W Workspace, L any](limiters ...ResourceLimiter[W, L]) ResourceLimiter[W, L] {
+	return &compositeLimiter[W, L]{
+		limiters: limiters,
+	}
+}
+
+type compositeLimiter[W Workspace, L any] struct {
+	limiters []ResourceLimiter[W, L]
+}
+
+func (cl *compositeLimiter[W, L]) Limit(ws W) (res L, err error) {
+	var errs []error
+	for _, limiter := range cl.limiters {
+		limit, err := limiter.Limit(ws)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		return limit, nil
+	}
+
+	allerr := make([]string, len(errs))
+	for i, err := range errs {
+		allerr[i] = err.Error()
+	}
+	return res, xerrors.Errorf("no limiter was able to provide a limit: %s", strings.Join(allerr, ", "))
+}
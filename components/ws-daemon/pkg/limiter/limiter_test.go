@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package limiter_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/limiter"
+)
+
+type testWorkspace struct {
+	Class       string
+	Annotations map[string]string
+}
+
+func (w testWorkspace) WorkspaceID() string                     { return "ws" }
+func (w testWorkspace) WorkspaceClass() string                  { return w.Class }
+func (w testWorkspace) WorkspaceAnnotations() map[string]string { return w.Annotations }
+
+func TestCompositeLimiter(t *testing.T) {
+	milliValue := func(q resource.Quantity) int64 { return q.MilliValue() }
+	l := limiter.Composite(
+		limiter.Annotation[testWorkspace]("limit", milliValue),
+		limiter.Class[testWorkspace](map[string]int64{"large": 4000}),
+		limiter.Fixed[testWorkspace](int64(1000)),
+	)
+
+	tests := []struct {
+		Desc        string
+		Workspace   testWorkspace
+		Expectation int64
+	}{
+		{"annotation", testWorkspace{Class: "large", Annotations: map[string]string{"limit": "2"}}, 2000},
+		{"invalid annotation", testWorkspace{Class: "large", Annotations: map[string]string{"limit": "foo"}}, 4000},
+		{"class", testWorkspace{Class: "large"}, 4000},
+		{"fixed", testWorkspace{Class: "small"}, 1000},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			limit, err := l.Limit(test.Workspace)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if limit != test.Expectation {
+				t.Errorf("unexpected limit %d: expected %d", limit, test.Expectation)
+			}
+		})
+	}
+
+	_, err := limiter.Composite(limiter.Class[testWorkspace](map[string]int64{})).Limit(testWorkspace{})
+	if err == nil {
+		t.Error("expected an error if no limiter provides a limit")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
+	cgroupsv2 "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+)
+
+type CgroupV2MemoryController string
+
+func (basePath CgroupV2MemoryController) Usage() (Memory, error) {
+	usage, err := cgroupsv2.NewMemoryController(string(basePath)).Current()
+	if err != nil {
+		return 0, err
+	}
+
+	return Memory(usage), nil
+}
+
+func (basePath CgroupV2MemoryController) Pressure() (time.Duration, error) {
+	psi, err := cgroupsv2.NewMemoryController(string(basePath)).PSI()
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(psi.Some) * time.Microsecond, nil
+}
+
+func (basePath CgroupV2MemoryController) SetLimits(high, low Memory) (changed bool, err error) {
+	highChanged, err := basePath.writeIfChanged("memory.high", high)
+	if err != nil {
+		return false, err
+	}
+	lowChanged, err := basePath.writeIfChanged("memory.low", low)
+	if err != nil {
+		return false, err
+	}
+
+	return highChanged || lowChanged, nil
+}
+
+func (basePath CgroupV2MemoryController) writeIfChanged(file string, value Memory) (changed bool, err error) {
+	fn := filepath.Join(string(basePath), file)
+	current, err := cgroups.ReadSingleValue(fn)
+	if err != nil {
+		return false, xerrors.Errorf("cannot read %s: %w", file, err)
+	}
+	if current == uint64(value) {
+		return false, nil
+	}
+
+	err = os.WriteFile(fn, []byte(strconv.FormatUint(uint64(value), 10)), 0644)
+	if err != nil {
+		return false, xerrors.Errorf("cannot set %s to %d: %w", file, value, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+)
+
+const defaultControlPeriod = 15 * time.Second
+
+// Config configures the memory distributor
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// TotalMemory is the memory available to all workspaces on the node. Zero means unlimited.
+	TotalMemory resource.Quantity `json:"totalMemory"`
+	// Limit is the memory.high workspaces start with and return to when they do not need more memory
+	Limit resource.Quantity `json:"limit"`
+	// BurstLimit is the highest memory.high workspaces under memory pressure can receive
+	BurstLimit resource.Quantity `json:"burstLimit"`
+	// Classes overrides the limits and configures the protected minimums per workspace class
+	Classes map[string]ClassConfig `json:"classes,omitempty"`
+
+	// PressureThreshold is the share of time workspaces must stall on memory to receive more memory. Defaults to 0.1.
+	PressureThreshold float64 `json:"pressureThreshold,omitempty"`
+	// Step is the amount by which memory.high changes per control period. Defaults to 256Mi.
+	Step resource.Quantity `json:"step,omitempty"`
+
+	// ControlPeriod is the interval at which memory is distributed. Defaults to 15 seconds.
+	ControlPeriod  util.Duration `json:"controlPeriod"`
+	CGroupBasePath string        `json:"cgroupBasePath"`
+}
+
+// ClassConfig configures the memory limits of a workspace class
+type ClassConfig struct {
+	Limit      resource.Quantity `json:"limit"`
+	BurstLimit resource.Quantity `json:"burstLimit"`
+	// Low is the memory protected from reclaim (memory.low) of workspaces of this class
+	Low resource.Quantity `json:"low"`
+}
+
+// NewDispatchListener creates a new memory distributor dispatch listener
+func NewDispatchListener(cfg *Config, prom prometheus.Registerer) *DispatchListener {
+	d := &DispatchListener{
+		Prometheus: prom,
+		Config:     cfg,
+		workspaces: make(map[string]*workspace),
+
+		workspacesAddedCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "memlimit_workspaces_added_total",
+			Help: "Number of workspaces added to memory control",
+		}, []string{"class"}),
+		workspacesRemovedCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "memlimit_workspaces_removed_total",
+			Help: "Number of workspaces removed from memory control",
+		}, []string{"class"}),
+		workspacesPressuredCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "memlimit_workspaces_pressured_total",
+			Help: "Number of times workspaces were under memory pressure",
+		}, []string{"class"}),
+		memoryDistributedGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "memlimit_distributed_bytes",
+			Help: "Sum of memory.high of all workspaces under memory control",
+		}),
+		memoryProtectedGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "memlimit_protected_bytes",
+			Help: "Sum of memory.low of all workspaces under memory control",
+		}),
+	}
+
+	if cfg.Enabled {
+		limits, burstLimits, lows := make(map[string]Memory), make(map[string]Memory), make(map[string]Memory)
+		for class, c := range cfg.Classes {
+			if l := MemoryFromQuantity(c.Limit); l > 0 {
+				limits[class] = l
+			}
+			if l := MemoryFromQuantity(c.BurstLimit); l > 0 {
+				burstLimits[class] = l
+			}
+			lows[class] = MemoryFromQuantity(c.Low)
+		}
+
+		dist := NewDistributor(d.source, d.sink,
+			CompositeLimiter(ClassLimiter(limits), FixedLimiter(MemoryFromQuantity(cfg.Limit))),
+			CompositeLimiter(ClassLimiter(burstLimits), FixedLimiter(MemoryFromQuantity(cfg.BurstLimit))),
+			CompositeLimiter(ClassLimiter(lows), FixedLimiter(0)),
+			MemoryFromQuantity(cfg.TotalMemory),
+		)
+		if cfg.PressureThreshold > 0 {
+			dist.PressureThreshold = cfg.PressureThreshold
+		}
+		if step := MemoryFromQuantity(cfg.Step); step > 0 {
+			dist.Step = step
+		}
+		dist.Log = log.WithField("component", "memlimit")
+		d.pressureThreshold = dist.PressureThreshold
+		period := time.Duration(cfg.ControlPeriod)
+		if period <= 0 {
+			period = defaultControlPeriod
+		}
+		go dist.Run(context.Background(), period, d.observe)
+	}
+
+	prom.MustRegister(
+		d.workspacesAddedCounterVec,
+		d.workspacesRemovedCounterVec,
+		d.workspacesPressuredCounterVec,
+		d.memoryDistributedGauge,
+		d.memoryProtectedGauge,
+	)
+
+	return d
+}
+
+// DispatchListener adds workspaces to the memory distributor using the workspace dispatch
+type DispatchListener struct {
+	Prometheus prometheus.Registerer
+	Config     *Config
+
+	workspaces map[string]*workspace
+	mu         sync.RWMutex
+
+	pressureThreshold float64
+	lastTick          DistributorDebug
+
+	workspacesAddedCounterVec     *prometheus.CounterVec
+	workspacesRemovedCounterVec   *prometheus.CounterVec
+	workspacesPressuredCounterVec *prometheus.CounterVec
+	memoryDistributedGauge        prometheus.Gauge
+	memoryProtectedGauge          prometheus.Gauge
+}
+
+type workspace struct {
+	Memory      MemoryController
+	OWI         logrus.Fields
+	Class       string
+	Annotations map[string]string
+}
+
+func (d *DispatchListener) source(context.Context) ([]Workspace, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	res := make([]Workspace, 0, len(d.workspaces))
+	for id, w := range d.workspaces {
+		usage, err := w.Memory.Usage()
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				log.WithFields(w.OWI).WithError(err).Warn("cannot read memory usage")
+			}
+
+			continue
+		}
+
+		pressure, err := w.Memory.Pressure()
+		if err != nil {
+			log.WithFields(w.OWI).WithError(err).Warn("cannot read memory pressure")
+			// we don't continue here, because worst case the workspace won't receive more memory,
+			// but at least we'll keep maintaining its limits.
+		}
+
+		res = append(res, Workspace{
+			ID:          id,
+			Usage:       usage,
+			Pressure:    pressure,
+			Class:       w.Class,
+			Annotations: w.Annotations,
+		})
+	}
+	return res, nil
+}
+
+func (d *DispatchListener) sink(id string, high, low Memory) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	ws, ok := d.workspaces[id]
+	if !ok {
+		// this can happen if the workspace has gone away inbetween a distributor cycle
+		return
+	}
+
+	changed, err := ws.Memory.SetLimits(high, low)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.WithError(err).WithFields(ws.OWI).Warn("cannot set memory limits")
+	}
+	if changed {
+		log.WithFields(ws.OWI).WithField("high", high).WithField("low", low).Debug("applied new memory limits")
+	}
+}
+
+func (d *DispatchListener) observe(dbg DistributorDebug) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.lastTick = dbg
+	d.memoryDistributedGauge.Set(float64(dbg.MemoryDistributed))
+	d.memoryProtectedGauge.Set(float64(dbg.MemoryProtected))
+	for _, ws := range dbg.Workspaces {
+		if ws.Pressure < d.pressureThreshold {
+			continue
+		}
+		if w, ok := d.workspaces[ws.ID]; ok {
+			d.workspacesPressuredCounterVec.WithLabelValues(w.Class).Inc()
+		}
+	}
+}
+
+// ServeHTTP serves the state of the last distributor tick
+func (d *DispatchListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mu.RLock()
+	dbg := d.lastTick
+	d.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(dbg)
+	if err != nil {
+		log.WithError(err).Warn("cannot serve memory distributor state")
+	}
+}
+
+// WorkspaceAdded adds a workspace to the memory distributor
+func (d *DispatchListener) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return xerrors.Errorf("no dispatch available")
+	}
+
+	cgroupPath, err := disp.Runtime.ContainerCGroupPath(context.Background(), ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot start memory distribution: %w", err)
+	}
+
+	controller, err := newMemoryController(d.Config.CGroupBasePath, cgroupPath)
+	if err != nil {
+		return xerrors.Errorf("cannot start memory controller: %w", err)
+	}
+
+	class := ws.Pod.Annotations[kubernetes.WorkspaceClassAnnotation]
+	d.workspaces[ws.InstanceID] = &workspace{
+		Memory:      controller,
+		OWI:         ws.OWI(),
+		Class:       class,
+		Annotations: ws.Pod.Annotations,
+	}
+	go func() {
+		<-ctx.Done()
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		delete(d.workspaces, ws.InstanceID)
+		d.workspacesRemovedCounterVec.WithLabelValues(class).Inc()
+	}()
+
+	d.workspacesAddedCounterVec.WithLabelValues(class).Inc()
+
+	return nil
+}
+
+// WorkspaceUpdated gets called when a workspace is updated
+func (d *DispatchListener) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	wsinfo, ok := d.workspaces[ws.InstanceID]
+	if !ok {
+		return xerrors.Errorf("received update for a workspace we haven't seen before: %s", ws.InstanceID)
+	}
+
+	wsinfo.Annotations = ws.Pod.Annotations
+	return nil
+}
+
+func newMemoryController(basePath, cgroupPath string) (MemoryController, error) {
+	unified, err := cgroups.IsUnifiedCgroupSetup()
+	if err != nil {
+		return nil, xerrors.Errorf("could not determine cgroup setup: %w", err)
+	}
+	if !unified {
+		return nil, xerrors.Errorf("memory distribution requires cgroup v2")
+	}
+
+	return CgroupV2MemoryController(filepath.Join(basePath, cgroupPath)), nil
+}
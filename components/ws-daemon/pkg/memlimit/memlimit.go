@@ -0,0 +1,327 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/limiter"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Memory is an amount of memory in bytes
+type Memory uint64
+
+// MemoryFromQuantity converts a quantity to memory
+func MemoryFromQuantity(v resource.Quantity) Memory {
+	if v.Sign() <= 0 {
+		return 0
+	}
+	return Memory(v.Value())
+}
+
+type Workspace struct {
+	ID string
+
+	// Usage is the memory currently used by the workspace
+	Usage Memory
+	// Pressure is the total time tasks of the workspace stalled on memory
+	Pressure    time.Duration
+	Class       string
+	Annotations map[string]string
+}
+
+type WorkspaceHistory struct {
+	ID string
+
+	LastUpdate  *Workspace
+	PressureLag time.Duration
+	High        Memory
+}
+
+func (h *WorkspaceHistory) Update(w Workspace) {
+	if h.LastUpdate == nil {
+		h.PressureLag = w.Pressure
+	} else {
+		h.PressureLag = h.LastUpdate.Pressure
+	}
+	h.LastUpdate = &w
+}
+
+// WorkspaceID implements limiter.Workspace
+func (h *WorkspaceHistory) WorkspaceID() string {
+	return h.ID
+}
+
+// WorkspaceClass implements limiter.Workspace
+func (h *WorkspaceHistory) WorkspaceClass() string {
+	if h.LastUpdate == nil {
+		return ""
+	}
+	return h.LastUpdate.Class
+}
+
+// WorkspaceAnnotations implements limiter.Workspace
+func (h *WorkspaceHistory) WorkspaceAnnotations() map[string]string {
+	if h.LastUpdate == nil {
+		return nil
+	}
+	return h.LastUpdate.Annotations
+}
+
+// Pressure returns the share of dt the workspace stalled on memory since the last update
+func (h *WorkspaceHistory) Pressure(dt time.Duration) float64 {
+	if h == nil || h.LastUpdate == nil || dt <= 0 || h.LastUpdate.Pressure < h.PressureLag {
+		return 0
+	}
+	return float64(h.LastUpdate.Pressure-h.PressureLag) / float64(dt)
+}
+
+type DistributorSource func(context.Context) ([]Workspace, error)
+type DistributorSink func(id string, high, low Memory)
+
+func NewDistributor(source DistributorSource, sink DistributorSink, limiter ResourceLimiter, burstLimiter ResourceLimiter, protection ResourceLimiter, totalMemory Memory) *Distributor {
+	return &Distributor{
+		Source:            source,
+		Sink:              sink,
+		Limiter:           limiter,
+		BurstLimiter:      burstLimiter,
+		Protection:        protection,
+		TotalMemory:       totalMemory,
+		PressureThreshold: DefaultPressureThreshold,
+		Step:              DefaultStep,
+		History:           make(map[string]*WorkspaceHistory),
+	}
+}
+
+const (
+	// DefaultPressureThreshold is the share of time a workspace must stall on memory to receive more memory
+	DefaultPressureThreshold = 0.1
+	// DefaultStep is the amount by which memory.high is raised or lowered per tick
+	DefaultStep Memory = 256 * 1024 * 1024
+)
+
+// Distributor distributes memory among workspaces by adjusting their memory.high. Workspaces which
+// stall on memory receive more memory up to their burst limit, and give it back once they no longer need it.
+type Distributor struct {
+	Source DistributorSource
+	Sink   DistributorSink
+
+	History      map[string]*WorkspaceHistory
+	Limiter      ResourceLimiter
+	BurstLimiter ResourceLimiter
+	Protection   ResourceLimiter
+
+	// TotalMemory is the total memory available to workspaces on this node
+	TotalMemory Memory
+	// PressureThreshold is the share of time above which a workspace is considered under memory pressure
+	PressureThreshold float64
+	// Step is the amount by which memory.high changes per tick
+	Step Memory
+
+	// Log is used (if not nil) to log out errors. If log is nil, no logging happens.
+	Log *logrus.Entry
+}
+
+type DistributorDebug struct {
+	MemoryAvail, MemoryDistributed, MemoryProtected Memory
+	Workspaces                                      []WorkspaceDebug
+}
+
+type WorkspaceDebug struct {
+	ID        string
+	Usage     Memory
+	Pressure  float64
+	High, Low Memory
+}
+
+// Run starts a ticker which repeatedly calls Tick until the context is canceled.
+// This function does not return until the context is canceled.
+// Each tick's debug information is passed to onTick if it is not nil.
+func (d *Distributor) Run(ctx context.Context, dt time.Duration, onTick func(DistributorDebug)) {
+	t := time.NewTicker(dt)
+	defer t.Stop()
+
+	go func() {
+		for range t.C {
+			dbg, err := d.Tick(dt)
+			if err != nil && d.Log != nil {
+				d.Log.WithError(err).Warn("cannot advance memory limit distributor")
+			}
+			if err == nil && onTick != nil {
+				onTick(dbg)
+			}
+		}
+	}()
+	<-ctx.Done()
+}
+
+// Tick drives the distributor and pushes out new limits.
+// Callers are expected to call this function repeatedly, with dt time inbetween calls.
+func (d *Distributor) Tick(dt time.Duration) (DistributorDebug, error) {
+	ws, err := d.Source(context.Background())
+	if err != nil {
+		return DistributorDebug{}, err
+	}
+
+	f := make(map[string]struct{}, len(ws))
+	for _, w := range ws {
+		h, ok := d.History[w.ID]
+		if !ok {
+			h = &WorkspaceHistory{
+				ID: w.ID,
+			}
+			d.History[w.ID] = h
+		}
+		h.Update(w)
+		f[w.ID] = struct{}{}
+	}
+	for oldWS := range d.History {
+		if _, found := f[oldWS]; !found {
+			delete(d.History, oldWS)
+		}
+	}
+
+	type limits struct {
+		Base, High, Low Memory
+		Pressure        float64
+	}
+	var (
+		total  Memory
+		lims   = make(map[string]*limits, len(d.History))
+		wsList = make([]string, 0, len(d.History))
+		dbg    = DistributorDebug{MemoryAvail: d.TotalMemory}
+	)
+	for id, h := range d.History {
+		base, err := d.Limiter.Limit(h)
+		if err != nil {
+			log.WithError(err).Errorf("unable to apply memory limit")
+			continue
+		}
+		if base == 0 {
+			// without a limit we leave the workspace's memory alone
+			continue
+		}
+		max, err := d.BurstLimiter.Limit(h)
+		if err != nil || max < base {
+			max = base
+		}
+		low, err := d.Protection.Limit(h)
+		if err != nil {
+			low = 0
+		}
+
+		var (
+			pressure = h.Pressure(dt)
+			high     = h.High
+		)
+		if high == 0 {
+			high = base
+		}
+		switch {
+		case pressure >= d.PressureThreshold:
+			high += d.Step
+		case h.LastUpdate.Usage+d.Step < high && high >= base+d.Step:
+			// the workspace is not using the memory it was given - let's take it back
+			high -= d.Step
+		}
+		if high > max {
+			high = max
+		}
+		if high < base {
+			high = base
+		}
+		if low > high {
+			low = high
+		}
+
+		lims[id] = &limits{Base: base, High: high, Low: low, Pressure: pressure}
+		wsList = append(wsList, id)
+		total += high
+	}
+
+	// If we've given out more memory than we have, we reclaim what workspaces received beyond their base limit.
+	// Workspaces with the least pressure give back first: they need the memory the least.
+	sort.Slice(wsList, func(i, j int) bool {
+		pI, pJ := lims[wsList[i]].Pressure, lims[wsList[j]].Pressure
+		if pI == pJ {
+			return wsList[i] < wsList[j]
+		}
+		return pI < pJ
+	})
+	for _, id := range wsList {
+		if d.TotalMemory == 0 || total <= d.TotalMemory {
+			break
+		}
+
+		l := lims[id]
+		excess := total - d.TotalMemory
+		reclaim := l.High - l.Base
+		if reclaim > excess {
+			reclaim = excess
+		}
+		l.High -= reclaim
+		total -= reclaim
+	}
+
+	for _, id := range wsList {
+		h, l := d.History[id], lims[id]
+		h.High = l.High
+		d.Sink(id, l.High, l.Low)
+
+		dbg.MemoryDistributed += l.High
+		dbg.MemoryProtected += l.Low
+		dbg.Workspaces = append(dbg.Workspaces, WorkspaceDebug{
+			ID:       id,
+			Usage:    h.LastUpdate.Usage,
+			Pressure: l.Pressure,
+			High:     l.High,
+			Low:      l.Low,
+		})
+	}
+	sort.Slice(dbg.Workspaces, func(i, j int) bool { return dbg.Workspaces[i].ID < dbg.Workspaces[j].ID })
+
+	return dbg, nil
+}
+
+func (d *Distributor) Reset() {
+	d.History = make(map[string]*WorkspaceHistory)
+}
+
+// ResourceLimiter implements a strategy to limit the memory use of a workspace
+type ResourceLimiter = limiter.ResourceLimiter[*WorkspaceHistory, Memory]
+
+// FixedLimiter returns a fixed limit
+func FixedLimiter(limit Memory) ResourceLimiter {
+	return limiter.Fixed[*WorkspaceHistory](limit)
+}
+
+// AnnotationLimiter returns the limit found in an annotation of the workspace
+func AnnotationLimiter(annotation string) ResourceLimiter {
+	return limiter.Annotation[*WorkspaceHistory](annotation, MemoryFromQuantity)
+}
+
+// ClassLimiter returns the limit of the workspace's class
+func ClassLimiter(limits map[string]Memory) ResourceLimiter {
+	return limiter.Class[*WorkspaceHistory](limits)
+}
+
+// CompositeLimiter returns the limit of the first limiter which is able to provide one
+func CompositeLimiter(limiters ...ResourceLimiter) ResourceLimiter {
+	return limiter.Composite(limiters...)
+}
+
+type MemoryController interface {
+	// Usage returns the memory.current value of the cgroup
+	Usage() (Memory, error)
+	// Pressure returns the total time tasks of the cgroup stalled on memory
+	Pressure() (time.Duration, error)
+	// SetLimits sets memory.high and memory.low of the cgroup
+	SetLimits(high, low Memory) (changed bool, err error)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	gi = memlimit.Memory(1 << 30)
+	dt = 10 * time.Second
+)
+
+type limits struct {
+	High, Low memlimit.Memory
+}
+
+func TestDistributor(t *testing.T) {
+	type tick struct {
+		Workspaces  []memlimit.Workspace
+		Expectation map[string]limits
+	}
+	tests := []struct {
+		Name        string
+		TotalMemory memlimit.Memory
+		Ticks       []tick
+	}{
+		{
+			Name: "pressure raises limit up to burst",
+			Ticks: []tick{
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 2 * gi, Pressure: 0}},
+					Expectation: map[string]limits{"a": {High: 4 * gi}},
+				},
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 4 * gi, Pressure: 5 * time.Second}},
+					Expectation: map[string]limits{"a": {High: 5 * gi}},
+				},
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 5 * gi, Pressure: 10 * time.Second}},
+					Expectation: map[string]limits{"a": {High: 6 * gi}},
+				},
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 6 * gi, Pressure: 15 * time.Second}},
+					Expectation: map[string]limits{"a": {High: 6 * gi}},
+				},
+			},
+		},
+		{
+			Name: "unused memory is returned",
+			Ticks: []tick{
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 4 * gi}},
+					Expectation: map[string]limits{"a": {High: 4 * gi}},
+				},
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 4 * gi, Pressure: 2 * time.Second}},
+					Expectation: map[string]limits{"a": {High: 5 * gi}},
+				},
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 1 * gi, Pressure: 2 * time.Second}},
+					Expectation: map[string]limits{"a": {High: 4 * gi}},
+				},
+				{
+					Workspaces:  []memlimit.Workspace{{ID: "a", Usage: 1 * gi, Pressure: 2 * time.Second}},
+					Expectation: map[string]limits{"a": {High: 4 * gi}},
+				},
+			},
+		},
+		{
+			Name:        "least pressured workspace gives back first",
+			TotalMemory: 13 * gi,
+			Ticks: []tick{
+				{
+					Workspaces: []memlimit.Workspace{
+						{ID: "a", Usage: 4 * gi},
+						{ID: "b", Usage: 4 * gi},
+						{ID: "c", Usage: 1 * gi, Class: "small"},
+					},
+					Expectation: map[string]limits{"a": {High: 4 * gi}, "b": {High: 4 * gi}, "c": {High: 2 * gi, Low: 1 * gi}},
+				},
+				{
+					Workspaces: []memlimit.Workspace{
+						{ID: "a", Usage: 4 * gi, Pressure: 2 * time.Second},
+						{ID: "b", Usage: 4 * gi, Pressure: 8 * time.Second},
+						{ID: "c", Usage: 2 * gi, Class: "small", Pressure: 5 * time.Second},
+					},
+					Expectation: map[string]limits{"a": {High: 5 * gi}, "b": {High: 5 * gi}, "c": {High: 3 * gi, Low: 1 * gi}},
+				},
+				{
+					Workspaces: []memlimit.Workspace{
+						{ID: "a", Usage: 5 * gi, Pressure: 4 * time.Second},
+						{ID: "b", Usage: 5 * gi, Pressure: 16 * time.Second},
+						{ID: "c", Usage: 3 * gi, Class: "small", Pressure: 10 * time.Second},
+					},
+					Expectation: map[string]limits{"a": {High: 4 * gi}, "b": {High: 6 * gi}, "c": {High: 3 * gi, Low: 1 * gi}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				current []memlimit.Workspace
+				act     map[string]limits
+			)
+			dist := memlimit.NewDistributor(
+				func(ctx context.Context) ([]memlimit.Workspace, error) { return current, nil },
+				func(id string, high, low memlimit.Memory) { act[id] = limits{High: high, Low: low} },
+				memlimit.CompositeLimiter(memlimit.ClassLimiter(map[string]memlimit.Memory{"small": 2 * gi}), memlimit.FixedLimiter(4*gi)),
+				memlimit.CompositeLimiter(memlimit.ClassLimiter(map[string]memlimit.Memory{"small": 3 * gi}), memlimit.FixedLimiter(6*gi)),
+				memlimit.CompositeLimiter(memlimit.ClassLimiter(map[string]memlimit.Memory{"small": 1 * gi}), memlimit.FixedLimiter(0)),
+				test.TotalMemory,
+			)
+			dist.Step = gi
+
+			for i, tick := range test.Ticks {
+				current = tick.Workspaces
+				act = make(map[string]limits)
+				dbg, err := dist.Tick(dt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tick.Expectation, act); diff != "" {
+					t.Errorf("unexpected limits in tick %d (-want +got):\n%s", i, diff)
+				}
+
+				var total memlimit.Memory
+				for _, l := range act {
+					total += l.High
+				}
+				if dbg.MemoryDistributed != total {
+					t.Errorf("unexpected distributed memory in tick %d: got %d, expected %d", i, dbg.MemoryDistributed, total)
+				}
+			}
+		})
+	}
+}
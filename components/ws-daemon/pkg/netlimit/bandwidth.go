@@ -0,0 +1,413 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+)
+
+const (
+	// workspaceInterface is the interface of the workspace network namespace through which all traffic flows
+	workspaceInterface = "eth0"
+
+	// egressLatency is the time a packet may wait in the egress queue before it is dropped
+	egressLatency = 25 * time.Millisecond
+
+	// minBurst is the smallest burst we configure. Bursts must fit at least a full (GSO) packet, or no traffic passes at all.
+	minBurst = 64 * 1024
+)
+
+var (
+	egressHandle  = netlink.MakeHandle(1, 0)
+	ingressHandle = netlink.MakeHandle(0xffff, 0)
+)
+
+// BandwidthLimiter shapes the network bandwidth of workspaces using traffic control in their network namespace
+type BandwidthLimiter struct {
+	mu     sync.Mutex
+	shaped map[string]*shapedWorkspace
+	config BandwidthConfig
+
+	droppedBytes *prometheus.CounterVec
+	queuedBytes  *prometheus.GaugeVec
+}
+
+type shapedWorkspace struct {
+	Workspace *dispatch.Workspace
+	Class     string
+	PID       uint64
+	Shaping   shaping
+
+	// lastStats are the traffic control statistics we read last
+	lastStats shapingStats
+}
+
+func NewBandwidthLimiter(config BandwidthConfig, prom prometheus.Registerer) *BandwidthLimiter {
+	s := &BandwidthLimiter{
+		droppedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "netlimit_bandwidth_dropped_bytes_total",
+			Help: "Number of bytes dropped due to bandwidth limiting, estimated from the dropped packets and their average size",
+		}, []string{"node", "workspace", "direction"}),
+
+		queuedBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_bandwidth_queued_bytes",
+			Help: "Number of egress bytes queued due to bandwidth limiting",
+		}, []string{"node", "workspace"}),
+		shaped: map[string]*shapedWorkspace{},
+	}
+
+	s.config = config
+
+	prom.MustRegister(
+		s.droppedBytes,
+		s.queuedBytes,
+	)
+
+	return s
+}
+
+// shapingForClass returns the shaping of a workspace class. No shaping applies while bandwidth limiting is disabled.
+func (c *BandwidthLimiter) shapingForClass(class string) shaping {
+	if !c.config.Enabled {
+		return shaping{}
+	}
+	return shapingFor(c.config.ForClass(class))
+}
+
+func (c *BandwidthLimiter) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return fmt.Errorf("no dispatch available")
+	}
+
+	pid, err := disp.Runtime.ContainerPID(context.Background(), ws.ContainerID)
+	if err != nil {
+		return fmt.Errorf("could not get pid for container %s of workspace %s", ws.ContainerID, ws.WorkspaceID)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sw := &shapedWorkspace{
+		Workspace: ws,
+		Class:     ws.Pod.Annotations[kubernetes.WorkspaceClassAnnotation],
+		PID:       pid,
+	}
+	sw.Shaping = c.shapingForClass(sw.Class)
+
+	// we keep track of all workspaces, so that they're shaped once bandwidth limiting is enabled
+	if sw.Shaping != (shaping{}) {
+		log.WithFields(ws.OWI()).WithField("class", sw.Class).WithField("shaping", sw.Shaping).Info("will limit network bandwidth")
+		err = applyShaping(pid, sw.Shaping)
+		if err != nil {
+			log.WithError(err).WithFields(ws.OWI()).Error("cannot enable bandwidth limiting")
+			return err
+		}
+	}
+	c.shaped[ws.InstanceID] = sw
+
+	go c.observe(ctx, sw)
+
+	return nil
+}
+
+func (c *BandwidthLimiter) observe(ctx context.Context, sw *shapedWorkspace) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	var (
+		nodeName = os.Getenv("NODENAME")
+		ws       = sw.Workspace
+	)
+	for {
+		select {
+		case <-ticker.C:
+			c.mu.Lock()
+			s := sw.Shaping
+			c.mu.Unlock()
+			if s == (shaping{}) {
+				continue
+			}
+
+			stats, err := readShapingStats(sw.PID, s)
+			if err != nil {
+				log.WithFields(ws.OWI()).WithError(err).Warnf("could not get bandwidth limiting stats")
+				continue
+			}
+
+			c.mu.Lock()
+			last := sw.lastStats
+			sw.lastStats = *stats
+			c.mu.Unlock()
+
+			c.droppedBytes.WithLabelValues(nodeName, ws.Pod.Name, "egress").Add(float64(counterDelta(last.EgressDroppedBytes, stats.EgressDroppedBytes)))
+			c.droppedBytes.WithLabelValues(nodeName, ws.Pod.Name, "ingress").Add(float64(counterDelta(last.IngressDroppedBytes, stats.IngressDroppedBytes)))
+			c.queuedBytes.WithLabelValues(nodeName, ws.Pod.Name).Set(float64(stats.EgressBacklog))
+
+		case <-ctx.Done():
+			c.mu.Lock()
+			delete(c.shaped, ws.InstanceID)
+			c.mu.Unlock()
+
+			c.droppedBytes.DeleteLabelValues(nodeName, ws.Pod.Name, "egress")
+			c.droppedBytes.DeleteLabelValues(nodeName, ws.Pod.Name, "ingress")
+			c.queuedBytes.DeleteLabelValues(nodeName, ws.Pod.Name)
+			return
+		}
+	}
+}
+
+// Update changes the bandwidth limits of all running and future workspaces.
+// Disabling bandwidth limiting removes the shaping from all running workspaces.
+func (c *BandwidthLimiter) Update(config BandwidthConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.config = config
+	log.WithField("config", config).Info("updating network bandwidth limits")
+
+	for _, sw := range c.shaped {
+		s := c.shapingForClass(sw.Class)
+		if s == sw.Shaping {
+			continue
+		}
+
+		// applying a zero shaping removes the qdiscs
+		err := applyShaping(sw.PID, s)
+		if err != nil {
+			log.WithError(err).WithFields(sw.Workspace.OWI()).Warn("cannot update bandwidth limits")
+			continue
+		}
+		sw.Shaping = s
+	}
+}
+
+// counterDelta returns the increase of a kernel counter. Counters restart at zero when their qdisc is recreated.
+func counterDelta(last, current uint64) uint64 {
+	if current < last {
+		return current
+	}
+	return current - last
+}
+
+// shaping are the traffic control parameters derived from a BandwidthLimit. All values are in bytes (per second).
+type shaping struct {
+	EgressRate   uint64
+	EgressBurst  uint64
+	IngressRate  uint64
+	IngressBurst uint64
+}
+
+func shapingFor(l BandwidthLimit) shaping {
+	burst := func(rate uint64, burst int64) uint64 {
+		if rate == 0 {
+			return 0
+		}
+		res := uint64(burst)
+		if burst <= 0 {
+			res = rate / 10
+		}
+		if res < minBurst {
+			res = minBurst
+		}
+		return res
+	}
+	rate := func(r int64) uint64 {
+		if r <= 0 {
+			return 0
+		}
+		return uint64(r)
+	}
+
+	var s shaping
+	s.EgressRate = rate(l.EgressRate.Value())
+	s.EgressBurst = burst(s.EgressRate, l.EgressBurst.Value())
+	s.IngressRate = rate(l.IngressRate.Value())
+	s.IngressBurst = burst(s.IngressRate, l.IngressBurst.Value())
+	return s
+}
+
+// egressQueueLimit is the number of bytes the egress queue can hold before packets are dropped
+func egressQueueLimit(rate, burst uint64) uint32 {
+	return clampUint32(rate*uint64(egressLatency.Microseconds())/uint64(time.Second.Microseconds()) + burst)
+}
+
+func clampUint32(v uint64) uint32 {
+	if v > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(v)
+}
+
+// applyShaping configures traffic control in the network namespace of pid. Egress traffic is shaped
+// using a token bucket filter, ingress traffic is policed because we cannot queue it.
+func applyShaping(pid uint64, s shaping) error {
+	h, link, err := netnsLink(pid)
+	if err != nil {
+		return err
+	}
+	defer h.Close()
+
+	egress := &netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    egressHandle,
+			Parent:    netlink.HANDLE_ROOT,
+		},
+	}
+	if s.EgressRate > 0 {
+		egress.Rate = s.EgressRate
+		egress.Buffer = netlink.Xmittime(s.EgressRate, clampUint32(s.EgressBurst))
+		egress.Limit = egressQueueLimit(s.EgressRate, s.EgressBurst)
+		err = h.QdiscReplace(egress)
+	} else {
+		err = ignoreNotExist(h.QdiscDel(egress))
+	}
+	if err != nil {
+		return fmt.Errorf("cannot configure egress bandwidth limit: %w", err)
+	}
+
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    ingressHandle,
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if s.IngressRate == 0 {
+		// removing the qdisc removes its filters, too
+		err = ignoreNotExist(h.QdiscDel(ingress))
+		if err != nil {
+			return fmt.Errorf("cannot configure ingress bandwidth limit: %w", err)
+		}
+		return nil
+	}
+
+	err = h.QdiscReplace(ingress)
+	if err != nil {
+		return fmt.Errorf("cannot configure ingress bandwidth limit: %w", err)
+	}
+	police := netlink.NewPoliceAction()
+	police.Rate = clampUint32(s.IngressRate)
+	police.Burst = clampUint32(s.IngressBurst)
+	police.ExceedAction = netlink.TC_POLICE_SHOT
+	police.NotExceedAction = netlink.TC_POLICE_OK
+	err = h.FilterReplace(&netlink.MatchAll{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    ingressHandle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []netlink.Action{police},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot configure ingress bandwidth limit: %w", err)
+	}
+
+	return nil
+}
+
+type shapingStats struct {
+	EgressDroppedBytes  uint64
+	EgressBacklog       uint64
+	IngressDroppedBytes uint64
+}
+
+// estimateDroppedBytes estimates the bytes of dropped packets from the average size of the packets seen.
+// Traffic control only counts the dropped packets, not their size.
+func estimateDroppedBytes(basic *netlink.GnetStatsBasic, drops uint32) uint64 {
+	if basic == nil || basic.Packets == 0 {
+		return 0
+	}
+	return uint64(drops) * (basic.Bytes / uint64(basic.Packets))
+}
+
+// readShapingStats reads the traffic control statistics from the network namespace of pid
+func readShapingStats(pid uint64, s shaping) (*shapingStats, error) {
+	h, link, err := netnsLink(pid)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+
+	var res shapingStats
+	if s.EgressRate > 0 {
+		qdiscs, err := h.QdiscList(link)
+		if err != nil {
+			return nil, fmt.Errorf("could not list qdiscs: %w", err)
+		}
+		for _, q := range qdiscs {
+			if q.Attrs().Handle != egressHandle || q.Attrs().Statistics == nil || q.Attrs().Statistics.Queue == nil {
+				continue
+			}
+			res.EgressDroppedBytes = estimateDroppedBytes(q.Attrs().Statistics.Basic, q.Attrs().Statistics.Queue.Drops)
+			res.EgressBacklog = uint64(q.Attrs().Statistics.Queue.Backlog)
+		}
+	}
+
+	if s.IngressRate > 0 {
+		filters, err := h.FilterList(link, ingressHandle)
+		if err != nil {
+			return nil, fmt.Errorf("could not list filters: %w", err)
+		}
+		for _, f := range filters {
+			ma, ok := f.(*netlink.MatchAll)
+			if !ok {
+				continue
+			}
+			for _, a := range ma.Actions {
+				if a.Attrs().Statistics == nil || a.Attrs().Statistics.Queue == nil {
+					continue
+				}
+				res.IngressDroppedBytes += estimateDroppedBytes(a.Attrs().Statistics.Basic, a.Attrs().Statistics.Queue.Drops)
+			}
+		}
+	}
+
+	return &res, nil
+}
+
+// netnsLink returns a netlink handle for the network namespace of pid and the workspace interface in it
+func netnsLink(pid uint64) (*netlink.Handle, netlink.Link, error) {
+	ns, err := netns.GetFromPid(int(pid))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get handle for network namespace: %w", err)
+	}
+	defer ns.Close()
+
+	h, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not establish netlink connection: %w", err)
+	}
+	link, err := h.LinkByName(workspaceInterface)
+	if err != nil {
+		h.Close()
+		return nil, nil, fmt.Errorf("could not find %s: %w", workspaceInterface, err)
+	}
+	return h, link, nil
+}
+
+func ignoreNotExist(err error) error {
+	if errors.Is(err, unix.ENOENT) || errors.Is(err, unix.EINVAL) {
+		return nil
+	}
+	return err
+}
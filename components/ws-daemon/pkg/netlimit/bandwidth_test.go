@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/vishvananda/netlink"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestShapingFor(t *testing.T) {
+	config := BandwidthConfig{
+		Default: BandwidthLimit{
+			EgressRate:  resource.MustParse("10Mi"),
+			IngressRate: resource.MustParse("20Mi"),
+		},
+		Classes: map[string]BandwidthLimit{
+			"large": {
+				EgressRate:   resource.MustParse("100Mi"),
+				EgressBurst:  resource.MustParse("1Mi"),
+				IngressRate:  resource.MustParse("200Mi"),
+				IngressBurst: resource.MustParse("1Ki"),
+			},
+			"egress-only": {
+				EgressRate: resource.MustParse("100Ki"),
+			},
+		},
+	}
+
+	tests := []struct {
+		Name        string
+		Class       string
+		Expectation shaping
+	}{
+		{
+			Name:        "default burst",
+			Class:       "default",
+			Expectation: shaping{EgressRate: 10 << 20, EgressBurst: 1 << 20, IngressRate: 20 << 20, IngressBurst: 2 << 20},
+		},
+		{
+			Name:        "explicit burst with minimum",
+			Class:       "large",
+			Expectation: shaping{EgressRate: 100 << 20, EgressBurst: 1 << 20, IngressRate: 200 << 20, IngressBurst: minBurst},
+		},
+		{
+			Name:        "unlimited ingress",
+			Class:       "egress-only",
+			Expectation: shaping{EgressRate: 100 << 10, EgressBurst: minBurst},
+		},
+		{
+			Name:        "unknown class",
+			Class:       "foobar",
+			Expectation: shaping{EgressRate: 10 << 20, EgressBurst: 1 << 20, IngressRate: 20 << 20, IngressBurst: 2 << 20},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := shapingFor(config.ForClass(test.Class))
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected shaping (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEgressQueueLimit(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Rate        uint64
+		Burst       uint64
+		Expectation uint32
+	}{
+		{Name: "10MiB/s", Rate: 10 << 20, Burst: 1 << 20, Expectation: 262144 + 1<<20},
+		{Name: "overflow", Rate: 1 << 40, Burst: 1 << 33, Expectation: 1<<32 - 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := egressQueueLimit(test.Rate, test.Burst)
+			if act != test.Expectation {
+				t.Errorf("unexpected queue limit: got %d, expected %d", act, test.Expectation)
+			}
+		})
+	}
+}
+
+func TestEstimateDroppedBytes(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Basic       *netlink.GnetStatsBasic
+		Drops       uint32
+		Expectation uint64
+	}{
+		{Name: "no stats", Drops: 10, Expectation: 0},
+		{Name: "no packets seen", Basic: &netlink.GnetStatsBasic{}, Drops: 10, Expectation: 0},
+		{Name: "average packet size", Basic: &netlink.GnetStatsBasic{Bytes: 15000, Packets: 10}, Drops: 4, Expectation: 6000},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := estimateDroppedBytes(test.Basic, test.Drops)
+			if act != test.Expectation {
+				t.Errorf("unexpected dropped bytes: got %d, expected %d", act, test.Expectation)
+			}
+		})
+	}
+}
+
+func TestCounterDelta(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Last        uint64
+		Current     uint64
+		Expectation uint64
+	}{
+		{Name: "increase", Last: 100, Current: 150, Expectation: 50},
+		{Name: "unchanged", Last: 100, Current: 100, Expectation: 0},
+		{Name: "qdisc recreated", Last: 100, Current: 20, Expectation: 20},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := counterDelta(test.Last, test.Current)
+			if act != test.Expectation {
+				t.Errorf("unexpected delta: got %d, expected %d", act, test.Expectation)
+			}
+		})
+	}
+}
@@ -4,9 +4,103 @@
 
 package netlimit
 
+import (
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
 type Config struct {
 	Enabled              bool  `json:"enabled"`
 	Enforce              bool  `json:"enforce"`
 	ConnectionsPerMinute int64 `json:"connectionsPerMinute"`
 	BucketSize           int64 `json:"bucketSize"`
+
+	// Bandwidth configures the shaping of workspace network bandwidth, independently of connection limiting
+	Bandwidth BandwidthConfig `json:"bandwidth"`
+
+	// Egress configures the egress network policies of workspaces, independently of connection limiting
+	Egress EgressConfig `json:"egress"`
+}
+
+// BandwidthConfig configures the bandwidth limits of workspaces
+type BandwidthConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Default applies to workspaces whose class has no entry in Classes
+	Default BandwidthLimit `json:"default"`
+
+	// Classes configures the bandwidth limits per workspace class
+	Classes map[string]BandwidthLimit `json:"classes,omitempty"`
+}
+
+// BandwidthLimit limits the bandwidth of a single workspace. A zero rate leaves that direction unlimited.
+type BandwidthLimit struct {
+	// EgressRate is the rate in bytes per second at which traffic leaves the workspace. Excess traffic is queued.
+	EgressRate resource.Quantity `json:"egressBytesPerSecond"`
+	// EgressBurst is the number of bytes which can be sent at once. Defaults to a tenth of the rate.
+	EgressBurst resource.Quantity `json:"egressBurstBytes"`
+
+	// IngressRate is the rate in bytes per second at which traffic enters the workspace. Excess traffic is dropped.
+	IngressRate resource.Quantity `json:"ingressBytesPerSecond"`
+	// IngressBurst is the number of bytes which can be received at once. Defaults to a tenth of the rate.
+	IngressBurst resource.Quantity `json:"ingressBurstBytes"`
+}
+
+// ForClass returns the bandwidth limit of a workspace class
+func (c BandwidthConfig) ForClass(class string) BandwidthLimit {
+	if l, ok := c.Classes[class]; ok {
+		return l
+	}
+	return c.Default
+}
+
+// EgressConfig configures the egress network policies of workspaces
+type EgressConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// AlwaysAllowed are the CIDRs every workspace can reach regardless of its policy, e.g. the cluster network and DNS servers
+	AlwaysAllowed []string `json:"alwaysAllowed,omitempty"`
+
+	// Policies are the egress policies available to workspaces by name
+	Policies map[string]EgressPolicy `json:"policies,omitempty"`
+
+	// Classes selects the policy of workspaces by workspace class. The egress policy annotation takes precedence.
+	Classes map[string]string `json:"classes,omitempty"`
+
+	// DNSRefreshInterval is the interval at which domain allowlists are resolved again. Defaults to five minutes.
+	DNSRefreshInterval util.Duration `json:"dnsRefreshInterval,omitempty"`
+}
+
+// EgressPolicyMode determines what happens to traffic a policy does not allow
+type EgressPolicyMode string
+
+const (
+	// EgressPolicyEnforce drops traffic the policy does not allow
+	EgressPolicyEnforce EgressPolicyMode = "enforce"
+	// EgressPolicyLogOnly counts and logs traffic the policy does not allow, but lets it pass
+	EgressPolicyLogOnly EgressPolicyMode = "log-only"
+)
+
+// EgressPolicy restricts the destinations workspaces can connect to. Denied CIDRs take precedence over
+// allowed CIDRs and domains. Connections that match neither are blocked if the policy allows anything at all.
+type EgressPolicy struct {
+	Mode EgressPolicyMode `json:"mode"`
+
+	AllowCIDRs   []string `json:"allowCIDRs,omitempty"`
+	AllowDomains []string `json:"allowDomains,omitempty"`
+	DenyCIDRs    []string `json:"denyCIDRs,omitempty"`
+}
+
+// PolicyFor returns the name of the egress policy of a workspace given its policy annotation and class.
+// Returns false if no policy applies to the workspace.
+func (c EgressConfig) PolicyFor(annotation, class string) (string, EgressPolicy, bool) {
+	name := annotation
+	if name == "" {
+		name = c.Classes[class]
+	}
+	if name == "" {
+		return "", EgressPolicy{}, false
+	}
+	p, ok := c.Policies[name]
+	return name, p, ok
 }
@@ -0,0 +1,559 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"os/exec"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vishvananda/netns"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/nsinsider"
+)
+
+const (
+	defaultDNSRefreshInterval = 5 * time.Minute
+	dnsResolveTimeout         = 10 * time.Second
+	policyUpdateTimeout       = 1 * time.Minute
+
+	egressBlockedStats = "ws-egress-blocked-stats"
+	egressBlockedSet   = "egress-blocked"
+)
+
+// EgressFilter applies egress network policies to workspaces. nsinsider sets up the filter chain in the
+// gitpod nftables table of the workspace network namespace, and we maintain the addresses of the policy in its sets.
+// Policies only hold IPv4 addresses, hence all new IPv6 connections leaving the workspace are blocked.
+type EgressFilter struct {
+	mu       sync.Mutex
+	filtered map[string]*filteredWorkspace
+	config   EgressConfig
+	resolver *domainResolver
+
+	blockedPackets *prometheus.GaugeVec
+}
+
+type filteredWorkspace struct {
+	Workspace  *dispatch.Workspace
+	Annotation string
+	Class      string
+	PID        uint64
+
+	Policy  string
+	Rules   egressRules
+	Blocked map[netip.Addr]struct{}
+
+	// applyMu serialises changes of the installed rules, which happen without holding EgressFilter.mu
+	applyMu sync.Mutex
+}
+
+func NewEgressFilter(config EgressConfig, prom prometheus.Registerer) *EgressFilter {
+	s := &EgressFilter{
+		blockedPackets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_egress_blocked_packets",
+			Help: "Number of packets blocked by the egress policy of a workspace. Blocked packets are not dropped in log-only mode.",
+		}, []string{"node", "workspace", "policy", "mode"}),
+		filtered: map[string]*filteredWorkspace{},
+		resolver: newDomainResolver(net.DefaultResolver),
+	}
+
+	s.config = config
+
+	if config.Enabled {
+		prom.MustRegister(
+			s.blockedPackets,
+		)
+	}
+
+	return s
+}
+
+func (c *EgressFilter) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	annotation := ws.Pod.Annotations[kubernetes.WorkspaceEgressPolicyAnnotation]
+	class := ws.Pod.Annotations[kubernetes.WorkspaceClassAnnotation]
+
+	c.mu.Lock()
+	config := c.config
+	c.mu.Unlock()
+
+	name, policy, ok := config.PolicyFor(annotation, class)
+	if !ok {
+		if name != "" {
+			log.WithFields(ws.OWI()).WithField("policy", name).Warn("unknown egress policy - not restricting egress traffic")
+		}
+		return nil
+	}
+
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return fmt.Errorf("no dispatch available")
+	}
+
+	pid, err := disp.Runtime.ContainerPID(context.Background(), ws.ContainerID)
+	if err != nil {
+		return fmt.Errorf("could not get pid for container %s of workspace %s", ws.ContainerID, ws.WorkspaceID)
+	}
+
+	fw := &filteredWorkspace{
+		Workspace:  ws,
+		Annotation: annotation,
+		Class:      class,
+		PID:        pid,
+		Policy:     name,
+		Blocked:    make(map[netip.Addr]struct{}),
+	}
+	fw.Rules = egressRulesFor(config, policy, c.resolver.Resolve(ctx, policy.AllowDomains, config.dnsRefreshInterval()))
+
+	log.WithFields(ws.OWI()).WithField("policy", name).WithField("mode", fw.Rules.Mode).Info("will restrict egress traffic")
+	err = c.setupPolicy(fw, fw.Rules, nil)
+	if err != nil {
+		log.WithError(err).WithFields(ws.OWI()).Error("cannot enable egress policy")
+		return err
+	}
+
+	c.mu.Lock()
+	c.filtered[ws.InstanceID] = fw
+	c.mu.Unlock()
+
+	go c.observe(ctx, fw)
+
+	return nil
+}
+
+// setupPolicy installs the egress chain if the rules differ from previous in a way that affects the chain, and updates the address sets.
+// It runs nsinsider and talks to nftables, hence must not be called with c.mu held.
+func (c *EgressFilter) setupPolicy(fw *filteredWorkspace, rules egressRules, previous *egressRules) error {
+	if previous == nil || previous.Mode != rules.Mode || previous.Allowlist != rules.Allowlist {
+		err := nsinsider.Nsinsider(fw.Workspace.InstanceID, int(fw.PID), func(cmd *exec.Cmd) {
+			cmd.Args = append(cmd.Args, "setup-egress-policy")
+			if rules.Allowlist {
+				cmd.Args = append(cmd.Args, "--allowlist")
+			}
+			if rules.Mode == EgressPolicyEnforce {
+				cmd.Args = append(cmd.Args, "--enforce")
+			}
+		}, nsinsider.EnterMountNS(false), nsinsider.EnterNetNS(true))
+		if err != nil {
+			return err
+		}
+		previous = nil
+	}
+
+	sets := map[string][]netip.Prefix{
+		"egress-always": rules.Always,
+		"egress-deny":   rules.Deny,
+		"egress-allow":  rules.Allow,
+	}
+	if previous != nil {
+		current := map[string][]netip.Prefix{
+			"egress-always": previous.Always,
+			"egress-deny":   previous.Deny,
+			"egress-allow":  previous.Allow,
+		}
+		for name, prefixes := range sets {
+			if reflect.DeepEqual(prefixes, current[name]) {
+				delete(sets, name)
+			}
+		}
+		if len(sets) == 0 {
+			return nil
+		}
+	}
+
+	return withNFTables(fw.PID, func(conn *nftables.Conn, table *nftables.Table) error {
+		for name, prefixes := range sets {
+			set, err := conn.GetSetByName(table, name)
+			if err != nil {
+				return fmt.Errorf("could not get set %s: %w", name, err)
+			}
+			conn.FlushSet(set)
+			elems := intervalElements(prefixes)
+			if len(elems) == 0 {
+				continue
+			}
+			err = conn.SetAddElements(set, elems)
+			if err != nil {
+				return fmt.Errorf("could not add elements to set %s: %w", name, err)
+			}
+		}
+		err := conn.Flush()
+		if err != nil {
+			return fmt.Errorf("could not update egress policy sets: %w", err)
+		}
+		return nil
+	})
+}
+
+func (c *EgressFilter) observe(ctx context.Context, fw *filteredWorkspace) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	var (
+		nodeName = os.Getenv("NODENAME")
+		ws       = fw.Workspace
+
+		lastRefresh = time.Now()
+		labels      []string
+	)
+	for {
+		select {
+		case <-ticker.C:
+			c.mu.Lock()
+			config := c.config
+			c.mu.Unlock()
+
+			if time.Since(lastRefresh) >= config.dnsRefreshInterval() {
+				c.refresh(ctx, fw)
+				lastRefresh = time.Now()
+			}
+
+			blocked, dsts, err := readEgressStats(fw.PID)
+			if err != nil {
+				log.WithFields(ws.OWI()).WithError(err).Warnf("could not get egress policy stats")
+				continue
+			}
+
+			c.mu.Lock()
+			if labels != nil && (labels[2] != fw.Policy || labels[3] != string(fw.Rules.Mode)) {
+				c.blockedPackets.DeleteLabelValues(labels...)
+			}
+			labels = []string{nodeName, ws.Pod.Name, fw.Policy, string(fw.Rules.Mode)}
+			c.blockedPackets.WithLabelValues(labels...).Set(float64(blocked))
+
+			// the audit log: destinations are logged when they're first blocked, and again once they expired from the blocked set
+			current := make(map[netip.Addr]struct{}, len(dsts))
+			for _, dst := range dsts {
+				current[dst] = struct{}{}
+				if _, exists := fw.Blocked[dst]; exists {
+					continue
+				}
+				log.WithFields(ws.OWI()).WithField("policy", fw.Policy).WithField("mode", fw.Rules.Mode).WithField("destination", dst.String()).Info("egress policy blocked connection")
+			}
+			fw.Blocked = current
+			c.mu.Unlock()
+
+		case <-ctx.Done():
+			c.mu.Lock()
+			delete(c.filtered, ws.InstanceID)
+			c.mu.Unlock()
+
+			if labels != nil {
+				c.blockedPackets.DeleteLabelValues(labels...)
+			}
+			return
+		}
+	}
+}
+
+// refresh resolves the allowed domains of a workspace policy again and updates its sets if the addresses changed
+func (c *EgressFilter) refresh(ctx context.Context, fw *filteredWorkspace) {
+	c.mu.Lock()
+	config := c.config
+	policy := config.Policies[fw.Policy]
+	c.mu.Unlock()
+
+	domains := c.resolver.Resolve(ctx, policy.AllowDomains, config.dnsRefreshInterval())
+	c.apply(fw, domains)
+}
+
+// apply changes the rules of a workspace to those of its current policy and the resolved domains.
+// The rules are computed while holding c.mu, but installed after releasing it. Must not be called with c.mu held.
+func (c *EgressFilter) apply(fw *filteredWorkspace, domains []netip.Addr) {
+	fw.applyMu.Lock()
+	defer fw.applyMu.Unlock()
+
+	c.mu.Lock()
+	if c.filtered[fw.Workspace.InstanceID] != fw {
+		// the workspace is gone
+		c.mu.Unlock()
+		return
+	}
+	previous := fw.Rules
+	rules := egressRulesFor(c.config, c.config.Policies[fw.Policy], domains)
+	c.mu.Unlock()
+
+	if reflect.DeepEqual(rules, previous) {
+		return
+	}
+
+	err := c.setupPolicy(fw, rules, &previous)
+	if err != nil {
+		log.WithError(err).WithFields(fw.Workspace.OWI()).Warn("cannot update egress policy")
+		return
+	}
+
+	c.mu.Lock()
+	fw.Rules = rules
+	c.mu.Unlock()
+}
+
+// Update changes the egress policies of all running and future workspaces
+func (c *EgressFilter) Update(config EgressConfig) {
+	c.mu.Lock()
+	c.config = config
+	log.WithField("config", config).Info("updating egress network policies")
+
+	type update struct {
+		fw     *filteredWorkspace
+		policy EgressPolicy
+	}
+	updates := make([]update, 0, len(c.filtered))
+	for _, fw := range c.filtered {
+		// Workspaces whose policy no longer exists keep their chain, but without any restrictions
+		name, policy, _ := config.PolicyFor(fw.Annotation, fw.Class)
+		fw.Policy = name
+		updates = append(updates, update{fw: fw, policy: policy})
+	}
+	c.mu.Unlock()
+
+	// resolving domains and installing the rules can take a while, hence we must not hold the lock while doing it
+	ctx, cancel := context.WithTimeout(context.Background(), policyUpdateTimeout)
+	defer cancel()
+	for _, u := range updates {
+		domains := c.resolver.Resolve(ctx, u.policy.AllowDomains, config.dnsRefreshInterval())
+		c.apply(u.fw, domains)
+	}
+}
+
+func (c EgressConfig) dnsRefreshInterval() time.Duration {
+	if c.DNSRefreshInterval <= 0 {
+		return defaultDNSRefreshInterval
+	}
+	return time.Duration(c.DNSRefreshInterval)
+}
+
+// egressRules are the addresses and verdicts derived from an EgressPolicy
+type egressRules struct {
+	Mode      EgressPolicyMode
+	Allowlist bool
+	Always    []netip.Prefix
+	Deny      []netip.Prefix
+	Allow     []netip.Prefix
+}
+
+func egressRulesFor(config EgressConfig, policy EgressPolicy, domains []netip.Addr) egressRules {
+	res := egressRules{
+		Mode:      policy.Mode,
+		Allowlist: len(policy.AllowCIDRs) > 0 || len(policy.AllowDomains) > 0,
+		Always:    parsePrefixes(config.AlwaysAllowed),
+		Deny:      parsePrefixes(policy.DenyCIDRs),
+		Allow:     parsePrefixes(policy.AllowCIDRs),
+	}
+	if res.Mode != EgressPolicyEnforce {
+		res.Mode = EgressPolicyLogOnly
+	}
+	for _, addr := range domains {
+		res.Allow = append(res.Allow, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return res
+}
+
+// parsePrefixes parses CIDRs and plain addresses. Invalid and non-IPv4 entries are ignored.
+func parsePrefixes(cidrs []string) []netip.Prefix {
+	var res []netip.Prefix
+	for _, c := range cidrs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(c)
+			if aerr != nil {
+				log.WithError(err).WithField("cidr", c).Warn("ignoring invalid CIDR in egress policy")
+				continue
+			}
+			p = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		if !p.Addr().Unmap().Is4() {
+			continue
+		}
+		res = append(res, netip.PrefixFrom(p.Addr().Unmap(), p.Bits()).Masked())
+	}
+	return res
+}
+
+// intervalElements converts IPv4 prefixes into the elements of an nftables interval set.
+// Overlapping and adjacent prefixes are merged, because the kernel rejects overlapping intervals.
+func intervalElements(prefixes []netip.Prefix) []nftables.SetElement {
+	type interval struct {
+		// end is exclusive, hence uint64
+		start, end uint64
+	}
+	var intervals []interval
+	for _, p := range prefixes {
+		if !p.Addr().Is4() {
+			continue
+		}
+		p = p.Masked()
+		start := uint64(binary.BigEndian.Uint32(p.Addr().AsSlice()))
+		intervals = append(intervals, interval{start: start, end: start + 1<<(32-p.Bits())})
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].start < intervals[j].start })
+
+	var merged []interval
+	for _, i := range intervals {
+		if len(merged) > 0 && i.start <= merged[len(merged)-1].end {
+			if i.end > merged[len(merged)-1].end {
+				merged[len(merged)-1].end = i.end
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+
+	key := func(v uint64) []byte {
+		return binary.BigEndian.AppendUint32(nil, uint32(v))
+	}
+	var res []nftables.SetElement
+	for _, i := range merged {
+		res = append(res, nftables.SetElement{Key: key(i.start)})
+		if i.end <= 0xffffffff {
+			// an interval which extends to the end of the address space has no end element
+			res = append(res, nftables.SetElement{Key: key(i.end), IntervalEnd: true})
+		}
+	}
+	return res
+}
+
+// readEgressStats reads the blocked packet counter and the recently blocked destinations from the network namespace of pid
+func readEgressStats(pid uint64) (blocked uint64, dsts []netip.Addr, err error) {
+	err = withNFTables(pid, func(conn *nftables.Conn, table *nftables.Table) error {
+		obj, err := conn.GetObject(&nftables.CounterObj{
+			Table: table,
+			Name:  egressBlockedStats,
+		})
+		if err != nil {
+			return fmt.Errorf("could not get egress blocked stats: %w", err)
+		}
+		counter, ok := obj.(*nftables.CounterObj)
+		if !ok {
+			return fmt.Errorf("could not cast counter object")
+		}
+		blocked = counter.Packets
+
+		// IPv6 egress is blocked altogether, and counted separately
+		obj, err = conn.GetObject(&nftables.CounterObj{
+			Table: &nftables.Table{Name: table.Name, Family: nftables.TableFamilyIPv6},
+			Name:  egressBlockedStats,
+		})
+		if err != nil {
+			return fmt.Errorf("could not get IPv6 egress blocked stats: %w", err)
+		}
+		if counter, ok := obj.(*nftables.CounterObj); ok {
+			blocked += counter.Packets
+		}
+
+		set, err := conn.GetSetByName(table, egressBlockedSet)
+		if err != nil {
+			return fmt.Errorf("could not get set %s: %w", egressBlockedSet, err)
+		}
+		elems, err := conn.GetSetElements(set)
+		if err != nil {
+			return fmt.Errorf("could not get elements of set %s: %w", egressBlockedSet, err)
+		}
+		for _, e := range elems {
+			addr, ok := netip.AddrFromSlice(e.Key)
+			if !ok {
+				continue
+			}
+			dsts = append(dsts, addr)
+		}
+		return nil
+	})
+	return
+}
+
+// withNFTables calls f with an nftables connection to the network namespace of pid and its gitpod table
+func withNFTables(pid uint64, f func(conn *nftables.Conn, table *nftables.Table) error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	ns, err := netns.GetFromPid(int(pid))
+	if err != nil {
+		return fmt.Errorf("could not get handle for network namespace: %w", err)
+	}
+	defer ns.Close()
+
+	nftconn, err := nftables.New(nftables.WithNetNSFd(int(ns)))
+	if err != nil {
+		return fmt.Errorf("could not establish netlink connection for nft: %w", err)
+	}
+
+	return f(nftconn, &nftables.Table{
+		Name:   "gitpod",
+		Family: nftables.TableFamilyIPv4,
+	})
+}
+
+// domainResolver resolves the IPv4 addresses of allowed domains. Results are shared between workspaces,
+// and a domain which cannot be resolved keeps its previous addresses.
+type domainResolver struct {
+	mu       sync.Mutex
+	resolver interface {
+		LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+	}
+	cache map[string]resolvedDomain
+}
+
+type resolvedDomain struct {
+	Addrs    []netip.Addr
+	Resolved time.Time
+}
+
+func newDomainResolver(resolver *net.Resolver) *domainResolver {
+	return &domainResolver{
+		resolver: resolver,
+		cache:    make(map[string]resolvedDomain),
+	}
+}
+
+// Resolve returns the sorted addresses of all domains, resolving those whose cache entry is older than maxAge
+func (r *domainResolver) Resolve(ctx context.Context, domains []string, maxAge time.Duration) []netip.Addr {
+	var (
+		res  []netip.Addr
+		seen = make(map[netip.Addr]struct{})
+	)
+	for _, domain := range domains {
+		r.mu.Lock()
+		entry, cached := r.cache[domain]
+		r.mu.Unlock()
+
+		if !cached || time.Since(entry.Resolved) >= maxAge {
+			lctx, cancel := context.WithTimeout(ctx, dnsResolveTimeout)
+			addrs, err := r.resolver.LookupNetIP(lctx, "ip4", domain)
+			cancel()
+			if err != nil {
+				log.WithError(err).WithField("domain", domain).Warn("cannot resolve domain of egress policy")
+			} else {
+				entry = resolvedDomain{Addrs: addrs, Resolved: time.Now()}
+				r.mu.Lock()
+				r.cache[domain] = entry
+				r.mu.Unlock()
+			}
+		}
+
+		for _, addr := range entry.Addrs {
+			addr = addr.Unmap()
+			if _, exists := seen[addr]; exists {
+				continue
+			}
+			seen[addr] = struct{}{}
+			res = append(res, addr)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Less(res[j]) })
+	return res
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/nftables"
+)
+
+func TestIntervalElements(t *testing.T) {
+	start := func(addr string) nftables.SetElement {
+		return nftables.SetElement{Key: netip.MustParseAddr(addr).AsSlice()}
+	}
+	end := func(addr string) nftables.SetElement {
+		return nftables.SetElement{Key: netip.MustParseAddr(addr).AsSlice(), IntervalEnd: true}
+	}
+
+	tests := []struct {
+		Name        string
+		Prefixes    []string
+		Expectation []nftables.SetElement
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:        "single address",
+			Prefixes:    []string{"10.0.0.1/32"},
+			Expectation: []nftables.SetElement{start("10.0.0.1"), end("10.0.0.2")},
+		},
+		{
+			Name:     "unsorted",
+			Prefixes: []string{"192.168.0.0/16", "10.0.0.0/8"},
+			Expectation: []nftables.SetElement{
+				start("10.0.0.0"), end("11.0.0.0"),
+				start("192.168.0.0"), end("192.169.0.0"),
+			},
+		},
+		{
+			Name:        "overlapping and adjacent",
+			Prefixes:    []string{"10.0.0.0/8", "10.1.0.0/16", "11.0.0.0/24"},
+			Expectation: []nftables.SetElement{start("10.0.0.0"), end("11.0.1.0")},
+		},
+		{
+			Name:        "end of address space",
+			Prefixes:    []string{"255.255.255.0/24"},
+			Expectation: []nftables.SetElement{start("255.255.255.0")},
+		},
+		{
+			Name:        "everything",
+			Prefixes:    []string{"0.0.0.0/0", "10.0.0.0/8"},
+			Expectation: []nftables.SetElement{start("0.0.0.0")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var prefixes []netip.Prefix
+			for _, p := range test.Prefixes {
+				prefixes = append(prefixes, netip.MustParsePrefix(p))
+			}
+
+			act := intervalElements(prefixes)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEgressRulesFor(t *testing.T) {
+	config := EgressConfig{
+		AlwaysAllowed: []string{"10.0.0.0/8"},
+		Policies: map[string]EgressPolicy{
+			"allowlist": {
+				Mode:         EgressPolicyEnforce,
+				AllowCIDRs:   []string{"140.82.112.0/20", "not-a-cidr", "2001:db8::/32"},
+				AllowDomains: []string{"example.com"},
+			},
+			"denylist": {
+				DenyCIDRs: []string{"169.254.169.254", "192.168.1.17/16"},
+			},
+		},
+		Classes: map[string]string{
+			"regulated": "allowlist",
+			"default":   "denylist",
+		},
+	}
+
+	tests := []struct {
+		Name          string
+		Annotation    string
+		Class         string
+		Domains       []netip.Addr
+		Expectation   egressRules
+		ExpectedName  string
+		ExpectNoMatch bool
+	}{
+		{
+			Name:         "by class",
+			Class:        "regulated",
+			Domains:      []netip.Addr{netip.MustParseAddr("93.184.216.34")},
+			ExpectedName: "allowlist",
+			Expectation: egressRules{
+				Mode:      EgressPolicyEnforce,
+				Allowlist: true,
+				Always:    []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+				Allow:     []netip.Prefix{netip.MustParsePrefix("140.82.112.0/20"), netip.MustParsePrefix("93.184.216.34/32")},
+			},
+		},
+		{
+			Name:         "annotation takes precedence",
+			Annotation:   "denylist",
+			Class:        "regulated",
+			ExpectedName: "denylist",
+			Expectation: egressRules{
+				Mode:   EgressPolicyLogOnly,
+				Always: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+				Deny:   []netip.Prefix{netip.MustParsePrefix("169.254.169.254/32"), netip.MustParsePrefix("192.168.0.0/16")},
+			},
+		},
+		{
+			Name:          "unknown policy",
+			Annotation:    "foobar",
+			ExpectedName:  "foobar",
+			ExpectNoMatch: true,
+		},
+		{
+			Name:          "no policy",
+			Class:         "large",
+			ExpectNoMatch: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			name, policy, ok := config.PolicyFor(test.Annotation, test.Class)
+			if name != test.ExpectedName {
+				t.Errorf("unexpected policy: got %q, expected %q", name, test.ExpectedName)
+			}
+			if ok == test.ExpectNoMatch {
+				t.Fatalf("unexpected match: %v", ok)
+			}
+			if !ok {
+				return
+			}
+
+			act := egressRulesFor(config, policy, test.Domains)
+			if diff := cmp.Diff(test.Expectation, act, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })); diff != "" {
+				t.Errorf("unexpected rules (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeResolver struct {
+	Addrs map[string][]netip.Addr
+	Calls int
+}
+
+func (r *fakeResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	r.Calls++
+	addrs, ok := r.Addrs[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host")
+	}
+	return addrs, nil
+}
+
+func TestDomainResolver(t *testing.T) {
+	var (
+		a = netip.MustParseAddr("192.0.2.1")
+		b = netip.MustParseAddr("192.0.2.2")
+		c = netip.MustParseAddr("192.0.2.3")
+	)
+	fake := &fakeResolver{Addrs: map[string][]netip.Addr{
+		"one.example.com": {b, a},
+		"two.example.com": {a, c},
+	}}
+	r := &domainResolver{resolver: fake, cache: make(map[string]resolvedDomain)}
+	domains := []string{"one.example.com", "two.example.com", "unknown.example.com"}
+	cmpAddr := cmp.Comparer(func(a, b netip.Addr) bool { return a == b })
+
+	act := r.Resolve(context.Background(), domains, time.Hour)
+	if diff := cmp.Diff([]netip.Addr{a, b, c}, act, cmpAddr); diff != "" {
+		t.Errorf("unexpected addresses (-want +got):\n%s", diff)
+	}
+	if fake.Calls != 3 {
+		t.Errorf("unexpected number of lookups: %d", fake.Calls)
+	}
+
+	// cached results are used, except for domains which could not be resolved
+	r.Resolve(context.Background(), domains, time.Hour)
+	if fake.Calls != 4 {
+		t.Errorf("unexpected number of lookups: %d", fake.Calls)
+	}
+
+	// failed lookups keep the previous addresses
+	delete(fake.Addrs, "two.example.com")
+	act = r.Resolve(context.Background(), domains, 0)
+	if diff := cmp.Diff([]netip.Addr{a, b, c}, act, cmpAddr); diff != "" {
+		t.Errorf("unexpected addresses after failed lookup (-want +got):\n%s", diff)
+	}
+}
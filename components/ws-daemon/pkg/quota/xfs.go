@@ -5,6 +5,7 @@
 package quota
 
 import (
+	"errors"
 	"fmt"
 	"os/exec"
 	"strconv"
@@ -27,6 +28,14 @@ const (
 	prjidHi  = 10000
 )
 
+var (
+	// ErrProjectNotFound is returned if there is no quota for a project
+	ErrProjectNotFound = errors.New("project not found")
+
+	// ErrUsageExceedsQuota is returned if a quota would be smaller than the space a project already uses
+	ErrUsageExceedsQuota = errors.New("usage exceeds quota")
+)
+
 type XFS struct {
 	Dir string
 
@@ -139,6 +148,103 @@ func (xfs *XFS) SetQuotaWithPrjId(path string, quota Size, prjID int, isHard boo
 	return prjID, nil
 }
 
+// Usage returns the space used by a project and its limit. The limit is the hard limit if there is one, otherwise the soft limit.
+func (xfs *XFS) Usage(projectID int) (used Size, limit Size, err error) {
+	used, soft, hard, err := xfs.report(projectID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	limit = hard
+	if limit == 0 {
+		limit = soft
+	}
+	return used, limit, nil
+}
+
+// ProjectUsage returns the space used by each project. It reads the accounting of the file system,
+// hence is much cheaper than walking the content of the projects.
+func (xfs *XFS) ProjectUsage() (map[int]Size, error) {
+	reports, err := xfs.reports()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int]Size, len(reports))
+	for prj, r := range reports {
+		res[prj] = r.Used
+	}
+	return res, nil
+}
+
+// report returns the space used by a project and its soft and hard limit
+func (xfs *XFS) report(projectID int) (used, soft, hard Size, err error) {
+	reports, err := xfs.reports()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	r, ok := reports[projectID]
+	if !ok {
+		return 0, 0, 0, ErrProjectNotFound
+	}
+	return r.Used, r.Soft, r.Hard, nil
+}
+
+type projectReport struct {
+	Used, Soft, Hard Size
+}
+
+// reports returns the usage and limits of all projects
+func (xfs *XFS) reports() (map[int]projectReport, error) {
+	out, err := xfs.exec(xfs.Dir, "report -p -N -b")
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int]projectReport)
+	for _, l := range strings.Split(out, "\n") {
+		fields := strings.Fields(l)
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		prj, err := strconv.Atoi(strings.TrimPrefix(fields[0], "#"))
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse quota report %q: %w", l, err)
+		}
+
+		// xfs_quota reports blocks in kilobytes
+		var blocks [3]int64
+		for i := range blocks {
+			blocks[i], err = strconv.ParseInt(fields[i+1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse quota report %q: %w", l, err)
+			}
+		}
+		res[prj] = projectReport{Used: Size(blocks[0]) * Kilobyte, Soft: Size(blocks[1]) * Kilobyte, Hard: Size(blocks[2]) * Kilobyte}
+	}
+	return res, nil
+}
+
+// SetLimit changes the limit of a project which already has a quota. The project keeps the kind of limit
+// it was created with, i.e. a project with only a soft limit gets a new soft limit. Shrinking the limit below the
+// space the project already uses fails with ErrUsageExceedsQuota.
+func (xfs *XFS) SetLimit(projectID int, quota Size) error {
+	used, soft, hard, err := xfs.report(projectID)
+	if err != nil {
+		return err
+	}
+	if used > quota {
+		return fmt.Errorf("cannot set quota of project %d to %s: %w (using %s)", projectID, quota, ErrUsageExceedsQuota, used)
+	}
+
+	if hard == 0 && soft != 0 {
+		_, err = xfs.exec(xfs.Dir, fmt.Sprintf("limit -p bsoft=%d %d", quota, projectID))
+	} else {
+		_, err = xfs.exec(xfs.Dir, fmt.Sprintf("limit -p bhard=%d %d", quota, projectID))
+	}
+	return err
+}
+
 // RegisterProject tells this implementation that a projectID is already in use
 func (xfs *XFS) RegisterProject(prjID int) {
 	xfs.mu.Lock()
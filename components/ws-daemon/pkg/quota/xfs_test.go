@@ -191,3 +191,115 @@ func TestSetQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLimit(t *testing.T) {
+	const report = "#0              4      0      0  00 [------]\n#1000        2048      0   4096  00 [------]\n#1001           0   1024      0  00 [------]"
+
+	type Expectation struct {
+		Used  Size
+		Limit Size
+		Execs []string
+		Error string
+	}
+	tests := []struct {
+		Name        string
+		ProjectID   int
+		Size        Size
+		Expectation Expectation
+	}{
+		{
+			Name:      "grow",
+			ProjectID: 1000,
+			Size:      8 * Megabyte,
+			Expectation: Expectation{
+				Used:  2 * Megabyte,
+				Limit: 4 * Megabyte,
+				Execs: []string{"report -p -N -b", "report -p -N -b", "limit -p bhard=8388608 1000"},
+			},
+		},
+		{
+			Name:      "shrink",
+			ProjectID: 1000,
+			Size:      3 * Megabyte,
+			Expectation: Expectation{
+				Used:  2 * Megabyte,
+				Limit: 4 * Megabyte,
+				Execs: []string{"report -p -N -b", "report -p -N -b", "limit -p bhard=3145728 1000"},
+			},
+		},
+		{
+			Name:      "shrink below usage",
+			ProjectID: 1000,
+			Size:      1 * Megabyte,
+			Expectation: Expectation{
+				Used:  2 * Megabyte,
+				Limit: 4 * Megabyte,
+				Execs: []string{"report -p -N -b", "report -p -N -b"},
+				Error: "cannot set quota of project 1000 to 1m: usage exceeds quota (using 2m)",
+			},
+		},
+		{
+			Name:      "soft limit only",
+			ProjectID: 1001,
+			Size:      1 * Megabyte,
+			Expectation: Expectation{
+				Limit: 1 * Megabyte,
+				Execs: []string{"report -p -N -b", "report -p -N -b", "limit -p bsoft=1048576 1001"},
+			},
+		},
+		{
+			Name:      "unknown project",
+			ProjectID: 1002,
+			Size:      1 * Megabyte,
+			Expectation: Expectation{
+				Execs: []string{"report -p -N -b", "report -p -N -b"},
+				Error: "project not found",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act Expectation
+			xfs := &XFS{
+				exec: func(dir, command string) (output string, err error) {
+					act.Execs = append(act.Execs, command)
+					if strings.HasPrefix(command, "report") {
+						return report, nil
+					}
+					return "", nil
+				},
+				projectIDs: make(map[int]struct{}),
+				Dir:        "/",
+			}
+
+			act.Used, act.Limit, _ = xfs.Usage(test.ProjectID)
+			err := xfs.SetLimit(test.ProjectID, test.Size)
+			if err != nil {
+				act.Error = err.Error()
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected SetLimit (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectUsage(t *testing.T) {
+	xfs := &XFS{
+		exec: func(dir, command string) (output string, err error) {
+			return "#0              4      0      0  00 [------]\n#1000        2048      0   4096  00 [------]\n#1001           0   1024      0  00 [------]\n", nil
+		},
+		projectIDs: make(map[int]struct{}),
+		Dir:        "/",
+	}
+
+	act, err := xfs.ProjectUsage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[int]Size{0: 4 * Kilobyte, 1000: 2 * Megabyte, 1001: 0}, act); diff != "" {
+		t.Errorf("unexpected ProjectUsage (-want +got):\n%s", diff)
+	}
+}
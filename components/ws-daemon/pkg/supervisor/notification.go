@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/xerrors"
+)
+
+// DefaultPort is the port supervisor serves its API on
+const DefaultPort = 22999
+
+// NotificationLevel is the level of a notification, as defined by supervisor's NotificationService
+type NotificationLevel string
+
+const (
+	NotificationLevelInfo    NotificationLevel = "INFO"
+	NotificationLevelWarning NotificationLevel = "WARNING"
+	NotificationLevelError   NotificationLevel = "ERROR"
+)
+
+// Notify shows a notification without actions to the user of a workspace, using the notification API
+// of the workspace's supervisor. Without actions, supervisor responds as soon as the notification is shown.
+func Notify(ctx context.Context, client *http.Client, podIP string, port int, level NotificationLevel, message string) error {
+	if podIP == "" {
+		return xerrors.Errorf("workspace has no address")
+	}
+	if port <= 0 {
+		port = DefaultPort
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"level":   level,
+		"message": message,
+	})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("http://%s/_supervisor/v1/notification/notify", net.JoinHostPort(podIP, strconv.Itoa(port)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("supervisor responded with %d", resp.StatusCode)
+	}
+
+	return nil
+}
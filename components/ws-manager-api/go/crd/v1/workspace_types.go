@@ -195,7 +195,7 @@ func (s *WorkspaceStatus) SetCondition(cond metav1.Condition) {
 	s.Conditions = wsk8s.AddUniqueCondition(s.Conditions, cond)
 }
 
-// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared
+// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;OOMKilled
 type WorkspaceCondition string
 
 const (
@@ -243,6 +243,10 @@ const (
 
 	// NodeDisappeared is true if the workspace's node disappeared before the workspace was stopped
 	WorkspaceConditionNodeDisappeared WorkspaceCondition = "NodeDisappeared"
+
+	// OOMKilled is true if processes of the workspace were killed because the workspace ran out of memory.
+	// The condition message describes the most recent OOM kill.
+	WorkspaceConditionOOMKilled WorkspaceCondition = "OOMKilled"
 )
 
 func NewWorkspaceConditionDeployed() metav1.Condition {
@@ -371,6 +375,16 @@ func NewWorkspaceConditionNodeDisappeared() metav1.Condition {
 	}
 }
 
+func NewWorkspaceConditionOOMKilled(message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionOOMKilled),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             "OOMKilled",
+		Message:            message,
+	}
+}
+
 // +kubebuilder:validation:Enum:=Unknown;Pending;Imagebuild;Creating;Initializing;Running;Stopping;Stopped
 type WorkspacePhase string
 
@@ -908,6 +908,11 @@ export class WorkspaceMetadata extends jspb.Message {
     getProject(): string | undefined;
     setProject(value: string): WorkspaceMetadata;
 
+    hasTenant(): boolean;
+    clearTenant(): void;
+    getTenant(): string | undefined;
+    setTenant(value: string): WorkspaceMetadata;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceMetadata.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceMetadata): WorkspaceMetadata.AsObject;
@@ -927,6 +932,7 @@ export namespace WorkspaceMetadata {
         annotationsMap: Array<[string, string]>,
         team?: string,
         project?: string,
+        tenant?: string,
     }
 }
 
@@ -7304,7 +7304,8 @@ proto.wsman.WorkspaceMetadata.toObject = function(includeInstance, msg) {
     startedAt: (f = msg.getStartedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
     annotationsMap: (f = msg.getAnnotationsMap()) ? f.toObject(includeInstance, undefined) : [],
     team: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    project: jspb.Message.getFieldWithDefault(msg, 6, "")
+    project: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    tenant: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -7368,6 +7369,10 @@ proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {string} */ (reader.readString());
       msg.setProject(value);
       break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTenant(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7437,6 +7442,13 @@ proto.wsman.WorkspaceMetadata.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
@@ -7607,6 +7619,42 @@ proto.wsman.WorkspaceMetadata.prototype.hasProject = function() {
 };
 
 
+/**
+ * optional string tenant = 7;
+ * @return {string}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getTenant = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.setTenant = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearTenant = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.prototype.hasTenant = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
 
 
 
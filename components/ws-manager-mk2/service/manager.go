@@ -199,6 +199,8 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		annotations[k] = v
 	}
 
+	annotations[wsk8s.WorkspaceClassAnnotation] = classID
+
 	limits := class.Container.Limits
 	if limits != nil && limits.CPU != nil {
 		if limits.CPU.MinLimit != "" {
@@ -248,6 +250,8 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			Ownership: workspacev1.Ownership{
 				Owner:       req.Metadata.Owner,
 				WorkspaceID: req.Metadata.MetaId,
+				Team:        req.Metadata.GetTeam(),
+				Tenant:      req.Metadata.GetTenant(),
 			},
 			Type:  workspaceType,
 			Class: classID,
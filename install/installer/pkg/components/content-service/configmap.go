@@ -11,6 +11,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/content-service/api/config"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -24,6 +25,21 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		Storage: common.StorageConfig(ctx),
 	}
 
+	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
+		_, _, signingKeyPath, ok := getExportSigningKey(cfg)
+		if !ok {
+			return nil
+		}
+		cscfg.HTTP = &baseserver.ServerConfiguration{
+			Address: fmt.Sprintf("0.0.0.0:%d", HTTPPort),
+		}
+		cscfg.Export = &config.ExportConfig{
+			BaseURL:        fmt.Sprintf("https://%s%s", ctx.Config.Domain, exportPath),
+			SigningKeyFile: signingKeyPath,
+		}
+		return nil
+	})
+
 	fc, err := common.ToJSONString(cscfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal content-service config: %w", err)
@@ -42,3 +58,27 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		},
 	}}, nil
 }
+
+// getExportSigningKey returns the volume and mount of the key workspace content export URLs are signed with.
+// ok is false if workspace content export is disabled.
+func getExportSigningKey(cfg *experimental.Config) (volume corev1.Volume, mount corev1.VolumeMount, path string, ok bool) {
+	if cfg == nil || cfg.Workspace == nil || cfg.Workspace.ContentService.ExportSigningKeySecretName == "" {
+		return
+	}
+
+	volume = corev1.Volume{
+		Name: exportSigningKeyVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: cfg.Workspace.ContentService.ExportSigningKeySecretName,
+			},
+		},
+	}
+	mount = corev1.VolumeMount{
+		Name:      exportSigningKeyVolumeName,
+		MountPath: exportSigningKeyMountPath,
+		SubPath:   exportSigningKeySecretSubPath,
+		ReadOnly:  true,
+	}
+	return volume, mount, exportSigningKeyMountPath, true
+}
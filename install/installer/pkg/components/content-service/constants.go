@@ -8,4 +8,12 @@ const (
 	Component      = "content-service"
 	RPCPort        = 8080
 	RPCServiceName = "rpc"
+	HTTPPort       = 3002
+	HTTPPortName   = "http"
+
+	// exportPath is the path under which proxy serves workspace content exports
+	exportPath                    = "/workspace-export/"
+	exportSigningKeyMountPath     = "/secrets/export-signing-key"
+	exportSigningKeyVolumeName    = "export-signing-key"
+	exportSigningKeySecretSubPath = "export-signing-key"
 )
@@ -7,6 +7,9 @@ package content_service
 import (
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
+
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var Objects = common.CompositeRenderFunc(
@@ -14,7 +17,12 @@ var Objects = common.CompositeRenderFunc(
 	deployment,
 	networkpolicy,
 	rolebinding,
-	common.GenerateService(Component, []common.ServicePort{
+	service,
+	common.DefaultServiceAccount(Component),
+)
+
+func service(ctx *common.RenderContext) ([]runtime.Object, error) {
+	ports := []common.ServicePort{
 		{
 			Name:          RPCServiceName,
 			ContainerPort: RPCPort,
@@ -25,6 +33,17 @@ var Objects = common.CompositeRenderFunc(
 			ContainerPort: baseserver.BuiltinMetricsPort,
 			ServicePort:   baseserver.BuiltinMetricsPort,
 		},
-	}),
-	common.DefaultServiceAccount(Component),
-)
+	}
+	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
+		if _, _, _, ok := getExportSigningKey(cfg); ok {
+			ports = append(ports, common.ServicePort{
+				Name:          HTTPPortName,
+				ContainerPort: HTTPPort,
+				ServicePort:   HTTPPort,
+			})
+		}
+		return nil
+	})
+
+	return common.GenerateService(Component, ports)(ctx)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/daemon"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
 
 	corev1 "k8s.io/api/core/v1"
@@ -43,9 +44,16 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		CGroupBasePath: "/mnt/node-cgroups",
 		ControlPeriod:  util.Duration(15 * time.Second),
 	}
+	memLimitConfig := memlimit.Config{
+		Enabled:        false,
+		CGroupBasePath: "/mnt/node-cgroups",
+		ControlPeriod:  util.Duration(15 * time.Second),
+	}
 	var ioLimitConfig daemon.IOLimitConfig
+	var memoryWarningConfig cgroup.MemoryWarningConfig
 
 	var procLimit int64
+	var backupHistory int
 	networkLimitConfig := netlimit.Config{
 		Enabled:              false,
 		Enforce:              false,
@@ -65,6 +73,8 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 	var wscontroller daemon.WorkspaceControllerConfig
 
+	var diskEscalation *diskguard.EscalationConfig
+
 	// default workspace network CIDR (and fallback)
 	workspaceCIDR := "10.0.5.0/30"
 
@@ -77,16 +87,31 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		cpuLimitConfig.BurstLimit = ucfg.Workspace.CPULimits.BurstLimit
 		cpuLimitConfig.Limit = ucfg.Workspace.CPULimits.Limit
 		cpuLimitConfig.TotalBandwidth = ucfg.Workspace.CPULimits.NodeCPUBandwidth
+		cpuLimitConfig.FairShare = ucfg.Workspace.CPULimits.FairShare
+
+		memLimitConfig.Enabled = ucfg.Workspace.MemoryLimits.Enabled
+		memLimitConfig.TotalMemory = ucfg.Workspace.MemoryLimits.NodeMemory
+		memLimitConfig.Limit = ucfg.Workspace.MemoryLimits.Limit
+		memLimitConfig.BurstLimit = ucfg.Workspace.MemoryLimits.BurstLimit
+		memLimitConfig.Classes = ucfg.Workspace.MemoryLimits.Classes
+		memLimitConfig.PressureThreshold = ucfg.Workspace.MemoryLimits.PressureThreshold
 
 		ioLimitConfig.WriteBWPerSecond = ucfg.Workspace.IOLimits.WriteBWPerSecond
 		ioLimitConfig.ReadBWPerSecond = ucfg.Workspace.IOLimits.ReadBWPerSecond
 		ioLimitConfig.WriteIOPS = ucfg.Workspace.IOLimits.WriteIOPS
 		ioLimitConfig.ReadIOPS = ucfg.Workspace.IOLimits.ReadIOPS
+		ioLimitConfig.Adaptive = ucfg.Workspace.IOLimits.Adaptive
 
 		networkLimitConfig.Enabled = ucfg.Workspace.NetworkLimits.Enabled
 		networkLimitConfig.Enforce = ucfg.Workspace.NetworkLimits.Enforce
 		networkLimitConfig.ConnectionsPerMinute = ucfg.Workspace.NetworkLimits.ConnectionsPerMinute
 		networkLimitConfig.BucketSize = ucfg.Workspace.NetworkLimits.BucketSize
+		networkLimitConfig.Bandwidth = ucfg.Workspace.NetworkLimits.Bandwidth
+		networkLimitConfig.Egress = ucfg.Workspace.NetworkLimits.Egress
+
+		diskEscalation = ucfg.Workspace.DiskGuard.Escalation
+
+		memoryWarningConfig = ucfg.Workspace.MemoryWarnings
 
 		oomScoreAdjConfig.Enabled = ucfg.Workspace.OOMScores.Enabled
 		oomScoreAdjConfig.Tier1 = ucfg.Workspace.OOMScores.Tier1
@@ -101,6 +126,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		}
 
 		procLimit = ucfg.Workspace.ProcLimit
+		backupHistory = ucfg.Workspace.BackupHistory
 
 		wscontroller.MaxConcurrentReconciles = 15
 
@@ -139,6 +165,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				Backup: content.BackupConfig{
 					Timeout:  util.Duration(time.Minute * 5),
 					Attempts: 3,
+					History:  backupHistory,
 				},
 				Initializer: content.InitializerConfig{
 					Command: "/app/content-initializer",
@@ -155,17 +182,20 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 					Size:  70000,
 				}},
 			},
-			CPULimit:  cpuLimitConfig,
-			IOLimit:   ioLimitConfig,
-			ProcLimit: procLimit,
-			NetLimit:  networkLimitConfig,
-			OOMScores: oomScoreAdjConfig,
+			CPULimit:       cpuLimitConfig,
+			MemLimit:       memLimitConfig,
+			IOLimit:        ioLimitConfig,
+			ProcLimit:      procLimit,
+			NetLimit:       networkLimitConfig,
+			OOMScores:      oomScoreAdjConfig,
+			MemoryWarnings: memoryWarningConfig,
 			DiskSpaceGuard: diskguard.Config{
 				Enabled:  true,
 				Interval: util.Duration(5 * time.Minute),
 				Locations: []diskguard.LocationConfig{{
 					Path:          ContainerWorkingAreaMk2,
 					MinBytesAvail: 21474836480,
+					Escalation:    diskEscalation,
 				}},
 			},
 			WorkspaceController: wscontroller,
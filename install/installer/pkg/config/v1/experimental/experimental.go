@@ -16,7 +16,11 @@ import (
 	agentSmith "github.com/gitpod-io/gitpod/agent-smith/pkg/config"
 	"github.com/gitpod-io/gitpod/common-go/grpc"
 	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -63,27 +67,51 @@ type WorkspaceConfig struct {
 		NodeCPUBandwidth resource.Quantity `json:"nodeBandwidth"`
 		Limit            resource.Quantity `json:"limit"`
 		BurstLimit       resource.Quantity `json:"burstLimit"`
+
+		FairShare cpulimit.FairShareConfig `json:"fairShare"`
 	}
+	MemoryLimits struct {
+		Enabled           bool                            `json:"enabled"`
+		NodeMemory        resource.Quantity               `json:"nodeMemory"`
+		Limit             resource.Quantity               `json:"limit"`
+		BurstLimit        resource.Quantity               `json:"burstLimit"`
+		Classes           map[string]memlimit.ClassConfig `json:"classes,omitempty"`
+		PressureThreshold float64                         `json:"pressureThreshold,omitempty"`
+	} `json:"memoryLimits"`
 	IOLimits struct {
 		WriteBWPerSecond resource.Quantity `json:"writeBandwidthPerSecond"`
 		ReadBWPerSecond  resource.Quantity `json:"readBandwidthPerSecond"`
 		WriteIOPS        int64             `json:"writeIOPS"`
 		ReadIOPS         int64             `json:"readIOPS"`
+
+		Adaptive cgroup.AdaptiveIOLimitConfig `json:"adaptive"`
 	} `json:"ioLimits"`
 	NetworkLimits struct {
 		Enabled              bool  `json:"enabled"`
 		Enforce              bool  `json:"enforce"`
 		ConnectionsPerMinute int64 `json:"connectionsPerMinute"`
 		BucketSize           int64 `json:"bucketSize"`
+
+		Bandwidth netlimit.BandwidthConfig `json:"bandwidth"`
+		Egress    netlimit.EgressConfig    `json:"egress"`
 	} `json:"networkLimits"`
+	DiskGuard struct {
+		// Escalation configures how ws-daemon frees space on the working area once it runs low
+		Escalation *diskguard.EscalationConfig `json:"escalation,omitempty"`
+	} `json:"diskGuard"`
 	OOMScores struct {
 		Enabled bool `json:"enabled"`
 		Tier1   int  `json:"tier1"`
 		Tier2   int  `json:"tier2"`
 	} `json:"oomScores"`
 
+	MemoryWarnings cgroup.MemoryWarningConfig `json:"memoryWarnings"`
+
 	ProcLimit int64 `json:"procLimit"`
 
+	// BackupHistory is the number of backup generations kept per workspace
+	BackupHistory int `json:"backupHistory,omitempty"`
+
 	WSManagerRateLimits map[string]grpc.RateLimit `json:"wsManagerRateLimits,omitempty"`
 
 	RegistryFacade struct {
@@ -120,6 +148,10 @@ type WorkspaceConfig struct {
 	ContentService struct {
 		// Deprecated
 		UsageReportBucketName string `json:"usageReportBucketName"`
+
+		// Name of the kubernetes secret which holds the key workspace content export URLs are signed with.
+		// Workspace content export is enabled if set.
+		ExportSigningKeySecretName string `json:"exportSigningKeySecretName"`
 	} `json:"contentService"`
 
 	EnableProtectedSecrets *bool `json:"enableProtectedSecrets"`